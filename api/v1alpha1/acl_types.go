@@ -0,0 +1,208 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// AclSpec defines the desired state of Acl. The filter is named after the Acl on
+// the devices.
+type AclSpec struct {
+	// TargetRef selects the Devices the configuration is applied to
+	// +kubebuilder:validation:Required
+	TargetRef TargetRef `json:"targetRef"`
+	// Type is ipv4 for an ipv4-filter, ipv6 for an ipv6-filter or mac for a
+	// mac-filter
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=ipv4;ipv6;mac
+	Type string `json:"type"`
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Description string `json:"description,omitempty"`
+	// Entries are evaluated in order, the action of the first matching entry is
+	// applied to a packet
+	// +kubebuilder:validation:MinItems=1
+	Entries []AclEntry `json:"entries"`
+	// Bindings are the subinterfaces the filter is applied to
+	Bindings []AclBinding `json:"bindings,omitempty"`
+}
+
+// AclEntry defines an entry of a filter
+type AclEntry struct {
+	// Name identifies the entry across edits of the Acl, the entry keeps its
+	// sequence id when entries are inserted or removed around it
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// SequenceID pins the sequence id of the entry on the devices, it is assigned
+	// when it is not set. The sequence ids must follow the order of the entries.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=65535
+	SequenceID *uint32 `json:"sequence-id,omitempty"`
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Description string `json:"description,omitempty"`
+	// Match holds the conditions a packet has to meet, all packets match when it
+	// is empty
+	Match AclMatch `json:"match,omitempty"`
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=accept;drop
+	Action string `json:"action"`
+	// Log logs the packets matching the entry
+	Log bool `json:"log,omitempty"`
+}
+
+// AclMatch defines the conditions of an entry. Entries of ipv4 and ipv6 filters
+// match on the ip header and the ports, entries of mac filters on the ethernet
+// header.
+type AclMatch struct {
+	// Protocol is the ipv4 protocol or the ipv6 next-header, a number or one of
+	// icmp, igmp, tcp, udp, gre, esp, ah, icmp6, ospf, pim or vrrp
+	Protocol *intstr.IntOrString `json:"protocol,omitempty"`
+	// SourcePrefix is the prefix the source address is in, e.g. 10.0.0.0/8
+	SourcePrefix string `json:"source-prefix,omitempty"`
+	// DestinationPrefix is the prefix the destination address is in
+	DestinationPrefix string        `json:"destination-prefix,omitempty"`
+	SourcePort        *AclPortMatch `json:"source-port,omitempty"`
+	DestinationPort   *AclPortMatch `json:"destination-port,omitempty"`
+	// DSCP are the dscp values, 0 to 63, matched
+	DSCP []uint8 `json:"dscp,omitempty"`
+	// SourceMAC is the source mac address matched
+	SourceMAC *AclMacMatch `json:"source-mac,omitempty"`
+	// DestinationMAC is the destination mac address matched
+	DestinationMAC *AclMacMatch `json:"destination-mac,omitempty"`
+	// Ethertype is the ethertype matched, a number from 1536 to 65535 or one of
+	// arp, ipv4, ipv6, lldp, mpls-unicast, mpls-multicast, pbb or slow-protocols
+	Ethertype *intstr.IntOrString `json:"ethertype,omitempty"`
+}
+
+// AclMacMatch matches a mac address, the bits that are set in Mask are compared
+type AclMacMatch struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}$`
+	Address string `json:"address"`
+	// Mask defaults to ff:ff:ff:ff:ff:ff on the device
+	// +kubebuilder:validation:Pattern=`^[0-9a-fA-F]{2}(:[0-9a-fA-F]{2}){5}$`
+	Mask string `json:"mask,omitempty"`
+}
+
+// AclPortMatch matches a tcp or udp port against a value or a range
+type AclPortMatch struct {
+	// Operator compares the port with Value
+	// +kubebuilder:validation:Enum=eq;ge;le
+	// +kubebuilder:default=eq
+	Operator string `json:"operator,omitempty"`
+	// Value is the port compared, it is exclusive with Range
+	Value *uint16 `json:"value,omitempty"`
+	// Range is the range of ports matched, it is exclusive with Value
+	Range *AclPortRange `json:"range,omitempty"`
+}
+
+// AclPortRange defines an inclusive range of ports
+type AclPortRange struct {
+	Start uint16 `json:"start"`
+	End   uint16 `json:"end"`
+}
+
+// AclBinding applies the filter to the traffic of a subinterface in a direction
+type AclBinding struct {
+	// Subinterface is the name of the subinterface, e.g. ethernet-1/1.10
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^.+\.[0-9]+$`
+	Subinterface string `json:"subinterface"`
+	// Direction is input for the traffic received on the subinterface or output for
+	// the traffic sent
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=input;output
+	Direction string `json:"direction"`
+}
+
+// AclEntrySequenceID defines the sequence id assigned to an entry
+type AclEntrySequenceID struct {
+	Name       string `json:"name"`
+	SequenceID uint32 `json:"sequenceID"`
+}
+
+// AclEntryState defines the statistics of an entry on a device
+type AclEntryState struct {
+	Name       string `json:"name"`
+	SequenceID uint32 `json:"sequenceID"`
+	// MatchedPackets is the number of packets that matched the entry
+	MatchedPackets uint64 `json:"matchedPackets"`
+	// LastMatch is the time a packet last matched the entry
+	LastMatch string `json:"lastMatch,omitempty"`
+}
+
+// AclDeviceStatus defines the observed state of Acl on a single device
+type AclDeviceStatus struct {
+	DeviceResult `json:",inline"`
+	// AppliedType is the type of the filter last applied, the filter is removed
+	// when the spec changes its type
+	AppliedType string `json:"appliedType,omitempty"`
+	// AppliedEntries and AppliedBindings hold the entries and the bindings last
+	// applied to the device, the ones removed from the spec are deleted. An entry
+	// whose sequence id is taken over by another entry is deleted as well so its
+	// statistics start from zero.
+	AppliedEntries  []AclEntrySequenceID `json:"appliedEntries,omitempty"`
+	AppliedBindings []AclBinding         `json:"appliedBindings,omitempty"`
+	Entries         []AclEntryState      `json:"entries,omitempty"`
+}
+
+// AclStatus defines the observed state of Acl
+type AclStatus struct {
+	// SequenceIDs holds the sequence ids assigned to the entries, they are kept
+	// when the entries are edited
+	SequenceIDs []AclEntrySequenceID `json:"sequenceIDs,omitempty"`
+	// Devices holds the result of applying the Acl and the statistics of the entries
+	// read back from each of the targeted devices
+	Devices []AclDeviceStatus `json:"devices,omitempty"`
+	// ObservedGeneration is the metadata.generation of the Acl the status was
+	// computed for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions holds the latest observations of the state of the Acl
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason"
+
+// Acl is the Schema for the acls API
+type Acl struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AclSpec   `json:"spec,omitempty"`
+	Status AclStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AclList contains a list of Acl
+type AclList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Acl `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Acl{}, &AclList{})
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// BgpSpec defines the desired state of Bgp
+type BgpSpec struct {
+	// TargetRef selects the Devices the configuration is applied to
+	// +kubebuilder:validation:Required
+	TargetRef TargetRef `json:"targetRef"`
+	// NetworkInstance is the network instance BGP runs in, the name of a
+	// NetworkInstance or of a network instance configured on the devices
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:default=default
+	NetworkInstance string `json:"network-instance,omitempty"`
+	// +kubebuilder:validation:Enum=enable;disable
+	// +kubebuilder:default=enable
+	AdminState string `json:"admin-state,omitempty"`
+	// AutonomousSystem is the local autonomous system number
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	AutonomousSystem uint32 `json:"autonomous-system"`
+	// RouterID is the BGP identifier of the devices
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Format=ipv4
+	RouterID string `json:"router-id"`
+	// AddressFamilies are the address families enabled for all the neighbors, the
+	// others are disabled. The defaults of the devices apply when it is not set.
+	AddressFamilies []BgpAddressFamily `json:"address-families,omitempty"`
+	BgpPolicies     `json:",inline"`
+	// Groups are the peer groups holding the settings shared by their neighbors
+	Groups []BgpGroup `json:"groups,omitempty"`
+	// Neighbors are the peers of the devices
+	Neighbors []BgpNeighbor `json:"neighbors,omitempty"`
+}
+
+// BgpAddressFamily is a BGP address family
+// +kubebuilder:validation:Enum=ipv4-unicast;ipv6-unicast
+type BgpAddressFamily string
+
+// BgpPolicies are the names of the routing policies applied to the routes
+// exchanged with the neighbors
+type BgpPolicies struct {
+	// ImportPolicy is applied to the routes received from the neighbors
+	// +kubebuilder:validation:MinLength=1
+	ImportPolicy string `json:"import-policy,omitempty"`
+	// ExportPolicy is applied to the routes advertised to the neighbors
+	// +kubebuilder:validation:MinLength=1
+	ExportPolicy string `json:"export-policy,omitempty"`
+}
+
+// BgpGroup defines a BGP peer group
+type BgpGroup struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Description string `json:"description,omitempty"`
+	// +kubebuilder:validation:Enum=enable;disable
+	// +kubebuilder:default=enable
+	AdminState string `json:"admin-state,omitempty"`
+	// PeerAS is the autonomous system number of the neighbors in the group
+	// +kubebuilder:validation:Minimum=1
+	PeerAS uint32 `json:"peer-as,omitempty"`
+	// AddressFamilies are the address families enabled for the neighbors in the
+	// group, they are inherited from the spec when it is not set
+	AddressFamilies []BgpAddressFamily `json:"address-families,omitempty"`
+	BgpPolicies     `json:",inline"`
+}
+
+// BgpNeighbor defines a BGP peer, the settings that are not set are inherited
+// from its peer group
+type BgpNeighbor struct {
+	// PeerAddress is the ipv4 or ipv6 address of the neighbor
+	// +kubebuilder:validation:Required
+	PeerAddress string `json:"peer-address"`
+	// PeerGroup is the name of the group the neighbor belongs to
+	// +kubebuilder:validation:Required
+	PeerGroup string `json:"peer-group"`
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Description string `json:"description,omitempty"`
+	// +kubebuilder:validation:Enum=enable;disable
+	// +kubebuilder:default=enable
+	AdminState string `json:"admin-state,omitempty"`
+	// +kubebuilder:validation:Minimum=1
+	PeerAS          uint32             `json:"peer-as,omitempty"`
+	AddressFamilies []BgpAddressFamily `json:"address-families,omitempty"`
+	BgpPolicies     `json:",inline"`
+}
+
+// BgpAddressFamilyState defines the routes exchanged with a neighbor in an address
+// family
+type BgpAddressFamilyState struct {
+	Name BgpAddressFamily `json:"name"`
+	// +kubebuilder:validation:Enum=enable;disable
+	AdminState string `json:"adminState,omitempty"`
+	// ReceivedRoutes is the number of routes received from the neighbor
+	ReceivedRoutes uint32 `json:"receivedRoutes"`
+	// ActiveRoutes is the number of received routes used for forwarding
+	ActiveRoutes uint32 `json:"activeRoutes"`
+	// SentRoutes is the number of routes advertised to the neighbor
+	SentRoutes uint32 `json:"sentRoutes"`
+}
+
+// BgpNeighborState defines the state of a BGP session
+type BgpNeighborState struct {
+	PeerAddress string `json:"peerAddress"`
+	PeerGroup   string `json:"peerGroup,omitempty"`
+	PeerAS      uint32 `json:"peerAS,omitempty"`
+	// +kubebuilder:validation:Enum=idle;connect;active;opensent;openconfirm;established
+	SessionState string `json:"sessionState,omitempty"`
+	// LastEstablished is the time the session was last established
+	LastEstablished string `json:"lastEstablished,omitempty"`
+	// Uptime is the time the session has been established for when the status was
+	// written, e.g. 26h3m12s
+	Uptime          string                  `json:"uptime,omitempty"`
+	AddressFamilies []BgpAddressFamilyState `json:"addressFamilies,omitempty"`
+}
+
+// BgpDeviceStatus defines the observed state of Bgp on a single device
+type BgpDeviceStatus struct {
+	DeviceResult `json:",inline"`
+	// AppliedNetworkInstance is the network instance BGP was last applied to, BGP
+	// is removed from it when the spec moves to another network instance
+	AppliedNetworkInstance string `json:"appliedNetworkInstance,omitempty"`
+	// AppliedGroups and AppliedNeighbors hold the peer groups and neighbors last
+	// applied to the device, the ones removed from the spec are deleted
+	AppliedGroups    []string `json:"appliedGroups,omitempty"`
+	AppliedNeighbors []string `json:"appliedNeighbors,omitempty"`
+	// +kubebuilder:validation:Enum=enable;disable
+	AdminState string `json:"adminState,omitempty"`
+	// +kubebuilder:validation:Enum=up;down;empty;downloading;booting;starting;failed;synchronizing;upgrading
+	OperState string             `json:"operState,omitempty"`
+	Neighbors []BgpNeighborState `json:"neighbors,omitempty"`
+}
+
+// BgpStatus defines the observed state of Bgp
+type BgpStatus struct {
+	// Devices holds the result of applying the Bgp and the session state of the
+	// neighbors of each of the targeted devices
+	Devices []BgpDeviceStatus `json:"devices,omitempty"`
+	// ObservedGeneration is the metadata.generation of the Bgp the status was
+	// computed for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions holds the latest observations of the state of the Bgp
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Network Instance",type="string",JSONPath=".spec.network-instance"
+// +kubebuilder:printcolumn:name="AS",type="integer",JSONPath=".spec.autonomous-system"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason"
+
+// Bgp is the Schema for the bgps API
+type Bgp struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BgpSpec   `json:"spec,omitempty"`
+	Status BgpStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BgpList contains a list of Bgp
+type BgpList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Bgp `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Bgp{}, &BgpList{})
+}
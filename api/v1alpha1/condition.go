@@ -0,0 +1,97 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionType is the type of a Condition
+type ConditionType string
+
+const (
+	// ConditionTypeReady reports that the resource is applied to all the targeted
+	// devices and their state matches it
+	ConditionTypeReady ConditionType = "Ready"
+	// ConditionTypeApplied reports that the current generation of the resource was
+	// accepted by all the targeted devices
+	ConditionTypeApplied ConditionType = "Applied"
+	// ConditionTypeSynced reports that the state read back from the targeted devices
+	// matches the resource
+	ConditionTypeSynced ConditionType = "Synced"
+	// ConditionTypeDegraded reports devices that failed or are out of sync
+	ConditionTypeDegraded ConditionType = "Degraded"
+	// ConditionTypeDeleted reports the removal of the configuration from the
+	// devices when the resource is deleted
+	ConditionTypeDeleted ConditionType = "Deleted"
+	// ConditionTypeDrifted reports configuration that was changed on the devices
+	// outside of the operator
+	ConditionTypeDrifted ConditionType = "Drifted"
+)
+
+// Condition describes one aspect of the observed state of a resource. It has the
+// same layout as the metav1.Condition of newer apimachinery releases.
+type Condition struct {
+	// Type of the condition
+	// +kubebuilder:validation:Required
+	Type ConditionType `json:"type"`
+	// Status of the condition, one of True, False or Unknown
+	// +kubebuilder:validation:Enum=True;False;Unknown
+	Status corev1.ConditionStatus `json:"status"`
+	// ObservedGeneration is the metadata.generation the condition was set for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// LastTransitionTime is the last time the status of the condition changed
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// Reason is a CamelCase reason for the last transition
+	Reason string `json:"reason,omitempty"`
+	// Message is a human readable message with details about the last transition
+	Message string `json:"message,omitempty"`
+}
+
+// SetCondition adds c to conditions or updates the condition of the same type.
+// LastTransitionTime is only changed when the status of the condition changes.
+func SetCondition(conditions *[]Condition, c Condition) {
+	for i := range *conditions {
+		existing := &(*conditions)[i]
+		if existing.Type != c.Type {
+			continue
+		}
+		if existing.Status != c.Status || existing.LastTransitionTime.IsZero() {
+			existing.LastTransitionTime = metav1.Now()
+		}
+		existing.Status = c.Status
+		existing.ObservedGeneration = c.ObservedGeneration
+		existing.Reason = c.Reason
+		existing.Message = c.Message
+		return
+	}
+	if c.LastTransitionTime.IsZero() {
+		c.LastTransitionTime = metav1.Now()
+	}
+	*conditions = append(*conditions, c)
+}
+
+// FindCondition returns the condition of type t, or nil if it is not set
+func FindCondition(conditions []Condition, t ConditionType) *Condition {
+	for i := range conditions {
+		if conditions[i].Type == t {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// DeviceTLS defines the TLS settings used to connect to the device
+type DeviceTLS struct {
+	// Insecure disables TLS and connects to the device in plain text
+	Insecure bool `json:"insecure,omitempty"`
+	// SkipVerify disables the verification of the device certificate
+	SkipVerify bool `json:"skipVerify,omitempty"`
+	// SecretName is the name of a Secret in the namespace of the Device holding
+	// the client certificate and key (tls.crt and tls.key) and/or the CA bundle
+	// (ca.crt) used to verify the device certificate
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// DeviceSpec defines the desired state of Device
+type DeviceSpec struct {
+	// Address is the IP address or hostname of the gNMI server of the device
+	// +kubebuilder:validation:Required
+	Address string `json:"address"`
+	// Port is the port of the gNMI server of the device
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port uint32 `json:"port,omitempty"`
+	// Encoding is the gNMI encoding used for the payloads sent to the device
+	// +kubebuilder:validation:Enum=JSON;JSON_IETF
+	Encoding string `json:"encoding,omitempty"`
+	// TLS defines the TLS settings used to connect to the device
+	TLS DeviceTLS `json:"tls,omitempty"`
+	// CredentialsName is the name of a kubernetes.io/basic-auth Secret in the namespace
+	// of the Device holding the username and password used to authenticate to the device
+	CredentialsName string `json:"credentialsName,omitempty"`
+}
+
+// TargetRef selects the Devices a resource is applied to. Devices listed by name
+// and Devices matching the selector are combined.
+type TargetRef struct {
+	// Devices is a list of names of Devices in the namespace of the resource
+	Devices []string `json:"devices,omitempty"`
+	// DeviceSelector is a label selector over the Devices in the namespace of the resource
+	DeviceSelector *metav1.LabelSelector `json:"deviceSelector,omitempty"`
+}
+
+// DeviceModel is a YANG model supported by the device
+type DeviceModel struct {
+	Name         string `json:"name"`
+	Organization string `json:"organization,omitempty"`
+	Version      string `json:"version,omitempty"`
+}
+
+// Selects returns true if the ref selects dev
+func (r *TargetRef) Selects(dev metav1.Object) bool {
+	for _, name := range r.Devices {
+		if name == dev.GetName() {
+			return true
+		}
+	}
+	if r.DeviceSelector == nil {
+		return false
+	}
+	selector, err := metav1.LabelSelectorAsSelector(r.DeviceSelector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(dev.GetLabels()))
+}
+
+// DeviceResult is the result of applying a resource to one of the Devices
+// selected by its TargetRef
+type DeviceResult struct {
+	// Name is the name of the Device
+	Name string `json:"name"`
+	// Result is Applied, Failed for errors that are retried, e.g. an unreachable
+	// device, or Rejected for configuration the device refused
+	// +kubebuilder:validation:Enum=Applied;Failed;Rejected
+	Result string `json:"result,omitempty"`
+	// Message holds the error returned by the device when the resource could not be
+	// applied, or the reason the state could not be read back when it was applied
+	Message string `json:"message,omitempty"`
+	// AppliedGeneration is the metadata.generation of the resource last applied to
+	// the device, or rejected by it
+	AppliedGeneration int64 `json:"appliedGeneration,omitempty"`
+}
+
+// GetDeviceResult returns r, it is promoted to the device statuses of the resources
+// embedding a DeviceResult
+func (r *DeviceResult) GetDeviceResult() *DeviceResult {
+	return r
+}
+
+// DeviceStatus defines the observed state of Device
+type DeviceStatus struct {
+	// Target is the address:port the operator connects to
+	Target string `json:"target,omitempty"`
+	// Release is the SR Linux release reported by the device
+	Release string `json:"release,omitempty"`
+	// GnmiVersion is the gNMI version reported by the device
+	GnmiVersion string `json:"gnmiVersion,omitempty"`
+	// SupportedEncodings are the gNMI encodings supported by the device
+	SupportedEncodings []string `json:"supportedEncodings,omitempty"`
+	// SupportedModels are the YANG models supported by the device
+	SupportedModels []DeviceModel `json:"supportedModels,omitempty"`
+	// NetworkInstances are the names of the network instances configured on the device
+	NetworkInstances []string `json:"networkInstances,omitempty"`
+	// Conditions holds the latest observations of the state of the Device
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=".status.target"
+// +kubebuilder:printcolumn:name="Release",type="string",JSONPath=".status.release"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason"
+
+// Device is the Schema for the devices API
+type Device struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DeviceSpec   `json:"spec,omitempty"`
+	Status DeviceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DeviceList contains a list of Device
+type DeviceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Device `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Device{}, &DeviceList{})
+}
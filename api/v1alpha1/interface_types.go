@@ -0,0 +1,117 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// InterfaceSpec defines the desired state of Interface
+type InterfaceSpec struct {
+	// TargetRef selects the Devices the configuration is applied to
+	// +kubebuilder:validation:Required
+	TargetRef TargetRef `json:"targetRef"`
+	// Name is the name of the interface on the devices, e.g. ethernet-1/1, lag1,
+	// irb0 or lo0
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(mgmt0|ethernet-[1-9][0-9]?/[1-9][0-9]?[0-9]?|lag[1-9][0-9]?[0-9]?|irb[0-9][0-9]?[0-9]?|lo[0-9][0-9]?[0-9]?)$`
+	Name string `json:"name"`
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Description string `json:"description,omitempty"`
+	// +kubebuilder:validation:Enum=enable;disable
+	// +kubebuilder:default=enable
+	AdminState string `json:"admin-state,omitempty"`
+	// MTU is the port MTU in bytes, the device default is used when it is not set
+	// +kubebuilder:validation:Minimum=1500
+	// +kubebuilder:validation:Maximum=9500
+	MTU uint16 `json:"mtu,omitempty"`
+	// VlanTagging allows vlan tagged subinterfaces on the interface
+	VlanTagging bool `json:"vlan-tagging,omitempty"`
+}
+
+// InterfaceCounters are the statistics of an interface or a subinterface
+type InterfaceCounters struct {
+	InOctets            uint64 `json:"inOctets,omitempty"`
+	InUnicastPackets    uint64 `json:"inUnicastPackets,omitempty"`
+	InErrorPackets      uint64 `json:"inErrorPackets,omitempty"`
+	InDiscardedPackets  uint64 `json:"inDiscardedPackets,omitempty"`
+	OutOctets           uint64 `json:"outOctets,omitempty"`
+	OutUnicastPackets   uint64 `json:"outUnicastPackets,omitempty"`
+	OutErrorPackets     uint64 `json:"outErrorPackets,omitempty"`
+	OutDiscardedPackets uint64 `json:"outDiscardedPackets,omitempty"`
+}
+
+// InterfaceDeviceStatus defines the observed state of Interface on a single device
+type InterfaceDeviceStatus struct {
+	DeviceResult `json:",inline"`
+	// AppliedName is the name of the interface last applied to the device, the
+	// configuration of the interface is removed when the spec renames it
+	AppliedName string `json:"appliedName,omitempty"`
+	// +kubebuilder:validation:Enum=enable;disable
+	AdminState string `json:"adminState,omitempty"`
+	// +kubebuilder:validation:Enum=up;down;empty;downloading;booting;starting;failed;synchronizing;upgrading
+	OperState string `json:"operState,omitempty"`
+	// OperDownReason is the reason the interface is down
+	OperDownReason string `json:"operDownReason,omitempty"`
+	// Speed is the speed of the port, e.g. 100G
+	Speed string `json:"speed,omitempty"`
+	MTU   uint16 `json:"mtu,omitempty"`
+	// LastChange is the time of the last change of the oper-state
+	LastChange string             `json:"lastChange,omitempty"`
+	Counters   *InterfaceCounters `json:"counters,omitempty"`
+}
+
+// InterfaceStatus defines the observed state of Interface
+type InterfaceStatus struct {
+	// Devices holds the result of applying the Interface and the interface state
+	// read back from each of the targeted devices
+	Devices []InterfaceDeviceStatus `json:"devices,omitempty"`
+	// ObservedGeneration is the metadata.generation of the Interface the status was
+	// computed for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions holds the latest observations of the state of the Interface
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Interface",type="string",JSONPath=".spec.name"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason"
+
+// Interface is the Schema for the interfaces API
+type Interface struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   InterfaceSpec   `json:"spec,omitempty"`
+	Status InterfaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// InterfaceList contains a list of Interface
+type InterfaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Interface `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Interface{}, &InterfaceList{})
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NetworkInstanceSpec defines the desired state of NetworkInstance. The network
+// instance is named after the NetworkInstance on the devices, so other resources
+// refer to it by the name of the NetworkInstance.
+type NetworkInstanceSpec struct {
+	// TargetRef selects the Devices the configuration is applied to
+	// +kubebuilder:validation:Required
+	TargetRef TargetRef `json:"targetRef"`
+	// Type is default for the default routing instance of the devices, ip-vrf for a
+	// routing instance with its own route table or mac-vrf for a bridging instance
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=default;ip-vrf;mac-vrf
+	Type string `json:"type"`
+	// +kubebuilder:validation:Enum=enable;disable
+	// +kubebuilder:default=enable
+	AdminState string `json:"admin-state,omitempty"`
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Description string `json:"description,omitempty"`
+	// RouterID is the ipv4 address that identifies the network instance in the
+	// routing protocols
+	// +kubebuilder:validation:Format=ipv4
+	RouterID string `json:"router-id,omitempty"`
+	// Interfaces are the names of the subinterfaces attached to the network instance,
+	// e.g. ethernet-1/1.10
+	Interfaces []string `json:"interfaces,omitempty"`
+}
+
+// NetworkInstanceInterfaceState defines the state of a subinterface attached to a
+// network instance
+type NetworkInstanceInterfaceState struct {
+	Name string `json:"name"`
+	// +kubebuilder:validation:Enum=up;down;empty;downloading;booting;starting;failed;synchronizing;upgrading
+	OperState string `json:"operState,omitempty"`
+}
+
+// NetworkInstanceDeviceStatus defines the observed state of NetworkInstance on a
+// single device
+type NetworkInstanceDeviceStatus struct {
+	DeviceResult `json:",inline"`
+	// AppliedInterfaces holds the subinterfaces last attached to the network instance
+	// on the device, subinterfaces that are removed from the spec are detached
+	AppliedInterfaces []string `json:"appliedInterfaces,omitempty"`
+	Type              string   `json:"type,omitempty"`
+	// +kubebuilder:validation:Enum=enable;disable
+	AdminState string `json:"adminState,omitempty"`
+	// +kubebuilder:validation:Enum=up;down;empty;downloading;booting;starting;failed;synchronizing;upgrading
+	OperState  string                          `json:"operState,omitempty"`
+	RouterID   string                          `json:"routerID,omitempty"`
+	Interfaces []NetworkInstanceInterfaceState `json:"interfaces,omitempty"`
+}
+
+// NetworkInstanceStatus defines the observed state of NetworkInstance
+type NetworkInstanceStatus struct {
+	// Devices holds the result of applying the NetworkInstance and the network
+	// instance state read back from each of the targeted devices
+	Devices []NetworkInstanceDeviceStatus `json:"devices,omitempty"`
+	// ObservedGeneration is the metadata.generation of the NetworkInstance the status
+	// was computed for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions holds the latest observations of the state of the NetworkInstance
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=ni
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason"
+
+// NetworkInstance is the Schema for the networkinstances API
+type NetworkInstance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NetworkInstanceSpec   `json:"spec,omitempty"`
+	Status NetworkInstanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NetworkInstanceList contains a list of NetworkInstance
+type NetworkInstanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NetworkInstance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NetworkInstance{}, &NetworkInstanceList{})
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NextHopGroupSpec defines the desired state of NextHopGroup. The next-hop group is
+// named after the NextHopGroup on the devices, so StaticRoutes refer to it by the
+// name of the NextHopGroup.
+type NextHopGroupSpec struct {
+	// TargetRef selects the Devices the configuration is applied to
+	// +kubebuilder:validation:Required
+	TargetRef TargetRef `json:"targetRef"`
+	// NetworkInstance is the network instance holding the next-hop group, the name
+	// of a NetworkInstance or of a network instance configured on the devices
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:default=default
+	NetworkInstance string `json:"network-instance,omitempty"`
+	// +kubebuilder:validation:Enum=enable;disable
+	// +kubebuilder:default=enable
+	AdminState string `json:"admin-state,omitempty"`
+	// NextHops are the next-hops traffic is load balanced over
+	// +kubebuilder:validation:MinItems=1
+	NextHops []NextHop `json:"nexthops"`
+}
+
+// NextHop defines a next-hop of a next-hop group
+type NextHop struct {
+	// Index identifies the next-hop within the group
+	// +kubebuilder:validation:Required
+	Index uint16 `json:"index"`
+	// IPAddress is the ipv4 or ipv6 address of the next-hop
+	// +kubebuilder:validation:Required
+	IPAddress string `json:"ip-address"`
+	// +kubebuilder:validation:Enum=enable;disable
+	// +kubebuilder:default=enable
+	AdminState string `json:"admin-state,omitempty"`
+	// Resolve allows the next-hop address to be resolved through the route table,
+	// otherwise it has to be on a local subnet
+	// +kubebuilder:default=true
+	Resolve *bool `json:"resolve,omitempty"`
+}
+
+// NextHopGroupDeviceStatus defines the observed state of NextHopGroup on a single
+// device
+type NextHopGroupDeviceStatus struct {
+	DeviceResult `json:",inline"`
+	// AppliedNetworkInstance is the network instance the next-hop group was last
+	// applied to, the group is removed from it when the spec moves it to another
+	// network instance
+	AppliedNetworkInstance string `json:"appliedNetworkInstance,omitempty"`
+	// AppliedNextHops holds the indexes of the next-hops last applied to the device,
+	// next-hops that are removed from the spec are deleted from the device
+	AppliedNextHops []uint16 `json:"appliedNextHops,omitempty"`
+}
+
+// NextHopGroupStatus defines the observed state of NextHopGroup
+type NextHopGroupStatus struct {
+	// Devices holds the result of applying the NextHopGroup to each of the targeted
+	// devices
+	Devices []NextHopGroupDeviceStatus `json:"devices,omitempty"`
+	// ObservedGeneration is the metadata.generation of the NextHopGroup the status
+	// was computed for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions holds the latest observations of the state of the NextHopGroup
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=nhg
+// +kubebuilder:printcolumn:name="Network Instance",type="string",JSONPath=".spec.network-instance"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason"
+
+// NextHopGroup is the Schema for the nexthopgroups API
+type NextHopGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NextHopGroupSpec   `json:"spec,omitempty"`
+	Status NextHopGroupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NextHopGroupList contains a list of NextHopGroup
+type NextHopGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NextHopGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NextHopGroup{}, &NextHopGroupList{})
+}
@@ -27,9 +27,9 @@ import (
 type NtpServer struct {
 	// +kubebuilder:validation:Required
 	Address string `json:"address"`
-	// +kubebuilder:validation:Default:false
+	// +kubebuilder:default=false
 	IBurst bool `json:"iburst,omitempty"`
-	// +kubebuilder:validation:Default:false
+	// +kubebuilder:default=false
 	Prefer bool `json:"prefer,omitempty"`
 }
 
@@ -38,20 +38,40 @@ type NtpSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
+	// TargetRef selects the Devices the configuration is applied to
+	// +kubebuilder:validation:Required
+	TargetRef TargetRef `json:"targetRef"`
 	// +kubebuilder:validation:Enum=enable;disable
+	// +kubebuilder:default=enable
 	AdminState string `json:"admin-state,omitempty"`
-	// +kubebuilder:validation:Required
-	NetworkInstance string      `json:"network-instance"`
+	// NetworkInstance is the network instance the servers are reached through, the
+	// name of a NetworkInstance or of a network instance configured on the devices
+	// +kubebuilder:default=mgmt
+	NetworkInstance string      `json:"network-instance,omitempty"`
 	Server          []NtpServer `json:"server,omitempty"`
+	// Baseline is the ntp configuration restored on the devices when the Ntp is
+	// deleted. When not set, the servers of the Ntp are removed from the devices.
+	Baseline *NtpBaseline `json:"baseline,omitempty"`
+	// DriftPolicy defines what happens when the ntp configuration of a device no
+	// longer matches the spec: ignore it, report it in the Drifted condition, or
+	// report it and re-apply the spec. Defaults to report.
+	// +kubebuilder:validation:Enum=ignore;report;remediate
+	// +kubebuilder:default=report
+	DriftPolicy string `json:"driftPolicy,omitempty"`
+}
+
+// NtpBaseline defines the ntp configuration restored when an Ntp is deleted
+type NtpBaseline struct {
+	// +kubebuilder:validation:Enum=enable;disable
+	AdminState string      `json:"admin-state,omitempty"`
+	Server     []NtpServer `json:"server,omitempty"`
 }
 
 // NtpServerState defines the NTP server state
 type NtpServerState struct {
 	// +kubebuilder:validation:Required
-	Address string `json:"address"`
-	// +kubebuilder:validation:Default:false
-	IBurst bool `json:"iBurst,omitempty"`
-	// +kubebuilder:validation:Default:false
+	Address      string `json:"address"`
+	IBurst       bool   `json:"iBurst,omitempty"`
 	Prefer       bool   `json:"prefer,omitempty"`
 	Stratum      uint8  `json:"stratum,omitempty"`
 	Jitter       string `json:"jitter,omitempty"`
@@ -59,22 +79,50 @@ type NtpServerState struct {
 	PollInterval uint16 `json:"pollInterval,omitempty"`
 }
 
-// NtpStatus defines the observed state of Ntp
-type NtpStatus struct {
-	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
-	// Important: Run "make" to regenerate code after modifying this file
+// NtpDeviceStatus defines the observed state of Ntp on a single device
+type NtpDeviceStatus struct {
+	// Name is the name of the Device
+	Name string `json:"name"`
+	// Result is Applied, Failed for errors that are retried, e.g. an unreachable
+	// device, or Rejected for configuration the device refused
+	// +kubebuilder:validation:Enum=Applied;Failed;Rejected
+	Result string `json:"result,omitempty"`
+	// Message holds the error returned by the device when the Ntp could not be applied,
+	// or the reason the ntp state could not be read back when it was applied
+	Message string `json:"message,omitempty"`
+	// AppliedServers holds the addresses of the servers last applied to the device,
+	// servers that are removed from the spec are deleted from the device
+	AppliedServers []string `json:"appliedServers,omitempty"`
+	// AppliedGeneration is the metadata.generation of the Ntp last applied to the device,
+	// or rejected by it
+	AppliedGeneration int64 `json:"appliedGeneration,omitempty"`
 	// +kubebuilder:validation:Enum=enable;disable
 	AdminState string `json:"adminState,omitempty"`
 	// +kubebuilder:validation:Enum=up;down;empty;downloading;booting;starting;failed;synchronizing;upgrading
-	OperState    string `json:"operState,omitempty"`
-	Synchronized string `json:"synchronized,omitempty"`
-	// +kubebuilder:validation:Required
-	NetworkInstance string           `json:"networkInstance"`
+	OperState       string           `json:"operState,omitempty"`
+	Synchronized    string           `json:"synchronized,omitempty"`
+	NetworkInstance string           `json:"networkInstance,omitempty"`
 	Server          []NtpServerState `json:"server,omitempty"`
 }
 
+// NtpStatus defines the observed state of Ntp
+type NtpStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Devices holds the result of applying the Ntp and the ntp state read back from
+	// each of the targeted devices
+	Devices []NtpDeviceStatus `json:"devices,omitempty"`
+	// ObservedGeneration is the metadata.generation of the Ntp the status was computed for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions holds the latest observations of the state of the Ntp
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason"
 
 // Ntp is the Schema for the ntps API
 type Ntp struct {
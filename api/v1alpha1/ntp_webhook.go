@@ -0,0 +1,221 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+const (
+	// MaxNtpServers is the maximum number of entries in /system/ntp/server on SR Linux
+	MaxNtpServers = 8
+
+	// DefaultNtpAdminState is the admin-state of an Ntp that does not set one
+	DefaultNtpAdminState = "enable"
+	// DefaultNtpNetworkInstance is the network instance of an Ntp that does not set one
+	DefaultNtpNetworkInstance = "mgmt"
+	// DefaultNtpDriftPolicy is the drift policy of an Ntp that does not set one
+	DefaultNtpDriftPolicy = "report"
+)
+
+// log is for logging in this package.
+var ntplog = logf.Log.WithName("ntp-resource")
+
+// webhookClient is used by the webhooks to look up the Devices an object targets
+var webhookClient client.Client
+
+// NtpConfigValidator validates the device configuration of an Ntp spec against the
+// YANG schema of the devices. It is set when a schema is loaded.
+var NtpConfigValidator func(spec *NtpSpec, fldPath *field.Path) field.ErrorList
+
+// SetupWebhookWithManager registers the Ntp webhooks with the manager
+func (r *Ntp) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	webhookClient = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-srlinux-henderiw-be-v1alpha1-ntp,mutating=true,failurePolicy=fail,groups=srlinux.henderiw.be,resources=ntps,verbs=create;update,versions=v1alpha1,name=mntp.kb.io
+
+var _ webhook.Defaulter = &Ntp{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type.
+// The reconciler applies the same defaults to Ntps created without the webhook.
+func (r *Ntp) Default() {
+	if r.Spec.AdminState == "" {
+		r.Spec.AdminState = DefaultNtpAdminState
+	}
+	if r.Spec.NetworkInstance == "" {
+		r.Spec.NetworkInstance = DefaultNtpNetworkInstance
+	}
+	if r.Spec.DriftPolicy == "" {
+		r.Spec.DriftPolicy = DefaultNtpDriftPolicy
+	}
+}
+
+// +kubebuilder:webhook:verbs=create;update,path=/validate-srlinux-henderiw-be-v1alpha1-ntp,mutating=false,failurePolicy=fail,groups=srlinux.henderiw.be,resources=ntps,versions=v1alpha1,name=vntp.kb.io
+
+var _ webhook.Validator = &Ntp{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *Ntp) ValidateCreate() error {
+	ntplog.Info("validate create", "name", r.Name)
+	return r.validateNtp()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type.
+// Updates that leave the spec alone, e.g. removing the finalizer, are not validated
+// so that an Ntp can be deleted after its network instance is gone.
+func (r *Ntp) ValidateUpdate(old runtime.Object) error {
+	ntplog.Info("validate update", "name", r.Name)
+	if !r.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	if oldNtp, ok := old.(*Ntp); ok && reflect.DeepEqual(oldNtp.Spec, r.Spec) {
+		return nil
+	}
+	return r.validateNtp()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *Ntp) ValidateDelete() error {
+	return nil
+}
+
+func (r *Ntp) validateNtp() error {
+	specPath := field.NewPath("spec")
+	allErrs := validateNtpServers(specPath.Child("server"), r.Spec.Server)
+	if r.Spec.Baseline != nil {
+		allErrs = append(allErrs, validateNtpServers(specPath.Child("baseline", "server"), r.Spec.Baseline.Server)...)
+	}
+	allErrs = append(allErrs, r.validateNetworkInstance(specPath.Child("network-instance"))...)
+	if len(allErrs) == 0 && NtpConfigValidator != nil {
+		allErrs = NtpConfigValidator(&r.Spec, specPath)
+	}
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(schema.GroupKind{Group: GroupVersion.Group, Kind: "Ntp"}, r.Name, allErrs)
+}
+
+// validateNtpServers checks the addresses, duplicates, preferred servers and
+// number of servers
+func validateNtpServers(fldPath *field.Path, servers []NtpServer) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(servers) > MaxNtpServers {
+		allErrs = append(allErrs, field.TooMany(fldPath, len(servers), MaxNtpServers))
+	}
+	seen := make(map[string]bool, len(servers))
+	var preferred int
+	for i, server := range servers {
+		addrPath := fldPath.Index(i).Child("address")
+		if !validNtpAddress(server.Address) {
+			allErrs = append(allErrs, field.Invalid(addrPath, server.Address, "must be an IP address or a fully qualified domain name"))
+		}
+		key := strings.ToLower(server.Address)
+		if seen[key] {
+			allErrs = append(allErrs, field.Duplicate(addrPath, server.Address))
+		}
+		seen[key] = true
+		if server.Prefer {
+			preferred++
+			if preferred > 1 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("prefer"), server.Prefer, "only one server can be preferred"))
+			}
+		}
+	}
+	return allErrs
+}
+
+// validNtpAddress returns true if address is an IP address or a fully qualified
+// domain name. Single-label names like localhost and names with a numeric top-level
+// label, e.g. a mistyped IP address, are rejected.
+func validNtpAddress(address string) bool {
+	if net.ParseIP(address) != nil {
+		return true
+	}
+	name := strings.ToLower(strings.TrimSuffix(address, "."))
+	if len(validation.IsDNS1123Subdomain(name)) != 0 {
+		return false
+	}
+	i := strings.LastIndex(name, ".")
+	if i < 0 {
+		return false
+	}
+	tld := name[i+1:]
+	return strings.TrimLeft(tld, "0123456789") != ""
+}
+
+// validateNetworkInstance checks that the network instance is known on the targeted
+// Devices, either reported by the Device or created by a NetworkInstance of the same
+// name that targets the Device. Devices that did not report their network instances
+// yet are skipped.
+func (r *Ntp) validateNetworkInstance(fldPath *field.Path) field.ErrorList {
+	if webhookClient == nil {
+		return nil
+	}
+	var devices DeviceList
+	if err := webhookClient.List(context.Background(), &devices, client.InNamespace(r.Namespace)); err != nil {
+		return field.ErrorList{field.InternalError(fldPath, err)}
+	}
+	var ni NetworkInstance
+	hasNi := true
+	if err := webhookClient.Get(context.Background(), client.ObjectKey{Namespace: r.Namespace, Name: r.Spec.NetworkInstance}, &ni); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return field.ErrorList{field.InternalError(fldPath, err)}
+		}
+		hasNi = false
+	}
+	var allErrs field.ErrorList
+	for _, dev := range devices.Items {
+		if !r.Spec.TargetRef.Selects(&dev) || len(dev.Status.NetworkInstances) == 0 {
+			continue
+		}
+		if hasNi && ni.Spec.TargetRef.Selects(&dev) {
+			continue
+		}
+		if !containsString(dev.Status.NetworkInstances, r.Spec.NetworkInstance) {
+			allErrs = append(allErrs, field.NotFound(fldPath, r.Spec.NetworkInstance))
+			ntplog.Info("unknown network instance", "name", r.Name, "device", dev.Name, "network-instance", r.Spec.NetworkInstance)
+			break
+		}
+	}
+	return allErrs
+}
+
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// withWebhookClient runs f with a fake client holding objs as the client of the
+// webhooks
+func withWebhookClient(t *testing.T, f func(), objs ...runtime.Object) {
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	webhookClient = fake.NewFakeClientWithScheme(scheme, objs...)
+	defer func() { webhookClient = nil }()
+	f()
+}
+
+func TestNtpDefault(t *testing.T) {
+	ntp := &Ntp{}
+	ntp.Default()
+	if ntp.Spec.AdminState != DefaultNtpAdminState || ntp.Spec.NetworkInstance != DefaultNtpNetworkInstance ||
+		ntp.Spec.DriftPolicy != DefaultNtpDriftPolicy {
+		t.Errorf("Default() = %+v", ntp.Spec)
+	}
+
+	ntp = &Ntp{Spec: NtpSpec{AdminState: "disable", NetworkInstance: "default", DriftPolicy: "remediate"}}
+	want := ntp.Spec
+	ntp.Default()
+	if ntp.Spec.AdminState != want.AdminState || ntp.Spec.NetworkInstance != want.NetworkInstance ||
+		ntp.Spec.DriftPolicy != want.DriftPolicy {
+		t.Errorf("Default() = %+v, want %+v", ntp.Spec, want)
+	}
+}
+
+func TestNtpValidateServers(t *testing.T) {
+	servers := func(n int) []NtpServer {
+		s := make([]NtpServer, 0, n)
+		for i := 0; i < n; i++ {
+			s = append(s, NtpServer{Address: fmt.Sprintf("10.0.0.%d", i+1)})
+		}
+		return s
+	}
+	tests := []struct {
+		name    string
+		servers []NtpServer
+		wantErr bool
+	}{
+		{name: "max", servers: servers(MaxNtpServers)},
+		{name: "too many", servers: servers(MaxNtpServers + 1), wantErr: true},
+		{name: "fqdn", servers: []NtpServer{{Address: "ntp.example.com."}}},
+		{name: "ipv6", servers: []NtpServer{{Address: "2001:db8::1"}}},
+		{name: "invalid address", servers: []NtpServer{{Address: "not an address"}}, wantErr: true},
+		{name: "single label", servers: []NtpServer{{Address: "ntp"}}, wantErr: true},
+		{name: "localhost", servers: []NtpServer{{Address: "localhost"}}, wantErr: true},
+		{name: "numeric top-level label", servers: []NtpServer{{Address: "10.0.0.256"}}, wantErr: true},
+		{name: "short ip address", servers: []NtpServer{{Address: "10.1"}}, wantErr: true},
+		{name: "duplicate", servers: []NtpServer{{Address: "NTP.example.com"}, {Address: "ntp.example.com"}}, wantErr: true},
+		{name: "preferred", servers: []NtpServer{{Address: "10.0.0.1", Prefer: true}, {Address: "10.0.0.2", Prefer: true}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ntp := &Ntp{Spec: NtpSpec{Server: tt.servers}}
+			ntp.Default()
+			if err := ntp.ValidateCreate(); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestNtpValidateNetworkInstance(t *testing.T) {
+	leaf1 := &Device{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "leaf1"},
+		Status:     DeviceStatus{NetworkInstances: []string{"mgmt", "default"}},
+	}
+	// leaf2 did not report its network instances yet
+	leaf2 := &Device{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "leaf2"}}
+	ni := &NetworkInstance{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "vrf1"},
+		Spec:       NetworkInstanceSpec{TargetRef: TargetRef{Devices: []string{"leaf1"}}},
+	}
+	tests := []struct {
+		name            string
+		device          string
+		networkInstance string
+		wantErr         bool
+	}{
+		{name: "reported", device: "leaf1", networkInstance: "default"},
+		{name: "created", device: "leaf1", networkInstance: "vrf1"},
+		{name: "unknown", device: "leaf1", networkInstance: "vrf2", wantErr: true},
+		{name: "not reported", device: "leaf2", networkInstance: "vrf2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ntp := &Ntp{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ntp"},
+				Spec: NtpSpec{
+					TargetRef:       TargetRef{Devices: []string{tt.device}},
+					NetworkInstance: tt.networkInstance,
+					Server:          []NtpServer{{Address: "10.0.0.1"}},
+				},
+			}
+			withWebhookClient(t, func() {
+				if err := ntp.ValidateCreate(); (err != nil) != tt.wantErr {
+					t.Errorf("ValidateCreate() error = %v, wantErr %v", err, tt.wantErr)
+				}
+			}, leaf1, leaf2, ni)
+		})
+	}
+}
+
+func TestNtpValidateUpdate(t *testing.T) {
+	leaf1 := &Device{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "leaf1"},
+		Status:     DeviceStatus{NetworkInstances: []string{"mgmt"}},
+	}
+	// the network instance of the Ntp was removed
+	old := &Ntp{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ntp", Finalizers: []string{"ntp.srlinux.henderiw.be/cleanup"}},
+		Spec: NtpSpec{
+			TargetRef:       TargetRef{Devices: []string{"leaf1"}},
+			NetworkInstance: "vrf1",
+			Server:          []NtpServer{{Address: "10.0.0.1"}},
+		},
+	}
+	now := metav1.Now()
+
+	withWebhookClient(t, func() {
+		unchanged := old.DeepCopy()
+		unchanged.Finalizers = nil
+		if err := unchanged.ValidateUpdate(old); err != nil {
+			t.Errorf("removing the finalizer: %v", err)
+		}
+
+		deleted := old.DeepCopy()
+		deleted.DeletionTimestamp = &now
+		deleted.Spec.Server = append(deleted.Spec.Server, NtpServer{Address: "10.0.0.2"})
+		if err := deleted.ValidateUpdate(old); err != nil {
+			t.Errorf("updating a deleted ntp: %v", err)
+		}
+
+		changed := old.DeepCopy()
+		changed.Spec.Server = append(changed.Spec.Server, NtpServer{Address: "10.0.0.2"})
+		if err := changed.ValidateUpdate(old); err == nil {
+			t.Error("changing the spec of an ntp with an unknown network instance was accepted")
+		}
+	}, leaf1)
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// StaticRouteSpec defines the desired state of StaticRoute, a set of prefixes
+// routed through the same next-hop group
+type StaticRouteSpec struct {
+	// TargetRef selects the Devices the configuration is applied to
+	// +kubebuilder:validation:Required
+	TargetRef TargetRef `json:"targetRef"`
+	// NetworkInstance is the network instance holding the routes, the name of a
+	// NetworkInstance or of a network instance configured on the devices
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:default=default
+	NetworkInstance string `json:"network-instance,omitempty"`
+	// Prefixes are the ipv4 and ipv6 prefixes routed, e.g. 10.1.0.0/16
+	// +kubebuilder:validation:MinItems=1
+	Prefixes []string `json:"prefixes"`
+	// NextHopGroup is the name of the NextHopGroup, or of a next-hop group
+	// configured on the devices, the prefixes are routed through
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	NextHopGroup string `json:"next-hop-group"`
+	// +kubebuilder:validation:Enum=enable;disable
+	// +kubebuilder:default=enable
+	AdminState string `json:"admin-state,omitempty"`
+	// Metric selects between the static routes to the same prefix, the lowest wins
+	Metric *uint32 `json:"metric,omitempty"`
+	// Preference selects between the routes of the different protocols to the same
+	// prefix, the lowest wins
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=255
+	Preference *uint8 `json:"preference,omitempty"`
+}
+
+// StaticRouteState defines the state of a static route in the route table
+type StaticRouteState struct {
+	Prefix string `json:"prefix"`
+	// Installed is set when the route is active in the route table
+	Installed  bool   `json:"installed"`
+	Metric     uint32 `json:"metric,omitempty"`
+	Preference uint8  `json:"preference,omitempty"`
+}
+
+// StaticRouteDeviceStatus defines the observed state of StaticRoute on a single
+// device
+type StaticRouteDeviceStatus struct {
+	DeviceResult `json:",inline"`
+	// AppliedNetworkInstance is the network instance the routes were last applied
+	// to, they are removed from it when the spec moves them to another network
+	// instance
+	AppliedNetworkInstance string `json:"appliedNetworkInstance,omitempty"`
+	// AppliedPrefixes holds the prefixes last applied to the device, prefixes that
+	// are removed from the spec are deleted from the device
+	AppliedPrefixes []string `json:"appliedPrefixes,omitempty"`
+	// Routes holds the state of the routes read back from the route table
+	Routes []StaticRouteState `json:"routes,omitempty"`
+}
+
+// StaticRouteStatus defines the observed state of StaticRoute
+type StaticRouteStatus struct {
+	// Devices holds the result of applying the StaticRoute and the route table
+	// state read back from each of the targeted devices
+	Devices []StaticRouteDeviceStatus `json:"devices,omitempty"`
+	// ObservedGeneration is the metadata.generation of the StaticRoute the status
+	// was computed for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions holds the latest observations of the state of the StaticRoute
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Network Instance",type="string",JSONPath=".spec.network-instance"
+// +kubebuilder:printcolumn:name="Next Hop Group",type="string",JSONPath=".spec.next-hop-group"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason"
+
+// StaticRoute is the Schema for the staticroutes API
+type StaticRoute struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   StaticRouteSpec   `json:"spec,omitempty"`
+	Status StaticRouteStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// StaticRouteList contains a list of StaticRoute
+type StaticRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []StaticRoute `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&StaticRoute{}, &StaticRouteList{})
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SubinterfaceSpec defines the desired state of Subinterface
+type SubinterfaceSpec struct {
+	// TargetRef selects the Devices the configuration is applied to
+	// +kubebuilder:validation:Required
+	TargetRef TargetRef `json:"targetRef"`
+	// Interface is the name of the parent interface on the devices, e.g. ethernet-1/1
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(mgmt0|ethernet-[1-9][0-9]?/[1-9][0-9]?[0-9]?|lag[1-9][0-9]?[0-9]?|irb[0-9][0-9]?[0-9]?|lo[0-9][0-9]?[0-9]?)$`
+	Interface string `json:"interface"`
+	// Index is the index of the subinterface within the parent interface
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=9999
+	Index uint32 `json:"index"`
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=255
+	Description string `json:"description,omitempty"`
+	// +kubebuilder:validation:Enum=enable;disable
+	// +kubebuilder:default=enable
+	AdminState string `json:"admin-state,omitempty"`
+	// VlanID is the vlan id of the single tagged frames of the subinterface, the
+	// parent interface must have vlan-tagging enabled. The subinterface is untagged
+	// when it is not set.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4095
+	VlanID *uint16 `json:"vlan-id,omitempty"`
+	// IPv4 are the ipv4 addresses of the subinterface with their prefix length,
+	// e.g. 192.168.0.1/24
+	IPv4 []string `json:"ipv4,omitempty"`
+	// IPv6 are the ipv6 addresses of the subinterface with their prefix length,
+	// e.g. 2001:db8::1/64
+	IPv6 []string `json:"ipv6,omitempty"`
+}
+
+// SubinterfaceDeviceStatus defines the observed state of Subinterface on a single device
+type SubinterfaceDeviceStatus struct {
+	DeviceResult `json:",inline"`
+	// AppliedInterface and AppliedIndex are the parent interface and the index of
+	// the subinterface last applied to the device, the subinterface is removed from
+	// them when the spec moves it to another interface or index
+	AppliedInterface string `json:"appliedInterface,omitempty"`
+	AppliedIndex     uint32 `json:"appliedIndex,omitempty"`
+	// AppliedAddresses holds the ipv4 and ipv6 addresses last applied to the device,
+	// addresses that are removed from the spec are deleted from the device
+	AppliedAddresses []string `json:"appliedAddresses,omitempty"`
+	// +kubebuilder:validation:Enum=enable;disable
+	AdminState string `json:"adminState,omitempty"`
+	// +kubebuilder:validation:Enum=up;down;empty;downloading;booting;starting;failed;synchronizing;upgrading
+	OperState string `json:"operState,omitempty"`
+	// LastChange is the time of the last change of the oper-state
+	LastChange string             `json:"lastChange,omitempty"`
+	VlanID     uint16             `json:"vlanID,omitempty"`
+	IPv4       []string           `json:"ipv4,omitempty"`
+	IPv6       []string           `json:"ipv6,omitempty"`
+	Counters   *InterfaceCounters `json:"counters,omitempty"`
+}
+
+// SubinterfaceStatus defines the observed state of Subinterface
+type SubinterfaceStatus struct {
+	// Devices holds the result of applying the Subinterface and the subinterface
+	// state read back from each of the targeted devices
+	Devices []SubinterfaceDeviceStatus `json:"devices,omitempty"`
+	// ObservedGeneration is the metadata.generation of the Subinterface the status
+	// was computed for
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions holds the latest observations of the state of the Subinterface
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Interface",type="string",JSONPath=".spec.interface"
+// +kubebuilder:printcolumn:name="Index",type="integer",JSONPath=".spec.index"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status"
+// +kubebuilder:printcolumn:name="Reason",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].reason"
+
+// Subinterface is the Schema for the subinterfaces API
+type Subinterface struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SubinterfaceSpec   `json:"spec,omitempty"`
+	Status SubinterfaceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SubinterfaceList contains a list of Subinterface
+type SubinterfaceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Subinterface `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Subinterface{}, &SubinterfaceList{})
+}
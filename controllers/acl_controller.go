@@ -0,0 +1,354 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/translate"
+)
+
+// aclFinalizer holds the Acl until it is removed from the devices
+const aclFinalizer = "acl.srlinux.henderiw.be/cleanup"
+
+// AclReconciler reconciles a Acl object
+type AclReconciler struct {
+	client.Client
+	Pool *gnmic.Pool
+	// ResyncPeriod is the interval at which the statistics of the entries are read
+	// back from the devices
+	ResyncPeriod time.Duration
+	Log          logr.Logger
+	Scheme       *runtime.Scheme
+	Recorder     record.EventRecorder
+
+	retries retrySet
+}
+
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=acls,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=acls/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=devices,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile function
+func (r *AclReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	var acl srlinuxv1alpha1.Acl
+	if err := r.Get(ctx, req.NamespacedName, &acl); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	previous := make([]deviceEntry, 0, len(acl.Status.Devices))
+	for i := range acl.Status.Devices {
+		previous = append(previous, &acl.Status.Devices[i])
+	}
+	if !acl.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.devices(ctx, &acl, nil).finalize(ctx, aclFinalizer, &acl.Status.Conditions, previous)
+	}
+	if !controllerutil.ContainsFinalizer(&acl, aclFinalizer) {
+		controllerutil.AddFinalizer(&acl, aclFinalizer)
+		if err := r.Update(ctx, &acl); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	ids, err := translate.AclSequenceIDs(acl.Spec.Entries, acl.Status.SequenceIDs)
+	if err != nil {
+		// retrying does not help, the Acl is reconciled again when its spec changes
+		r.Recorder.Eventf(&acl, corev1.EventTypeWarning, "InvalidSpec", "cannot number entries: %v", err)
+		setCondition(&acl.Status.Conditions, acl.Generation, srlinuxv1alpha1.ConditionTypeReady, false, "InvalidSpec", err.Error())
+		acl.Status.ObservedGeneration = acl.Generation
+		return ctrl.Result{}, r.Status().Update(ctx, &acl)
+	}
+	acl.Status.SequenceIDs = make([]srlinuxv1alpha1.AclEntrySequenceID, 0, len(ids))
+	for i, e := range acl.Spec.Entries {
+		acl.Status.SequenceIDs = append(acl.Status.SequenceIDs, srlinuxv1alpha1.AclEntrySequenceID{Name: e.Name, SequenceID: ids[i]})
+	}
+
+	d := r.devices(ctx, &acl, ids)
+	devices, err := targetDevices(ctx, r.Client, acl.Namespace, &acl.Spec.TargetRef)
+	if err != nil {
+		return ctrl.Result{}, d.targetFailed(ctx, &acl.Status.Conditions, &acl.Status.ObservedGeneration, err)
+	}
+
+	// rejected devices are retried when the spec or the Device changes, not on
+	// every resync
+	d.retry = r.retries.Take(req.NamespacedName)
+	statuses, failed, err := d.run(ctx, devices, previous)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	acl.Status.Devices = make([]srlinuxv1alpha1.AclDeviceStatus, 0, len(statuses))
+	for _, devStatus := range statuses {
+		acl.Status.Devices = append(acl.Status.Devices, *devStatus.(*srlinuxv1alpha1.AclDeviceStatus))
+	}
+
+	setDeviceConditions(&acl.Status.Conditions, acl.Generation, deviceResults(statuses), nil)
+	acl.Status.ObservedGeneration = acl.Generation
+	if err := r.Status().Update(ctx, &acl); err != nil {
+		return ctrl.Result{}, err
+	}
+	return d.result(failed, r.ResyncPeriod)
+}
+
+// devices returns how acl, with its entries numbered by ids, is applied to and
+// removed from the devices. The type, the entries and the bindings applied to a
+// device are recorded, so they are removed after the spec changed them or the Acl
+// no longer targets the device. ids is only used to apply the filter.
+func (r *AclReconciler) devices(ctx context.Context, acl *srlinuxv1alpha1.Acl, ids []uint32) *deviceReconcile {
+	return &deviceReconcile{
+		client:   r.Client,
+		log:      r.Log.WithValues("acl", types.NamespacedName{Namespace: acl.Namespace, Name: acl.Name}),
+		recorder: r.Recorder,
+		object:   acl,
+		what:     "acl",
+		newStatus: func(name string, prev deviceEntry) deviceEntry {
+			devStatus := &srlinuxv1alpha1.AclDeviceStatus{DeviceResult: srlinuxv1alpha1.DeviceResult{Name: name}}
+			if prev != nil {
+				applied := prev.(*srlinuxv1alpha1.AclDeviceStatus)
+				devStatus.AppliedType = applied.AppliedType
+				devStatus.AppliedEntries = applied.AppliedEntries
+				devStatus.AppliedBindings = applied.AppliedBindings
+			}
+			return devStatus
+		},
+		apply: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+			return r.applyAndRead(ctx, acl, ids, dev, devStatus.(*srlinuxv1alpha1.AclDeviceStatus))
+		},
+		refresh: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+			return r.refresh(ctx, acl, dev, devStatus.(*srlinuxv1alpha1.AclDeviceStatus))
+		},
+		cleanup: func(dev *srlinuxv1alpha1.Device, prev deviceEntry) error {
+			var applied srlinuxv1alpha1.AclDeviceStatus
+			if prev != nil {
+				applied = *prev.(*srlinuxv1alpha1.AclDeviceStatus)
+			}
+			return r.cleanup(ctx, acl, dev, applied)
+		},
+	}
+}
+
+// applyAndRead sends the filter and its bindings to the device and reads back the
+// statistics of the entries
+func (r *AclReconciler) applyAndRead(ctx context.Context, acl *srlinuxv1alpha1.Acl, ids []uint32, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.AclDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	if err := r.apply(ctx, acl, ids, g, devStatus); err != nil {
+		return err
+	}
+	devStatus.AppliedGeneration = acl.Generation
+	devStatus.AppliedType = acl.Spec.Type
+	devStatus.AppliedEntries = append([]srlinuxv1alpha1.AclEntrySequenceID(nil), acl.Status.SequenceIDs...)
+	devStatus.AppliedBindings = append([]srlinuxv1alpha1.AclBinding(nil), acl.Spec.Bindings...)
+	if err := getAclState(ctx, g, acl, devStatus); err != nil {
+		// the configuration was applied, only the statistics are missing
+		r.Log.Error(err, "cannot get acl state", "device", dev.Name)
+		devStatus.Message = fmt.Sprintf("cannot get acl state: %v", err)
+	}
+	return nil
+}
+
+// refresh reads the statistics of the entries from the device
+func (r *AclReconciler) refresh(ctx context.Context, acl *srlinuxv1alpha1.Acl, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.AclDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	return getAclState(ctx, g, acl, devStatus)
+}
+
+// apply sends the filter and its bindings to the device. Entries that kept their
+// sequence id only have their match, action and description replaced so their
+// statistics carry on; the other entries applied before are deleted, as well as
+// the bindings that are no longer part of the spec. The filter and all its
+// bindings are removed when the spec changed the type of the filter.
+func (r *AclReconciler) apply(ctx context.Context, acl *srlinuxv1alpha1.Acl, ids []uint32, g *gnmic.GnmiClient, devStatus *srlinuxv1alpha1.AclDeviceStatus) error {
+	afi := acl.Spec.Type
+	root, err := translate.AclFromSpec(acl.Name, &acl.Spec, ids).Device()
+	if err != nil {
+		return gnmic.NewInvalidRequestError("SetRequest", g.Target, err)
+	}
+
+	var deletes []*gnmi.Path
+	if devStatus.AppliedType != "" && devStatus.AppliedType != afi {
+		paths, err := aclPaths(acl.Name, devStatus.AppliedType, devStatus.AppliedBindings)
+		if err != nil {
+			return gnmic.NewInvalidRequestError("SetRequest", g.Target, err)
+		}
+		deletes = append(deletes, paths...)
+	} else {
+		names := make(map[uint32]string, len(ids))
+		for _, e := range acl.Status.SequenceIDs {
+			names[e.SequenceID] = e.Name
+		}
+		for _, e := range devStatus.AppliedEntries {
+			if name, ok := names[e.SequenceID]; !ok || name != e.Name {
+				deletes = append(deletes, srlmodels.AclEntryPath(afi, acl.Name, e.SequenceID))
+				continue
+			}
+			for _, leaf := range []string{"description", "match", "action"} {
+				deletes = append(deletes, srlmodels.AclEntryPath(afi, acl.Name, e.SequenceID, leaf))
+			}
+		}
+		if acl.Spec.Description == "" {
+			deletes = append(deletes, srlmodels.AclFilterPath(afi, acl.Name, "description"))
+		}
+		paths, err := aclBindingPaths(afi, removedAclBindings(devStatus.AppliedBindings, acl.Spec.Bindings))
+		if err != nil {
+			return gnmic.NewInvalidRequestError("SetRequest", g.Target, err)
+		}
+		deletes = append(deletes, paths...)
+	}
+
+	setReq, err := g.CreateSetRequestFromStruct(root, deletes...)
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// getAclState reads the filter of the Acl from the device and copies the
+// statistics of its entries into devStatus
+func getAclState(ctx context.Context, g *gnmic.GnmiClient, acl *srlinuxv1alpha1.Acl, devStatus *srlinuxv1alpha1.AclDeviceStatus) error {
+	f := &translate.AclFilter{}
+	if err := g.GetInto(ctx, srlmodels.AclFilterPath(devStatus.AppliedType, acl.Name), gnmi.GetRequest_ALL, f); err != nil {
+		return err
+	}
+	names := make(map[uint32]string, len(devStatus.AppliedEntries))
+	for _, e := range devStatus.AppliedEntries {
+		names[e.SequenceID] = e.Name
+	}
+	f.Status(devStatus, names)
+	return nil
+}
+
+// cleanup deletes the filter recorded in devStatus, or the one of the spec when
+// none was applied, and its bindings from the device
+func (r *AclReconciler) cleanup(ctx context.Context, acl *srlinuxv1alpha1.Acl, dev *srlinuxv1alpha1.Device, devStatus srlinuxv1alpha1.AclDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+
+	afi, bindings := devStatus.AppliedType, devStatus.AppliedBindings
+	if afi == "" {
+		afi, bindings = acl.Spec.Type, acl.Spec.Bindings
+	}
+	deletes, err := aclPaths(acl.Name, afi, bindings)
+	if err != nil {
+		return gnmic.NewInvalidRequestError("SetRequest", g.Target, err)
+	}
+
+	setReq, err := g.CreateSetRequestFromStruct(nil, deletes...)
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// aclPaths returns the paths of the ipv4, ipv6 or mac filter named name and of its
+// bindings
+func aclPaths(name, afi string, bindings []srlinuxv1alpha1.AclBinding) ([]*gnmi.Path, error) {
+	paths, err := aclBindingPaths(afi, bindings)
+	if err != nil {
+		return nil, err
+	}
+	return append(paths, srlmodels.AclFilterPath(afi, name)), nil
+}
+
+// aclBindingPaths returns the paths of the bindings of an ipv4, ipv6 or mac filter
+func aclBindingPaths(afi string, bindings []srlinuxv1alpha1.AclBinding) ([]*gnmi.Path, error) {
+	paths := make([]*gnmi.Path, 0, len(bindings))
+	for _, b := range bindings {
+		name, index, err := translate.ParseSubinterfaceName(b.Subinterface)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, srlmodels.SubinterfaceAclPath(name, index, b.Direction, afi))
+	}
+	return paths, nil
+}
+
+// removedAclBindings returns the bindings in applied that are not in desired
+func removedAclBindings(applied, desired []srlinuxv1alpha1.AclBinding) []srlinuxv1alpha1.AclBinding {
+	keep := make(map[srlinuxv1alpha1.AclBinding]bool, len(desired))
+	for _, b := range desired {
+		keep[b] = true
+	}
+	var removed []srlinuxv1alpha1.AclBinding
+	for _, b := range applied {
+		if !keep[b] {
+			removed = append(removed, b)
+		}
+	}
+	return removed
+}
+
+// SetupWithManager function
+func (r *AclReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// status updates must not trigger a reconcile, the resync period takes care of refreshing it
+		For(&srlinuxv1alpha1.Acl{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &srlinuxv1alpha1.Device{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.aclsForDevice),
+		}).
+		Complete(r)
+}
+
+// aclsForDevice maps a Device to the Acls targeting it
+func (r *AclReconciler) aclsForDevice(o handler.MapObject) []reconcile.Request {
+	var list srlinuxv1alpha1.AclList
+	if err := r.List(context.Background(), &list, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "cannot list acls", "device", o.Meta.GetName())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, acl := range list.Items {
+		if acl.Spec.TargetRef.Selects(o.Meta) {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: acl.Namespace, Name: acl.Name},
+			})
+		}
+	}
+	// a changed Device may accept the filter it rejected
+	return r.retries.Mark(reqs)
+}
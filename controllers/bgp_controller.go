@@ -0,0 +1,508 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/translate"
+)
+
+// bgpFinalizer holds the Bgp until it is removed from the devices
+const bgpFinalizer = "bgp.srlinux.henderiw.be/cleanup"
+
+// BgpReconciler reconciles a Bgp object
+type BgpReconciler struct {
+	client.Client
+	Pool *gnmic.Pool
+	// ResyncPeriod is the interval at which the uptime of the sessions is
+	// refreshed, the other session state is pushed by the devices
+	ResyncPeriod time.Duration
+	Log          logr.Logger
+	Scheme       *runtime.Scheme
+	Recorder     record.EventRecorder
+
+	watcher *stateWatcher
+}
+
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=bgps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=bgps/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=devices,verbs=get;list;watch
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=networkinstances,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile function
+func (r *BgpReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("bgp", req.NamespacedName)
+
+	var bgp srlinuxv1alpha1.Bgp
+	if err := r.Get(ctx, req.NamespacedName, &bgp); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	if !bgp.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.finalize(ctx, &bgp)
+	}
+	if !controllerutil.ContainsFinalizer(&bgp, bgpFinalizer) {
+		controllerutil.AddFinalizer(&bgp, bgpFinalizer)
+		if err := r.Update(ctx, &bgp); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	devices, err := targetDevices(ctx, r.Client, bgp.Namespace, &bgp.Spec.TargetRef)
+	if err != nil {
+		// the state of deleted devices is no longer watched, the Device controller
+		// removed them from the pool already
+		names := make([]string, 0, len(bgp.Status.Devices))
+		applied := make(map[string]string, len(bgp.Status.Devices))
+		for _, devStatus := range bgp.Status.Devices {
+			names = append(names, devStatus.Name)
+			applied[devStatus.Name] = devStatus.AppliedNetworkInstance
+		}
+		deleted, derr := deletedDevices(ctx, r.Client, bgp.Namespace, names)
+		if derr != nil {
+			return ctrl.Result{}, derr
+		}
+		for _, name := range deleted {
+			r.unwatch(&bgp, name, applied[name])
+		}
+		r.Recorder.Eventf(&bgp, corev1.EventTypeWarning, "TargetFailed", "cannot select devices: %v", err)
+		setCondition(&bgp.Status.Conditions, bgp.Generation, srlinuxv1alpha1.ConditionTypeReady, false, "TargetFailed", err.Error())
+		bgp.Status.ObservedGeneration = bgp.Generation
+		if err := r.Status().Update(ctx, &bgp); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, err
+	}
+
+	// rejected devices are retried when the spec or the Device or NetworkInstance
+	// changes, not on every state change pushed by the devices
+	retry := r.watcher.TakeRetry(req.NamespacedName)
+	previous := make(map[string]srlinuxv1alpha1.BgpDeviceStatus, len(bgp.Status.Devices))
+	for _, devStatus := range bgp.Status.Devices {
+		previous[devStatus.Name] = devStatus
+	}
+
+	var failed int
+	bgp.Status.Devices = make([]srlinuxv1alpha1.BgpDeviceStatus, 0, len(devices))
+	for i := range devices {
+		dev := &devices[i]
+		prev, ok := previous[dev.Name]
+		delete(previous, dev.Name)
+		if ok && prev.Result == resultRejected && prev.AppliedGeneration == bgp.Generation && !retry {
+			// the device refused this generation already
+			bgp.Status.Devices = append(bgp.Status.Devices, prev)
+			continue
+		}
+
+		var err error
+		devStatus := srlinuxv1alpha1.BgpDeviceStatus{
+			DeviceResult:           srlinuxv1alpha1.DeviceResult{Name: dev.Name, Result: resultApplied},
+			AppliedNetworkInstance: prev.AppliedNetworkInstance,
+			AppliedGroups:          prev.AppliedGroups,
+			AppliedNeighbors:       prev.AppliedNeighbors,
+		}
+		if ok && prev.Result == resultApplied && prev.AppliedGeneration == bgp.Generation {
+			// the spec is applied already, only refresh the state
+			devStatus = prev
+			devStatus.Message = ""
+			err = r.refresh(ctx, &bgp, dev, &devStatus)
+		} else {
+			err = r.applyAndRead(ctx, &bgp, dev, &devStatus)
+			if err == nil {
+				r.Recorder.Eventf(&bgp, corev1.EventTypeNormal, "Applied", "applied bgp to network instance %s of device %s", bgp.Spec.NetworkInstance, dev.Name)
+			}
+		}
+		if err != nil {
+			log.Error(err, "cannot apply bgp", "device", dev.Name)
+			r.Recorder.Eventf(&bgp, corev1.EventTypeWarning, "ApplyFailed", "cannot apply bgp to device %s: %v", dev.Name, err)
+			devStatus.Result = deviceResult(err)
+			devStatus.Message = err.Error()
+			if devStatus.Result == resultFailed {
+				failed++
+			} else {
+				// the generation the device rejected
+				devStatus.AppliedGeneration = bgp.Generation
+			}
+		} else if devStatus.Message != "" {
+			r.Recorder.Eventf(&bgp, corev1.EventTypeWarning, "StateFailed", "device %s: %s", dev.Name, devStatus.Message)
+		}
+		bgp.Status.Devices = append(bgp.Status.Devices, devStatus)
+	}
+
+	// bgp is removed from the devices that are no longer targeted
+	for name, prev := range previous {
+		r.unwatch(&bgp, name, prev.AppliedNetworkInstance)
+		var dev srlinuxv1alpha1.Device
+		if err := r.Get(ctx, types.NamespacedName{Namespace: bgp.Namespace, Name: name}, &dev); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return ctrl.Result{}, err
+		}
+		if err := r.cleanup(ctx, &bgp, &dev, prev.AppliedNetworkInstance); err != nil {
+			log.Error(err, "cannot remove bgp", "device", name)
+			r.Recorder.Eventf(&bgp, corev1.EventTypeWarning, "CleanupFailed", "cannot remove bgp from device %s: %v", name, err)
+			bgp.Status.Devices = append(bgp.Status.Devices, srlinuxv1alpha1.BgpDeviceStatus{
+				DeviceResult:           srlinuxv1alpha1.DeviceResult{Name: name, Result: deviceResult(err), Message: err.Error()},
+				AppliedNetworkInstance: prev.AppliedNetworkInstance,
+			})
+			if deviceResult(err) == resultFailed {
+				failed++
+			}
+			continue
+		}
+		r.Recorder.Eventf(&bgp, corev1.EventTypeNormal, "Removed", "removed bgp from device %s", name)
+	}
+
+	results := make([]srlinuxv1alpha1.DeviceResult, 0, len(bgp.Status.Devices))
+	for _, devStatus := range bgp.Status.Devices {
+		results = append(results, devStatus.DeviceResult)
+	}
+	setDeviceConditions(&bgp.Status.Conditions, bgp.Generation, results, nil)
+	bgp.Status.ObservedGeneration = bgp.Generation
+	if err := r.Status().Update(ctx, &bgp); err != nil {
+		return ctrl.Result{}, err
+	}
+	if failed > 0 {
+		return ctrl.Result{}, fmt.Errorf("failed applying bgp to %d devices", failed)
+	}
+
+	if r.ResyncPeriod > 0 {
+		return ctrl.Result{RequeueAfter: r.ResyncPeriod}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// applyAndRead sends the bgp configuration to the device and reads back the
+// resulting bgp state into devStatus
+func (r *BgpReconciler) applyAndRead(ctx context.Context, bgp *srlinuxv1alpha1.Bgp, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.BgpDeviceStatus) error {
+	g, err := r.deviceClient(ctx, bgp, dev)
+	if err != nil {
+		return err
+	}
+	if err := r.apply(ctx, bgp, g, devStatus); err != nil {
+		return err
+	}
+	if devStatus.AppliedNetworkInstance != "" && devStatus.AppliedNetworkInstance != bgp.Spec.NetworkInstance {
+		r.unwatch(bgp, dev.Name, devStatus.AppliedNetworkInstance)
+	}
+	devStatus.AppliedGeneration = bgp.Generation
+	devStatus.AppliedNetworkInstance = bgp.Spec.NetworkInstance
+	devStatus.AppliedGroups = make([]string, 0, len(bgp.Spec.Groups))
+	for _, group := range bgp.Spec.Groups {
+		devStatus.AppliedGroups = append(devStatus.AppliedGroups, group.Name)
+	}
+	devStatus.AppliedNeighbors = make([]string, 0, len(bgp.Spec.Neighbors))
+	for _, neighbor := range bgp.Spec.Neighbors {
+		devStatus.AppliedNeighbors = append(devStatus.AppliedNeighbors, neighbor.PeerAddress)
+	}
+	if err := getBgpState(ctx, g, bgp.Spec.NetworkInstance, devStatus); err != nil {
+		// the configuration was applied, only the state is missing
+		r.Log.Error(err, "cannot get bgp state", "device", dev.Name)
+		devStatus.Message = fmt.Sprintf("cannot get bgp state: %v", err)
+	}
+	return nil
+}
+
+// refresh copies the bgp state of the device into devStatus
+func (r *BgpReconciler) refresh(ctx context.Context, bgp *srlinuxv1alpha1.Bgp, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.BgpDeviceStatus) error {
+	g, err := r.deviceClient(ctx, bgp, dev)
+	if err != nil {
+		return err
+	}
+	// the subscription keeps the bgp tree of the device current, only read it
+	// when the subscription is not synchronized
+	tree, ok := r.watcher.State(deviceKey(dev), srlmodels.BgpPath(bgp.Spec.NetworkInstance))
+	if !ok {
+		return getBgpState(ctx, g, bgp.Spec.NetworkInstance, devStatus)
+	}
+	b, err := translate.BgpFromTree(tree)
+	if err != nil {
+		return err
+	}
+	b.Status(devStatus, time.Now())
+	return nil
+}
+
+// deviceClient returns the gnmi client of the device and makes sure the bgp state
+// of the device is watched on behalf of the Bgp
+func (r *BgpReconciler) deviceClient(ctx context.Context, bgp *srlinuxv1alpha1.Bgp, dev *srlinuxv1alpha1.Device) (*gnmic.GnmiClient, error) {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return nil, err
+	}
+	r.watcher.Watch(deviceKey(dev), srlmodels.BgpPath(bgp.Spec.NetworkInstance), types.NamespacedName{Namespace: bgp.Namespace, Name: bgp.Name}, g)
+	return g, nil
+}
+
+// unwatch ends the watch of the bgp state of the network instance named ni on the
+// device named device on behalf of the Bgp
+func (r *BgpReconciler) unwatch(bgp *srlinuxv1alpha1.Bgp, device, ni string) {
+	if ni == "" {
+		ni = bgp.Spec.NetworkInstance
+	}
+	r.watcher.Unwatch(types.NamespacedName{Namespace: bgp.Namespace, Name: device}.String(), srlmodels.BgpPath(ni),
+		types.NamespacedName{Namespace: bgp.Namespace, Name: bgp.Name})
+}
+
+// apply sends the bgp configuration to the device. The groups and neighbors that
+// were applied before but are no longer part of the spec are deleted, as well as
+// the optional leaves that are not set in the spec. bgp is removed from the network
+// instance it was applied to before when the spec moved it to another one.
+func (r *BgpReconciler) apply(ctx context.Context, bgp *srlinuxv1alpha1.Bgp, g *gnmic.GnmiClient, devStatus *srlinuxv1alpha1.BgpDeviceStatus) error {
+	ni := bgp.Spec.NetworkInstance
+	root, err := translate.BgpFromSpec(&bgp.Spec).Device(ni)
+	if err != nil {
+		return gnmic.NewInvalidRequestError("SetRequest", g.Target, err)
+	}
+
+	var deletes []*gnmi.Path
+	if applied := devStatus.AppliedNetworkInstance; applied != "" && applied != ni {
+		deletes = append(deletes, srlmodels.BgpPath(applied))
+	} else {
+		groups := make([]string, 0, len(bgp.Spec.Groups))
+		for _, group := range bgp.Spec.Groups {
+			groups = append(groups, group.Name)
+		}
+		for _, group := range removedStrings(devStatus.AppliedGroups, groups) {
+			deletes = append(deletes, srlmodels.BgpGroupPath(ni, group))
+		}
+		neighbors := make([]string, 0, len(bgp.Spec.Neighbors))
+		for _, neighbor := range bgp.Spec.Neighbors {
+			neighbors = append(neighbors, neighbor.PeerAddress)
+		}
+		for _, neighbor := range removedStrings(devStatus.AppliedNeighbors, neighbors) {
+			deletes = append(deletes, srlmodels.BgpNeighborPath(ni, neighbor))
+		}
+	}
+
+	deletes = append(deletes, bgpUnsetLeaves(srlmodels.BgpPath(ni), map[string]bool{
+		"import-policy":            bgp.Spec.ImportPolicy != "",
+		"export-policy":            bgp.Spec.ExportPolicy != "",
+		"ipv4-unicast/admin-state": len(bgp.Spec.AddressFamilies) > 0,
+		"ipv6-unicast/admin-state": len(bgp.Spec.AddressFamilies) > 0,
+	})...)
+	for _, group := range bgp.Spec.Groups {
+		deletes = append(deletes, bgpUnsetLeaves(srlmodels.BgpGroupPath(ni, group.Name), map[string]bool{
+			"description":              group.Description != "",
+			"peer-as":                  group.PeerAS != 0,
+			"import-policy":            group.ImportPolicy != "",
+			"export-policy":            group.ExportPolicy != "",
+			"ipv4-unicast/admin-state": len(group.AddressFamilies) > 0,
+			"ipv6-unicast/admin-state": len(group.AddressFamilies) > 0,
+		})...)
+	}
+	for _, neighbor := range bgp.Spec.Neighbors {
+		deletes = append(deletes, bgpUnsetLeaves(srlmodels.BgpNeighborPath(ni, neighbor.PeerAddress), map[string]bool{
+			"description":              neighbor.Description != "",
+			"peer-as":                  neighbor.PeerAS != 0,
+			"import-policy":            neighbor.ImportPolicy != "",
+			"export-policy":            neighbor.ExportPolicy != "",
+			"ipv4-unicast/admin-state": len(neighbor.AddressFamilies) > 0,
+			"ipv6-unicast/admin-state": len(neighbor.AddressFamilies) > 0,
+		})...)
+	}
+
+	setReq, err := g.CreateSetRequestFromStruct(root, deletes...)
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// bgpUnsetLeaves returns the paths below base of the leaves that are not set,
+// leaves maps the path of a leaf relative to base to whether it is set
+func bgpUnsetLeaves(base *gnmi.Path, leaves map[string]bool) []*gnmi.Path {
+	var unset []string
+	for leaf, set := range leaves {
+		if !set {
+			unset = append(unset, leaf)
+		}
+	}
+	sort.Strings(unset)
+
+	paths := make([]*gnmi.Path, 0, len(unset))
+	for _, leaf := range unset {
+		p := &gnmi.Path{Elem: append([]*gnmi.PathElem(nil), base.GetElem()...)}
+		for _, name := range strings.Split(leaf, "/") {
+			p.Elem = append(p.Elem, &gnmi.PathElem{Name: name})
+		}
+		paths = append(paths, p)
+	}
+	return paths
+}
+
+// getBgpState reads the bgp state of the network instance named ni from the device
+// and copies it into devStatus
+func getBgpState(ctx context.Context, g *gnmic.GnmiClient, ni string, devStatus *srlinuxv1alpha1.BgpDeviceStatus) error {
+	b := &translate.Bgp{}
+	if err := g.GetInto(ctx, srlmodels.BgpPath(ni), gnmi.GetRequest_ALL, b); err != nil {
+		return err
+	}
+	b.Status(devStatus, time.Now())
+	return nil
+}
+
+// finalize removes bgp from the devices it was applied to and releases the Bgp.
+// Devices that cannot be cleaned up are reported in the Deleted condition and
+// retried.
+func (r *BgpReconciler) finalize(ctx context.Context, bgp *srlinuxv1alpha1.Bgp) error {
+	log := r.Log.WithValues("bgp", types.NamespacedName{Namespace: bgp.Namespace, Name: bgp.Name})
+
+	if !controllerutil.ContainsFinalizer(bgp, bgpFinalizer) {
+		return nil
+	}
+
+	names := make([]string, 0, len(bgp.Status.Devices))
+	applied := make(map[string]string, len(bgp.Status.Devices))
+	for _, devStatus := range bgp.Status.Devices {
+		r.unwatch(bgp, devStatus.Name, devStatus.AppliedNetworkInstance)
+		names = append(names, devStatus.Name)
+		applied[devStatus.Name] = devStatus.AppliedNetworkInstance
+	}
+	failed, retryable, err := cleanupDevices(ctx, r.Client, bgp.Namespace, names, func(dev *srlinuxv1alpha1.Device) error {
+		if err := r.cleanup(ctx, bgp, dev, applied[dev.Name]); err != nil {
+			log.Error(err, "cannot remove bgp", "device", dev.Name)
+			r.Recorder.Eventf(bgp, corev1.EventTypeWarning, "CleanupFailed", "cannot remove bgp from device %s: %v", dev.Name, err)
+			return err
+		}
+		r.Recorder.Eventf(bgp, corev1.EventTypeNormal, "Removed", "removed bgp from device %s", dev.Name)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(failed) > 0 {
+		setDeletedCondition(&bgp.Status.Conditions, bgp.Generation, failed, retryable)
+		if err := r.Status().Update(ctx, bgp); err != nil {
+			return err
+		}
+		if retryable == 0 {
+			// the devices will refuse the cleanup again, the finalizer has to be
+			// removed by hand once the devices are fixed
+			return nil
+		}
+		return fmt.Errorf("failed removing bgp from %d devices", retryable)
+	}
+
+	log.Info("removed bgp from all devices")
+	controllerutil.RemoveFinalizer(bgp, bgpFinalizer)
+	return r.Update(ctx, bgp)
+}
+
+// cleanup deletes bgp from the network instance named ni of the device, the
+// network instance of the spec when ni is empty
+func (r *BgpReconciler) cleanup(ctx context.Context, bgp *srlinuxv1alpha1.Bgp, dev *srlinuxv1alpha1.Device, ni string) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	if ni == "" {
+		ni = bgp.Spec.NetworkInstance
+	}
+	setReq, err := g.CreateSetRequestFromStruct(nil, srlmodels.BgpPath(ni))
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// SetupWithManager function
+func (r *BgpReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.watcher = newStateWatcher(r.Log.WithName("watcher"), func() watchedObject {
+		return &srlinuxv1alpha1.Bgp{}
+	})
+	return ctrl.NewControllerManagedBy(mgr).
+		// status updates must not trigger a reconcile, the subscriptions and the
+		// resync period take care of refreshing it
+		For(&srlinuxv1alpha1.Bgp{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &srlinuxv1alpha1.Device{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.bgpsForDevice),
+		}).
+		// a device rejects bgp until its network instance is created
+		Watches(&source.Kind{Type: &srlinuxv1alpha1.NetworkInstance{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.bgpsForNetworkInstance),
+		}).
+		// changes of the bgp state pushed by the devices
+		Watches(&source.Channel{Source: r.watcher.events}, &handler.EnqueueRequestForObject{}).
+		Complete(r)
+}
+
+// bgpsForDevice maps a Device to the Bgps targeting it
+func (r *BgpReconciler) bgpsForDevice(o handler.MapObject) []reconcile.Request {
+	var list srlinuxv1alpha1.BgpList
+	if err := r.List(context.Background(), &list, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "cannot list bgps", "device", o.Meta.GetName())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, bgp := range list.Items {
+		if bgp.Spec.TargetRef.Selects(o.Meta) {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: bgp.Namespace, Name: bgp.Name},
+			})
+		}
+	}
+	return r.watcher.Retry(reqs)
+}
+
+// bgpsForNetworkInstance maps a NetworkInstance to the Bgps running in it
+func (r *BgpReconciler) bgpsForNetworkInstance(o handler.MapObject) []reconcile.Request {
+	var list srlinuxv1alpha1.BgpList
+	if err := r.List(context.Background(), &list, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "cannot list bgps", "networkinstance", o.Meta.GetName())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, bgp := range list.Items {
+		if bgp.Spec.NetworkInstance == o.Meta.GetName() {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: bgp.Namespace, Name: bgp.Name},
+			})
+		}
+	}
+	return r.watcher.Retry(reqs)
+}
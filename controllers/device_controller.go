@@ -0,0 +1,304 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+)
+
+const (
+	defaultGnmiPort     = 57400
+	defaultGnmiEncoding = "JSON_IETF"
+
+	// caBundleKey is the key of the CA bundle in the TLS Secret of a Device
+	caBundleKey = "ca.crt"
+)
+
+// DeviceReconciler reconciles a Device object
+type DeviceReconciler struct {
+	client.Client
+	Pool *gnmic.Pool
+	// ResyncPeriod is the interval at which the network instances of the device
+	// are read again
+	ResyncPeriod time.Duration
+	Log          logr.Logger
+	Scheme       *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=devices,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=devices/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// Reconcile function
+func (r *DeviceReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+	log := r.Log.WithValues("device", req.NamespacedName)
+
+	log.Info("reconciling SRLinux Device")
+
+	var dev srlinuxv1alpha1.Device
+	if err := r.Get(ctx, req.NamespacedName, &dev); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			// the device is gone, tear down its connection
+			log.Info("closing gnmi connection")
+			return ctrl.Result{}, r.Pool.Delete(req.NamespacedName.String())
+		}
+		return ctrl.Result{}, err
+	}
+
+	// dial the device so changes to its spec replace the cached connection
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, &dev)
+	if err != nil {
+		log.Error(err, "cannot connect to device")
+		status := *dev.Status.DeepCopy()
+		reason := "ConnectFailed"
+		if gnmic.IsTerminal(err) {
+			// e.g. an encoding the device does not support, retrying will not help
+			reason = "HandshakeRejected"
+		}
+		setCondition(&status.Conditions, dev.Generation, srlinuxv1alpha1.ConditionTypeReady, false, reason, err.Error())
+		if !reflect.DeepEqual(dev.Status, status) {
+			dev.Status = status
+			if uerr := r.Status().Update(ctx, &dev); uerr != nil {
+				return ctrl.Result{}, uerr
+			}
+		}
+		if gnmic.IsTerminal(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	status := deviceStatus(g)
+	status.NetworkInstances = dev.Status.NetworkInstances
+	// copy the conditions, setCondition updates them in place
+	status.Conditions = append([]srlinuxv1alpha1.Condition(nil), dev.Status.Conditions...)
+	setCondition(&status.Conditions, dev.Generation, srlinuxv1alpha1.ConditionTypeReady, true, "Connected", "")
+	if names, err := getNetworkInstances(ctx, g); err != nil {
+		log.Error(err, "cannot get network instances")
+	} else {
+		status.NetworkInstances = names
+	}
+	if !reflect.DeepEqual(dev.Status, status) {
+		dev.Status = status
+		if err := r.Status().Update(ctx, &dev); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: r.ResyncPeriod}, nil
+}
+
+// deviceStatus returns the status of a device connected through g
+func deviceStatus(g *gnmic.GnmiClient) srlinuxv1alpha1.DeviceStatus {
+	status := srlinuxv1alpha1.DeviceStatus{Target: g.Target}
+	if g.Caps == nil {
+		return status
+	}
+	status.Release = g.Caps.Release
+	status.GnmiVersion = g.Caps.GnmiVersion
+	status.SupportedEncodings = append(status.SupportedEncodings, g.Caps.Encodings...)
+	for _, m := range g.Caps.Models {
+		status.SupportedModels = append(status.SupportedModels, srlinuxv1alpha1.DeviceModel{
+			Name:         m.Name,
+			Organization: m.Organization,
+			Version:      m.Version,
+		})
+	}
+	return status
+}
+
+// getNetworkInstances reads the names of the network instances configured on the device
+func getNetworkInstances(ctx context.Context, g *gnmic.GnmiClient) ([]string, error) {
+	var names []string
+	if err := g.GetInto(ctx, srlmodels.NetworkInstancePath("*", "name"), gnmi.GetRequest_CONFIG, &names); err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// SetupWithManager function
+func (r *DeviceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&srlinuxv1alpha1.Device{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.devicesForSecret),
+		}).
+		Complete(r)
+}
+
+// devicesForSecret maps a Secret to the Devices referencing it, so rotated
+// credentials and certificates are picked up by re-dialing the device
+func (r *DeviceReconciler) devicesForSecret(o handler.MapObject) []reconcile.Request {
+	var list srlinuxv1alpha1.DeviceList
+	if err := r.List(context.Background(), &list, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "cannot list devices", "secret", o.Meta.GetName())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, dev := range list.Items {
+		if dev.Spec.CredentialsName == o.Meta.GetName() || dev.Spec.TLS.SecretName == o.Meta.GetName() {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: dev.Namespace, Name: dev.Name},
+			})
+		}
+	}
+	return reqs
+}
+
+// gnmiClientForDevice returns the pooled gnmi client of the device, dialing it
+// when it is not connected yet or when its configuration changed
+func gnmiClientForDevice(ctx context.Context, c client.Client, pool *gnmic.Pool, dev *srlinuxv1alpha1.Device) (*gnmic.GnmiClient, error) {
+	g := gnmic.NewGnmiClient()
+
+	port := dev.Spec.Port
+	if port == 0 {
+		port = defaultGnmiPort
+	}
+	g.Target = net.JoinHostPort(dev.Spec.Address, strconv.Itoa(int(port)))
+	g.Encoding = dev.Spec.Encoding
+	if g.Encoding == "" {
+		g.Encoding = defaultGnmiEncoding
+	}
+	g.Insecure = dev.Spec.TLS.Insecure
+	g.SkipVerify = dev.Spec.TLS.SkipVerify
+
+	if dev.Spec.CredentialsName != "" {
+		secret, err := deviceSecret(ctx, c, dev, dev.Spec.CredentialsName)
+		if err != nil {
+			return nil, err
+		}
+		if secret.Type != corev1.SecretTypeBasicAuth && secret.Type != corev1.SecretTypeOpaque {
+			return nil, fmt.Errorf("secret %s of device %s has type %s, expected %s",
+				secret.Name, dev.Name, secret.Type, corev1.SecretTypeBasicAuth)
+		}
+		username, ok := secret.Data[corev1.BasicAuthUsernameKey]
+		if !ok {
+			return nil, fmt.Errorf("secret %s of device %s has no %s key",
+				secret.Name, dev.Name, corev1.BasicAuthUsernameKey)
+		}
+		g.Username = string(username)
+		g.Password = string(secret.Data[corev1.BasicAuthPasswordKey])
+	}
+
+	if dev.Spec.TLS.SecretName != "" && !dev.Spec.TLS.Insecure {
+		secret, err := deviceSecret(ctx, c, dev, dev.Spec.TLS.SecretName)
+		if err != nil {
+			return nil, err
+		}
+		if secret.Type != corev1.SecretTypeTLS && secret.Type != corev1.SecretTypeOpaque {
+			return nil, fmt.Errorf("secret %s of device %s has type %s, expected %s",
+				secret.Name, dev.Name, secret.Type, corev1.SecretTypeTLS)
+		}
+		g.TLSCert = secret.Data[corev1.TLSCertKey]
+		g.TLSKey = secret.Data[corev1.TLSPrivateKeyKey]
+		g.TLSCA = secret.Data[caBundleKey]
+	}
+
+	return pool.Get(deviceKey(dev), g)
+}
+
+// deviceKey returns the key of the device in the connection pool
+func deviceKey(dev *srlinuxv1alpha1.Device) string {
+	return types.NamespacedName{Namespace: dev.Namespace, Name: dev.Name}.String()
+}
+
+// deviceSecret returns the Secret name in the namespace of the device
+func deviceSecret(ctx context.Context, c client.Client, dev *srlinuxv1alpha1.Device, name string) (*corev1.Secret, error) {
+	var secret corev1.Secret
+	key := types.NamespacedName{Namespace: dev.Namespace, Name: name}
+	if err := c.Get(ctx, key, &secret); err != nil {
+		return nil, fmt.Errorf("cannot get secret %s of device %s: %v", name, dev.Name, err)
+	}
+	return &secret, nil
+}
+
+// deletedDevices returns the names of the Devices in namespace that no longer exist
+func deletedDevices(ctx context.Context, c client.Client, namespace string, names []string) ([]string, error) {
+	var deleted []string
+	for _, name := range names {
+		var dev srlinuxv1alpha1.Device
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &dev); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return nil, err
+			}
+			deleted = append(deleted, name)
+		}
+	}
+	return deleted, nil
+}
+
+// targetDevices returns the Devices in namespace selected by ref
+func targetDevices(ctx context.Context, c client.Client, namespace string, ref *srlinuxv1alpha1.TargetRef) ([]srlinuxv1alpha1.Device, error) {
+	var devices []srlinuxv1alpha1.Device
+	seen := make(map[string]bool)
+
+	for _, name := range ref.Devices {
+		if seen[name] {
+			continue
+		}
+		var dev srlinuxv1alpha1.Device
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &dev); err != nil {
+			return nil, fmt.Errorf("cannot get device %s: %v", name, err)
+		}
+		seen[name] = true
+		devices = append(devices, dev)
+	}
+
+	if ref.DeviceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(ref.DeviceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid device selector: %v", err)
+		}
+		var list srlinuxv1alpha1.DeviceList
+		if err := c.List(ctx, &list, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, err
+		}
+		for _, dev := range list.Items {
+			if seen[dev.Name] {
+				continue
+			}
+			seen[dev.Name] = true
+			devices = append(devices, dev)
+		}
+	}
+	return devices, nil
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	driftPolicyIgnore    = "ignore"
+	driftPolicyReport    = "report"
+	driftPolicyRemediate = "remediate"
+
+	// maxDriftSummary is the number of differences listed in the Drifted condition
+	maxDriftSummary = 5
+)
+
+// diffConfig compares the desired configuration with the actual configuration
+// read from the device and returns a description of every leaf of desired that is
+// missing or different on the device. Leaves that only exist on the device are not
+// reported, they are state or not managed by the operator. Lists of objects are
+// matched on the key given in listKeys for the name of the list, these lists are
+// managed as a whole: their entries that only exist on the device are reported too.
+func diffConfig(path string, desired, actual interface{}, listKeys map[string]string) []string {
+	switch d := desired.(type) {
+	case map[string]interface{}:
+		a, ok := actual.(map[string]interface{})
+		if !ok {
+			return []string{fmt.Sprintf("%s: missing", pathOrRoot(path))}
+		}
+		keys := make([]string, 0, len(d))
+		for k := range d {
+			keys = append(keys, k)
+		}
+		for k := range a {
+			if _, ok := d[k]; !ok && listKeys[k] != "" {
+				// a managed list that is empty in desired
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		var diffs []string
+		for _, k := range keys {
+			av, ok := a[k]
+			if !ok {
+				diffs = append(diffs, fmt.Sprintf("%s/%s: missing", path, k))
+				continue
+			}
+			if dv, ok := d[k]; !ok || isList(dv) {
+				l, _ := dv.([]interface{})
+				diffs = append(diffs, diffList(path+"/"+k, listKeys[k], l, av, listKeys)...)
+				continue
+			}
+			diffs = append(diffs, diffConfig(path+"/"+k, d[k], av, listKeys)...)
+		}
+		return diffs
+	case []interface{}:
+		return diffList(path, "", d, actual, listKeys)
+	default:
+		if fmt.Sprint(desired) != fmt.Sprint(actual) {
+			return []string{fmt.Sprintf("%s: want %v, got %v", pathOrRoot(path), desired, actual)}
+		}
+		return nil
+	}
+}
+
+// diffList compares two lists, matching the elements on key when it is set and
+// on their position otherwise. The elements of actual whose key is not in desired
+// are reported as unexpected.
+func diffList(path, key string, desired []interface{}, actual interface{}, listKeys map[string]string) []string {
+	a, ok := actual.([]interface{})
+	if !ok {
+		if len(desired) == 0 {
+			return nil
+		}
+		return []string{fmt.Sprintf("%s: missing", path)}
+	}
+	var diffs []string
+	for i, d := range desired {
+		if key == "" {
+			if i >= len(a) {
+				diffs = append(diffs, fmt.Sprintf("%s[%d]: missing", path, i))
+				continue
+			}
+			diffs = append(diffs, diffConfig(fmt.Sprintf("%s[%d]", path, i), d, a[i], listKeys)...)
+			continue
+		}
+		dm, ok := d.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		elemPath := fmt.Sprintf("%s[%s=%v]", path, key, dm[key])
+		match := findListElem(a, key, dm[key])
+		if match == nil {
+			diffs = append(diffs, fmt.Sprintf("%s: missing", elemPath))
+			continue
+		}
+		diffs = append(diffs, diffConfig(elemPath, dm, match, listKeys)...)
+	}
+	if key == "" {
+		return diffs
+	}
+	for _, v := range extraListKeys(key, desired, a) {
+		diffs = append(diffs, fmt.Sprintf("%s[%s=%s]: unexpected", path, key, v))
+	}
+	return diffs
+}
+
+// extraListKeys returns the values of key of the elements of actual that have no
+// element with the same key in desired
+func extraListKeys(key string, desired, actual []interface{}) []string {
+	var extra []string
+	for _, e := range actual {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if findListElem(desired, key, m[key]) == nil {
+			extra = append(extra, fmt.Sprint(m[key]))
+		}
+	}
+	sort.Strings(extra)
+	return extra
+}
+
+func isList(v interface{}) bool {
+	_, ok := v.([]interface{})
+	return ok
+}
+
+// findListElem returns the element of list whose key equals value
+func findListElem(list []interface{}, key string, value interface{}) map[string]interface{} {
+	for _, e := range list {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if fmt.Sprint(m[key]) == fmt.Sprint(value) {
+			return m
+		}
+	}
+	return nil
+}
+
+// toGeneric converts v into its generic json representation
+func toGeneric(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var out interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// driftSummary returns a short description of diffs
+func driftSummary(diffs []string) string {
+	if len(diffs) <= maxDriftSummary {
+		return strings.Join(diffs, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(diffs[:maxDriftSummary], ", "), len(diffs)-maxDriftSummary)
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/translate"
+)
+
+func mustGeneric(t *testing.T, s string) interface{} {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestDiffConfig(t *testing.T) {
+	desired := `{"admin-state": "enable", "server": [{"address": "10.0.0.1", "prefer": true}, {"address": "10.0.0.2"}]}`
+	tests := []struct {
+		name   string
+		actual string
+		want   []string
+	}{
+		{
+			name:   "matching config",
+			actual: `{"admin-state": "enable", "server": [{"address": "10.0.0.2"}, {"address": "10.0.0.1", "prefer": true}]}`,
+		},
+		{
+			name:   "state-only leaves are ignored",
+			actual: `{"admin-state": "enable", "oper-state": "up", "synchronized": "10.0.0.1", "server": [{"address": "10.0.0.1", "prefer": true, "stratum": 2}, {"address": "10.0.0.2", "stratum": 3}]}`,
+		},
+		{
+			name:   "changed leaf",
+			actual: `{"admin-state": "disable", "server": [{"address": "10.0.0.1", "prefer": false}, {"address": "10.0.0.2"}]}`,
+			want: []string{
+				"/system/ntp/admin-state: want enable, got disable",
+				"/system/ntp/server[address=10.0.0.1]/prefer: want true, got false",
+			},
+		},
+		{
+			name:   "missing leaf",
+			actual: `{"server": [{"address": "10.0.0.1", "prefer": true}, {"address": "10.0.0.2"}]}`,
+			want:   []string{"/system/ntp/admin-state: missing"},
+		},
+		{
+			name:   "removed list entry",
+			actual: `{"admin-state": "enable", "server": [{"address": "10.0.0.1", "prefer": true}]}`,
+			want:   []string{"/system/ntp/server[address=10.0.0.2]: missing"},
+		},
+		{
+			// e.g. a server added with the cli
+			name:   "added list entry",
+			actual: `{"admin-state": "enable", "server": [{"address": "10.0.0.3"}, {"address": "10.0.0.1", "prefer": true}, {"address": "10.0.0.2"}]}`,
+			want:   []string{"/system/ntp/server[address=10.0.0.3]: unexpected"},
+		},
+		{
+			name:   "missing list",
+			actual: `{"admin-state": "enable"}`,
+			want:   []string{"/system/ntp/server: missing"},
+		},
+		{
+			name:   "nothing configured",
+			actual: `null`,
+			want:   []string{"/system/ntp: missing"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diffConfig("/system/ntp", mustGeneric(t, desired), mustGeneric(t, tt.actual), ntpListKeys)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffConfig() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiffConfigEmptyList(t *testing.T) {
+	desired := mustGeneric(t, `{"admin-state": "enable"}`)
+	actual := mustGeneric(t, `{"admin-state": "enable", "server": [{"address": "10.0.0.2"}, {"address": "10.0.0.1"}]}`)
+	want := []string{
+		"/system/ntp/server[address=10.0.0.1]: unexpected",
+		"/system/ntp/server[address=10.0.0.2]: unexpected",
+	}
+	if got := diffConfig("/system/ntp", desired, actual, ntpListKeys); !reflect.DeepEqual(got, want) {
+		t.Errorf("diffConfig() = %q, want %q", got, want)
+	}
+}
+
+func TestDiffListWithoutKey(t *testing.T) {
+	desired := mustGeneric(t, `{"address": ["10.0.0.1", "10.0.0.2"]}`)
+	actual := mustGeneric(t, `{"address": ["10.0.0.1"]}`)
+	want := []string{"/dns/address[1]: missing"}
+	if got := diffConfig("/dns", desired, actual, nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("diffConfig() = %q, want %q", got, want)
+	}
+}
+
+func TestDriftSummary(t *testing.T) {
+	tests := []struct {
+		name  string
+		diffs []string
+		want  string
+	}{
+		{name: "none", diffs: nil, want: ""},
+		{name: "single", diffs: []string{"/a: missing"}, want: "/a: missing"},
+		{
+			name:  "at the limit",
+			diffs: []string{"/a", "/b", "/c", "/d", "/e"},
+			want:  "/a, /b, /c, /d, /e",
+		},
+		{
+			name:  "over the limit",
+			diffs: []string{"/a", "/b", "/c", "/d", "/e", "/f", "/g"},
+			want:  "/a, /b, /c, /d, /e and 2 more",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := driftSummary(tt.diffs); got != tt.want {
+				t.Errorf("driftSummary() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemediateDeletesAddedNtpServers(t *testing.T) {
+	spec := []srlinuxv1alpha1.NtpServer{{Address: "10.0.0.1"}}
+	applied := []string{"10.0.0.1", "10.0.0.2"}
+	onDevice := []translate.NtpServer{{Address: "10.0.0.1"}, {Address: "10.0.0.3"}}
+
+	// the server removed from the spec and the server added on the device
+	want := []string{"10.0.0.2", "10.0.0.3"}
+	if got := removedNtpServers(mergeNtpServers(applied, onDevice), spec); !reflect.DeepEqual(got, want) {
+		t.Errorf("deleted servers = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/translate"
+)
+
+// interfaceFinalizer holds the Interface until its configuration is removed from
+// the devices
+const interfaceFinalizer = "interface.srlinux.henderiw.be/cleanup"
+
+// interfaceLeaves are the leaves of an interface managed by an Interface, they are
+// deleted from the device, restoring their default, when the Interface is deleted
+var interfaceLeaves = []string{"description", "admin-state", "mtu", "vlan-tagging"}
+
+// InterfaceReconciler reconciles a Interface object
+type InterfaceReconciler struct {
+	client.Client
+	Pool *gnmic.Pool
+	// ResyncPeriod is the interval at which the oper-state and the counters of the
+	// interfaces are read from the devices
+	ResyncPeriod time.Duration
+	Log          logr.Logger
+	Scheme       *runtime.Scheme
+	Recorder     record.EventRecorder
+
+	retries retrySet
+}
+
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=interfaces,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=interfaces/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=devices,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile function
+func (r *InterfaceReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	var iface srlinuxv1alpha1.Interface
+	if err := r.Get(ctx, req.NamespacedName, &iface); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	d := r.devices(ctx, &iface)
+	previous := make([]deviceEntry, 0, len(iface.Status.Devices))
+	for i := range iface.Status.Devices {
+		previous = append(previous, &iface.Status.Devices[i])
+	}
+	if !iface.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, d.finalize(ctx, interfaceFinalizer, &iface.Status.Conditions, previous)
+	}
+	if !controllerutil.ContainsFinalizer(&iface, interfaceFinalizer) {
+		controllerutil.AddFinalizer(&iface, interfaceFinalizer)
+		if err := r.Update(ctx, &iface); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	devices, err := targetDevices(ctx, r.Client, iface.Namespace, &iface.Spec.TargetRef)
+	if err != nil {
+		return ctrl.Result{}, d.targetFailed(ctx, &iface.Status.Conditions, &iface.Status.ObservedGeneration, err)
+	}
+
+	// rejected devices are retried when the spec or the Device changes, not on
+	// every resync
+	d.retry = r.retries.Take(req.NamespacedName)
+	statuses, failed, err := d.run(ctx, devices, previous)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	iface.Status.Devices = make([]srlinuxv1alpha1.InterfaceDeviceStatus, 0, len(statuses))
+	for _, devStatus := range statuses {
+		iface.Status.Devices = append(iface.Status.Devices, *devStatus.(*srlinuxv1alpha1.InterfaceDeviceStatus))
+	}
+
+	setDeviceConditions(&iface.Status.Conditions, iface.Generation, deviceResults(statuses), nil)
+	iface.Status.ObservedGeneration = iface.Generation
+	if err := r.Status().Update(ctx, &iface); err != nil {
+		return ctrl.Result{}, err
+	}
+	return d.result(failed, r.ResyncPeriod)
+}
+
+// devices returns how iface is applied to and removed from the devices. The
+// interface named AppliedName on a device gets the defaults of the leaves back
+// when the Interface no longer targets the device.
+func (r *InterfaceReconciler) devices(ctx context.Context, iface *srlinuxv1alpha1.Interface) *deviceReconcile {
+	return &deviceReconcile{
+		client:   r.Client,
+		log:      r.Log.WithValues("interface", types.NamespacedName{Namespace: iface.Namespace, Name: iface.Name}),
+		recorder: r.Recorder,
+		object:   iface,
+		what:     "interface " + iface.Spec.Name,
+		newStatus: func(name string, prev deviceEntry) deviceEntry {
+			devStatus := &srlinuxv1alpha1.InterfaceDeviceStatus{DeviceResult: srlinuxv1alpha1.DeviceResult{Name: name}}
+			if prev != nil {
+				devStatus.AppliedName = prev.(*srlinuxv1alpha1.InterfaceDeviceStatus).AppliedName
+			}
+			return devStatus
+		},
+		apply: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+			return r.applyAndRead(ctx, iface, dev, devStatus.(*srlinuxv1alpha1.InterfaceDeviceStatus))
+		},
+		refresh: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+			return r.refresh(ctx, iface, dev, devStatus.(*srlinuxv1alpha1.InterfaceDeviceStatus))
+		},
+		cleanup: func(dev *srlinuxv1alpha1.Device, prev deviceEntry) error {
+			var appliedName string
+			if prev != nil {
+				appliedName = prev.(*srlinuxv1alpha1.InterfaceDeviceStatus).AppliedName
+			}
+			return r.cleanup(ctx, iface, dev, appliedName)
+		},
+	}
+}
+
+// applyAndRead sends the interface configuration to the device and reads back the
+// resulting interface state into devStatus
+func (r *InterfaceReconciler) applyAndRead(ctx context.Context, iface *srlinuxv1alpha1.Interface, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.InterfaceDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	if err := r.apply(ctx, iface, g, devStatus.AppliedName); err != nil {
+		return err
+	}
+	devStatus.AppliedGeneration = iface.Generation
+	devStatus.AppliedName = iface.Spec.Name
+	if err := getInterfaceState(ctx, g, iface.Spec.Name, devStatus); err != nil {
+		// the configuration was applied, only the state is missing
+		r.Log.Error(err, "cannot get interface state", "device", dev.Name)
+		devStatus.Message = fmt.Sprintf("cannot get interface state: %v", err)
+	}
+	return nil
+}
+
+// refresh reads the interface state of the device into devStatus
+func (r *InterfaceReconciler) refresh(ctx context.Context, iface *srlinuxv1alpha1.Interface, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.InterfaceDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	return getInterfaceState(ctx, g, iface.Spec.Name, devStatus)
+}
+
+// apply sends the interface configuration to the device. The optional leaves that
+// are not set in the spec are deleted, so they fall back to their default, as well
+// as the leaves of the interface named appliedName when the spec renamed it.
+func (r *InterfaceReconciler) apply(ctx context.Context, iface *srlinuxv1alpha1.Interface, g *gnmic.GnmiClient, appliedName string) error {
+	root, err := translate.InterfaceFromSpec(&iface.Spec).Device()
+	if err != nil {
+		return gnmic.NewInvalidRequestError("SetRequest", g.Target, err)
+	}
+
+	var deletes []*gnmi.Path
+	if iface.Spec.Description == "" {
+		deletes = append(deletes, srlmodels.InterfacePath(iface.Spec.Name, "description"))
+	}
+	if iface.Spec.MTU == 0 {
+		deletes = append(deletes, srlmodels.InterfacePath(iface.Spec.Name, "mtu"))
+	}
+	if appliedName != "" && appliedName != iface.Spec.Name {
+		for _, leaf := range interfaceLeaves {
+			deletes = append(deletes, srlmodels.InterfacePath(appliedName, leaf))
+		}
+	}
+
+	setReq, err := g.CreateSetRequestFromStruct(root, deletes...)
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// getInterfaceState reads the state of the interface named name from the device
+// and copies it into devStatus
+func getInterfaceState(ctx context.Context, g *gnmic.GnmiClient, name string, devStatus *srlinuxv1alpha1.InterfaceDeviceStatus) error {
+	i := &translate.Interface{}
+	if err := g.GetInto(ctx, srlmodels.InterfacePath(name), gnmi.GetRequest_ALL, i); err != nil {
+		return err
+	}
+	i.Status(devStatus)
+	return nil
+}
+
+// cleanup deletes the leaves managed by the Interface from the interface named
+// appliedName on the device, or from the interface of the spec when nothing was
+// applied yet. The subinterfaces are left alone, they belong to their Subinterfaces.
+func (r *InterfaceReconciler) cleanup(ctx context.Context, iface *srlinuxv1alpha1.Interface, dev *srlinuxv1alpha1.Device, appliedName string) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	name := appliedName
+	if name == "" {
+		name = iface.Spec.Name
+	}
+	deletes := make([]*gnmi.Path, 0, len(interfaceLeaves))
+	for _, leaf := range interfaceLeaves {
+		deletes = append(deletes, srlmodels.InterfacePath(name, leaf))
+	}
+	setReq, err := g.CreateSetRequestFromStruct(nil, deletes...)
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// SetupWithManager function
+func (r *InterfaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// status updates must not trigger a reconcile, the resync period takes care of refreshing it
+		For(&srlinuxv1alpha1.Interface{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &srlinuxv1alpha1.Device{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.interfacesForDevice),
+		}).
+		Complete(r)
+}
+
+// interfacesForDevice maps a Device to the Interfaces targeting it
+func (r *InterfaceReconciler) interfacesForDevice(o handler.MapObject) []reconcile.Request {
+	var list srlinuxv1alpha1.InterfaceList
+	if err := r.List(context.Background(), &list, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "cannot list interfaces", "device", o.Meta.GetName())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, iface := range list.Items {
+		if iface.Spec.TargetRef.Selects(o.Meta) {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: iface.Namespace, Name: iface.Name},
+			})
+		}
+	}
+	// a changed Device may accept the configuration it rejected
+	return r.retries.Mark(reqs)
+}
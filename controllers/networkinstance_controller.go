@@ -0,0 +1,278 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/translate"
+)
+
+// networkInstanceFinalizer holds the NetworkInstance until it is removed from the
+// devices
+const networkInstanceFinalizer = "networkinstance.srlinux.henderiw.be/cleanup"
+
+// mgmtNetworkInstance is the network instance of the management interface of SR Linux
+const mgmtNetworkInstance = "mgmt"
+
+// NetworkInstanceReconciler reconciles a NetworkInstance object
+type NetworkInstanceReconciler struct {
+	client.Client
+	Pool *gnmic.Pool
+	// ResyncPeriod is the interval at which the oper-state of the network instances
+	// is read from the devices
+	ResyncPeriod time.Duration
+	Log          logr.Logger
+	Scheme       *runtime.Scheme
+	Recorder     record.EventRecorder
+
+	retries retrySet
+}
+
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=networkinstances,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=networkinstances/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=devices,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile function
+func (r *NetworkInstanceReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	var ni srlinuxv1alpha1.NetworkInstance
+	if err := r.Get(ctx, req.NamespacedName, &ni); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	d := r.devices(ctx, &ni)
+	previous := make([]deviceEntry, 0, len(ni.Status.Devices))
+	for i := range ni.Status.Devices {
+		previous = append(previous, &ni.Status.Devices[i])
+	}
+	if !ni.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, d.finalize(ctx, networkInstanceFinalizer, &ni.Status.Conditions, previous)
+	}
+	if !controllerutil.ContainsFinalizer(&ni, networkInstanceFinalizer) {
+		controllerutil.AddFinalizer(&ni, networkInstanceFinalizer)
+		if err := r.Update(ctx, &ni); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	devices, err := targetDevices(ctx, r.Client, ni.Namespace, &ni.Spec.TargetRef)
+	if err != nil {
+		return ctrl.Result{}, d.targetFailed(ctx, &ni.Status.Conditions, &ni.Status.ObservedGeneration, err)
+	}
+
+	// rejected devices are retried when the spec or the Device changes, not on
+	// every resync
+	d.retry = r.retries.Take(req.NamespacedName)
+	statuses, failed, err := d.run(ctx, devices, previous)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	ni.Status.Devices = make([]srlinuxv1alpha1.NetworkInstanceDeviceStatus, 0, len(statuses))
+	for _, devStatus := range statuses {
+		ni.Status.Devices = append(ni.Status.Devices, *devStatus.(*srlinuxv1alpha1.NetworkInstanceDeviceStatus))
+	}
+
+	setDeviceConditions(&ni.Status.Conditions, ni.Generation, deviceResults(statuses), nil)
+	ni.Status.ObservedGeneration = ni.Generation
+	if err := r.Status().Update(ctx, &ni); err != nil {
+		return ctrl.Result{}, err
+	}
+	return d.result(failed, r.ResyncPeriod)
+}
+
+// devices returns how ni is applied to and removed from the devices. The
+// subinterfaces attached on a device are recorded, so they are detached after they
+// are removed from the spec or the NetworkInstance no longer targets the device.
+func (r *NetworkInstanceReconciler) devices(ctx context.Context, ni *srlinuxv1alpha1.NetworkInstance) *deviceReconcile {
+	return &deviceReconcile{
+		client:   r.Client,
+		log:      r.Log.WithValues("networkinstance", types.NamespacedName{Namespace: ni.Namespace, Name: ni.Name}),
+		recorder: r.Recorder,
+		object:   ni,
+		what:     "network instance " + ni.Name,
+		newStatus: func(name string, prev deviceEntry) deviceEntry {
+			devStatus := &srlinuxv1alpha1.NetworkInstanceDeviceStatus{DeviceResult: srlinuxv1alpha1.DeviceResult{Name: name}}
+			if prev != nil {
+				devStatus.AppliedInterfaces = prev.(*srlinuxv1alpha1.NetworkInstanceDeviceStatus).AppliedInterfaces
+			}
+			return devStatus
+		},
+		apply: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+			return r.applyAndRead(ctx, ni, dev, devStatus.(*srlinuxv1alpha1.NetworkInstanceDeviceStatus))
+		},
+		refresh: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+			return r.refresh(ctx, ni, dev, devStatus.(*srlinuxv1alpha1.NetworkInstanceDeviceStatus))
+		},
+		cleanup: func(dev *srlinuxv1alpha1.Device, prev deviceEntry) error {
+			var appliedInterfaces []string
+			if prev != nil {
+				appliedInterfaces = prev.(*srlinuxv1alpha1.NetworkInstanceDeviceStatus).AppliedInterfaces
+			}
+			return r.cleanup(ctx, ni, dev, appliedInterfaces)
+		},
+	}
+}
+
+// applyAndRead sends the network instance configuration to the device and reads
+// back the resulting network instance state into devStatus
+func (r *NetworkInstanceReconciler) applyAndRead(ctx context.Context, ni *srlinuxv1alpha1.NetworkInstance, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.NetworkInstanceDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	if err := r.apply(ctx, ni, g, devStatus.AppliedInterfaces); err != nil {
+		return err
+	}
+	devStatus.AppliedGeneration = ni.Generation
+	devStatus.AppliedInterfaces = append([]string(nil), ni.Spec.Interfaces...)
+	if err := getNetworkInstanceState(ctx, g, ni.Name, devStatus); err != nil {
+		// the configuration was applied, only the state is missing
+		r.Log.Error(err, "cannot get network instance state", "device", dev.Name)
+		devStatus.Message = fmt.Sprintf("cannot get network instance state: %v", err)
+	}
+	return nil
+}
+
+// refresh reads the network instance state of the device into devStatus
+func (r *NetworkInstanceReconciler) refresh(ctx context.Context, ni *srlinuxv1alpha1.NetworkInstance, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.NetworkInstanceDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	return getNetworkInstanceState(ctx, g, ni.Name, devStatus)
+}
+
+// apply sends the network instance configuration to the device and detaches the
+// subinterfaces that were attached before but are no longer part of the spec. The
+// optional leaves that are not set in the spec are deleted as well.
+func (r *NetworkInstanceReconciler) apply(ctx context.Context, ni *srlinuxv1alpha1.NetworkInstance, g *gnmic.GnmiClient, appliedInterfaces []string) error {
+	root, err := translate.NetworkInstanceFromSpec(ni.Name, &ni.Spec).Device()
+	if err != nil {
+		return gnmic.NewInvalidRequestError("SetRequest", g.Target, err)
+	}
+
+	var deletes []*gnmi.Path
+	if ni.Spec.Description == "" {
+		deletes = append(deletes, srlmodels.NetworkInstancePath(ni.Name, "description"))
+	}
+	if ni.Spec.RouterID == "" {
+		deletes = append(deletes, srlmodels.NetworkInstancePath(ni.Name, "router-id"))
+	}
+	for _, iface := range removedStrings(appliedInterfaces, ni.Spec.Interfaces) {
+		deletes = append(deletes, srlmodels.NetworkInstanceInterfacePath(ni.Name, iface))
+	}
+
+	setReq, err := g.CreateSetRequestFromStruct(root, deletes...)
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// getNetworkInstanceState reads the state of the network instance named name from
+// the device and copies it into devStatus
+func getNetworkInstanceState(ctx context.Context, g *gnmic.GnmiClient, name string, devStatus *srlinuxv1alpha1.NetworkInstanceDeviceStatus) error {
+	n := &translate.NetworkInstance{}
+	if err := g.GetInto(ctx, srlmodels.NetworkInstancePath(name), gnmi.GetRequest_ALL, n); err != nil {
+		return err
+	}
+	n.Status(devStatus)
+	return nil
+}
+
+// cleanup deletes the network instance from the device. The management and the
+// default network instances hold the connection to the device and its routing, they
+// are kept and only the subinterfaces and the leaves set by the NetworkInstance are
+// removed from them.
+func (r *NetworkInstanceReconciler) cleanup(ctx context.Context, ni *srlinuxv1alpha1.NetworkInstance, dev *srlinuxv1alpha1.Device, appliedInterfaces []string) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+
+	deletes := []*gnmi.Path{srlmodels.NetworkInstancePath(ni.Name)}
+	if ni.Name == mgmtNetworkInstance || ni.Spec.Type == "default" {
+		deletes = []*gnmi.Path{
+			srlmodels.NetworkInstancePath(ni.Name, "description"),
+			srlmodels.NetworkInstancePath(ni.Name, "router-id"),
+		}
+		interfaces := append(removedStrings(appliedInterfaces, ni.Spec.Interfaces), ni.Spec.Interfaces...)
+		for _, iface := range interfaces {
+			deletes = append(deletes, srlmodels.NetworkInstanceInterfacePath(ni.Name, iface))
+		}
+	}
+
+	setReq, err := g.CreateSetRequestFromStruct(nil, deletes...)
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// SetupWithManager function
+func (r *NetworkInstanceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// status updates must not trigger a reconcile, the resync period takes care of refreshing it
+		For(&srlinuxv1alpha1.NetworkInstance{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &srlinuxv1alpha1.Device{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.networkInstancesForDevice),
+		}).
+		Complete(r)
+}
+
+// networkInstancesForDevice maps a Device to the NetworkInstances targeting it
+func (r *NetworkInstanceReconciler) networkInstancesForDevice(o handler.MapObject) []reconcile.Request {
+	var list srlinuxv1alpha1.NetworkInstanceList
+	if err := r.List(context.Background(), &list, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "cannot list network instances", "device", o.Meta.GetName())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, ni := range list.Items {
+		if ni.Spec.TargetRef.Selects(o.Meta) {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: ni.Namespace, Name: ni.Name},
+			})
+		}
+	}
+	// a changed Device may accept the configuration it rejected
+	return r.retries.Mark(reqs)
+}
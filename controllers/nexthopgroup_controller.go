@@ -0,0 +1,314 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc/codes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/translate"
+)
+
+// nextHopGroupFinalizer holds the NextHopGroup until it is removed from the devices
+const nextHopGroupFinalizer = "nexthopgroup.srlinux.henderiw.be/cleanup"
+
+// NextHopGroupReconciler reconciles a NextHopGroup object
+type NextHopGroupReconciler struct {
+	client.Client
+	Pool *gnmic.Pool
+	// ResyncPeriod is the interval at which the devices are checked for next-hop
+	// groups that were removed without the NextHopGroup changing
+	ResyncPeriod time.Duration
+	Log          logr.Logger
+	Scheme       *runtime.Scheme
+	Recorder     record.EventRecorder
+
+	retries retrySet
+}
+
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=nexthopgroups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=nexthopgroups/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=devices,verbs=get;list;watch
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=networkinstances,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile function
+func (r *NextHopGroupReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	var nhg srlinuxv1alpha1.NextHopGroup
+	if err := r.Get(ctx, req.NamespacedName, &nhg); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	d := r.devices(ctx, &nhg)
+	previous := make([]deviceEntry, 0, len(nhg.Status.Devices))
+	for i := range nhg.Status.Devices {
+		previous = append(previous, &nhg.Status.Devices[i])
+	}
+	if !nhg.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, d.finalize(ctx, nextHopGroupFinalizer, &nhg.Status.Conditions, previous)
+	}
+	if !controllerutil.ContainsFinalizer(&nhg, nextHopGroupFinalizer) {
+		controllerutil.AddFinalizer(&nhg, nextHopGroupFinalizer)
+		if err := r.Update(ctx, &nhg); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	devices, err := targetDevices(ctx, r.Client, nhg.Namespace, &nhg.Spec.TargetRef)
+	if err != nil {
+		return ctrl.Result{}, d.targetFailed(ctx, &nhg.Status.Conditions, &nhg.Status.ObservedGeneration, err)
+	}
+
+	// rejected devices are retried when the spec or the Device or the NetworkInstance changes, not on
+	// every resync
+	d.retry = r.retries.Take(req.NamespacedName)
+	statuses, failed, err := d.run(ctx, devices, previous)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	nhg.Status.Devices = make([]srlinuxv1alpha1.NextHopGroupDeviceStatus, 0, len(statuses))
+	for _, devStatus := range statuses {
+		nhg.Status.Devices = append(nhg.Status.Devices, *devStatus.(*srlinuxv1alpha1.NextHopGroupDeviceStatus))
+	}
+
+	setDeviceConditions(&nhg.Status.Conditions, nhg.Generation, deviceResults(statuses), nil)
+	nhg.Status.ObservedGeneration = nhg.Generation
+	if err := r.Status().Update(ctx, &nhg); err != nil {
+		return ctrl.Result{}, err
+	}
+	return d.result(failed, r.ResyncPeriod)
+}
+
+// devices returns how nhg is applied to and removed from the devices. The network
+// instance and the next-hops applied to a device are recorded, so they are removed
+// after the spec moved or dropped them or the NextHopGroup no longer targets the
+// device.
+func (r *NextHopGroupReconciler) devices(ctx context.Context, nhg *srlinuxv1alpha1.NextHopGroup) *deviceReconcile {
+	return &deviceReconcile{
+		client:   r.Client,
+		log:      r.Log.WithValues("nexthopgroup", types.NamespacedName{Namespace: nhg.Namespace, Name: nhg.Name}),
+		recorder: r.Recorder,
+		object:   nhg,
+		what:     "next-hop group " + nhg.Name,
+		newStatus: func(name string, prev deviceEntry) deviceEntry {
+			devStatus := &srlinuxv1alpha1.NextHopGroupDeviceStatus{DeviceResult: srlinuxv1alpha1.DeviceResult{Name: name}}
+			if prev != nil {
+				applied := prev.(*srlinuxv1alpha1.NextHopGroupDeviceStatus)
+				devStatus.AppliedNetworkInstance = applied.AppliedNetworkInstance
+				devStatus.AppliedNextHops = applied.AppliedNextHops
+			}
+			return devStatus
+		},
+		apply: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+			return r.apply(ctx, nhg, dev, devStatus.(*srlinuxv1alpha1.NextHopGroupDeviceStatus))
+		},
+		refresh: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+			return r.refresh(ctx, nhg, dev, devStatus.(*srlinuxv1alpha1.NextHopGroupDeviceStatus))
+		},
+		cleanup: func(dev *srlinuxv1alpha1.Device, prev deviceEntry) error {
+			var ni string
+			if prev != nil {
+				ni = prev.(*srlinuxv1alpha1.NextHopGroupDeviceStatus).AppliedNetworkInstance
+			}
+			return r.cleanup(ctx, nhg, dev, ni)
+		},
+	}
+}
+
+// apply sends the next-hop group configuration to the device and records what was
+// applied in devStatus. The next-hops that were applied before but are no longer
+// part of the spec are deleted, the group is removed from the network instance it
+// was applied to before when the spec moved it to another one.
+func (r *NextHopGroupReconciler) apply(ctx context.Context, nhg *srlinuxv1alpha1.NextHopGroup, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.NextHopGroupDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	ni := nhg.Spec.NetworkInstance
+	root, err := translate.NextHopGroupFromSpec(nhg.Name, &nhg.Spec).Device(ni)
+	if err != nil {
+		return gnmic.NewInvalidRequestError("SetRequest", g.Target, err)
+	}
+
+	indexes := make([]uint16, 0, len(nhg.Spec.NextHops))
+	for _, nh := range nhg.Spec.NextHops {
+		indexes = append(indexes, nh.Index)
+	}
+	var deletes []*gnmi.Path
+	if applied := devStatus.AppliedNetworkInstance; applied != "" && applied != ni {
+		deletes = append(deletes, srlmodels.NextHopGroupPath(applied, nhg.Name))
+	} else {
+		for _, index := range removedIndexes(devStatus.AppliedNextHops, indexes) {
+			deletes = append(deletes, srlmodels.NextHopPath(ni, nhg.Name, index))
+		}
+	}
+
+	setReq, err := g.CreateSetRequestFromStruct(root, deletes...)
+	if err != nil {
+		return err
+	}
+	if _, err := g.Set(ctx, setReq); err != nil {
+		return err
+	}
+	devStatus.AppliedGeneration = nhg.Generation
+	devStatus.AppliedNetworkInstance = ni
+	devStatus.AppliedNextHops = indexes
+	return nil
+}
+
+// refresh reads the next-hop group from the device and applies it again when the
+// group or one of its next-hops is no longer configured
+func (r *NextHopGroupReconciler) refresh(ctx context.Context, nhg *srlinuxv1alpha1.NextHopGroup, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.NextHopGroupDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	// group stays nil when the device has no next-hop group at the path
+	var group *struct {
+		NextHops []struct {
+			Index uint16 `json:"index"`
+		} `json:"nexthop"`
+	}
+	err = g.GetInto(ctx, srlmodels.NextHopGroupPath(nhg.Spec.NetworkInstance, nhg.Name), gnmi.GetRequest_CONFIG, &group)
+	if err != nil && gnmic.Code(err) != codes.NotFound {
+		return err
+	}
+	missing := devStatus.AppliedNextHops
+	if group != nil {
+		configured := make([]uint16, 0, len(group.NextHops))
+		for _, nh := range group.NextHops {
+			configured = append(configured, nh.Index)
+		}
+		missing = removedIndexes(devStatus.AppliedNextHops, configured)
+		if len(missing) == 0 {
+			return nil
+		}
+	}
+	r.Log.Info("next-hop group is missing on device, applying it again", "device", dev.Name, "nexthops", missing)
+	if err := r.apply(ctx, nhg, dev, devStatus); err != nil {
+		return err
+	}
+	r.Recorder.Eventf(nhg, corev1.EventTypeNormal, "Reapplied", "applied next-hop group %s to device %s again", nhg.Name, dev.Name)
+	return nil
+}
+
+// cleanup deletes the next-hop group from the network instance named ni of the
+// device, the network instance of the spec when ni is empty
+func (r *NextHopGroupReconciler) cleanup(ctx context.Context, nhg *srlinuxv1alpha1.NextHopGroup, dev *srlinuxv1alpha1.Device, ni string) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	if ni == "" {
+		ni = nhg.Spec.NetworkInstance
+	}
+	setReq, err := g.CreateSetRequestFromStruct(nil, srlmodels.NextHopGroupPath(ni, nhg.Name))
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// removedIndexes returns the indexes in applied that are not in desired
+func removedIndexes(applied, desired []uint16) []uint16 {
+	keep := make(map[uint16]bool, len(desired))
+	for _, index := range desired {
+		keep[index] = true
+	}
+	var removed []uint16
+	for _, index := range applied {
+		if !keep[index] {
+			removed = append(removed, index)
+		}
+	}
+	return removed
+}
+
+// SetupWithManager function
+func (r *NextHopGroupReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// status updates must not trigger a reconcile
+		For(&srlinuxv1alpha1.NextHopGroup{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &srlinuxv1alpha1.Device{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.nextHopGroupsForDevice),
+		}).
+		// a device rejects a next-hop group until its network instance is created
+		Watches(&source.Kind{Type: &srlinuxv1alpha1.NetworkInstance{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.nextHopGroupsForNetworkInstance),
+		}).
+		Complete(r)
+}
+
+// nextHopGroupsForDevice maps a Device to the NextHopGroups targeting it
+func (r *NextHopGroupReconciler) nextHopGroupsForDevice(o handler.MapObject) []reconcile.Request {
+	var list srlinuxv1alpha1.NextHopGroupList
+	if err := r.List(context.Background(), &list, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "cannot list next-hop groups", "device", o.Meta.GetName())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, nhg := range list.Items {
+		if nhg.Spec.TargetRef.Selects(o.Meta) {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: nhg.Namespace, Name: nhg.Name},
+			})
+		}
+	}
+	// a changed Device may accept the next-hop group it rejected
+	return r.retries.Mark(reqs)
+}
+
+// nextHopGroupsForNetworkInstance maps a NetworkInstance to the NextHopGroups in it
+func (r *NextHopGroupReconciler) nextHopGroupsForNetworkInstance(o handler.MapObject) []reconcile.Request {
+	var list srlinuxv1alpha1.NextHopGroupList
+	if err := r.List(context.Background(), &list, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "cannot list next-hop groups", "networkinstance", o.Meta.GetName())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, nhg := range list.Items {
+		if nhg.Spec.NetworkInstance == o.Meta.GetName() {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: nhg.Namespace, Name: nhg.Name},
+			})
+		}
+	}
+	// the devices reject the next-hop group until its network instance exists
+	return r.retries.Mark(reqs)
+}
@@ -17,32 +17,59 @@ limitations under the License.
 package controllers
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
 	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/translate"
 )
 
+// ntpFinalizer holds the Ntp until its configuration is removed from the devices
+const ntpFinalizer = "ntp.srlinux.henderiw.be/cleanup"
+
+// ntpListKeys are the keys of the lists in the ntp configuration
+var ntpListKeys = map[string]string{"server": "address"}
+
 // NtpReconciler reconciles a Ntp object
 type NtpReconciler struct {
 	client.Client
-	GnmiClient *gnmic.GnmiClient
-	Log        logr.Logger
-	Scheme     *runtime.Scheme
+	Pool *gnmic.Pool
+	// ResyncPeriod is the interval at which the configuration of the devices is
+	// compared with the spec
+	ResyncPeriod time.Duration
+	Log          logr.Logger
+	Scheme       *runtime.Scheme
+	Recorder     record.EventRecorder
+
+	watcher *stateWatcher
 }
 
 // +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=ntps,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=ntps/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=devices,verbs=get;list;watch
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=networkinstances,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile function
 func (r *NtpReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
@@ -56,66 +83,477 @@ func (r *NtpReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	if err := r.Get(ctx, req.NamespacedName, &ntp); err != nil {
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
-
 	for _, ntp := range ntp.Spec.Server {
 		log.Info(ntp.Address)
 	}
 
-	//var path []string
-	//var value []string
-	//path = append(path, "/system/ntp/admin-state")
-	//value = append(value, "disable")
-	//setInput := &gnmiclient.SetCmdInput{
-	//	UpdatePaths:  path,
-	//	UpdateValues: value,
-	//}
-	// setReq, err := r.GnmiClient.CreateSetRequest(setInput)
-	// if err != nil {
-	// 	return ctrl.Result{}, client.IgnoreNotFound(err)
-	// }
-
-	path := "/system/ntp"
-	gnmiPath, err := gnmic.ParsePath(strings.TrimSpace(path))
+	if !ntp.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, r.finalize(ctx, &ntp)
+	}
+	if !controllerutil.ContainsFinalizer(&ntp, ntpFinalizer) {
+		controllerutil.AddFinalizer(&ntp, ntpFinalizer)
+		if err := r.Update(ctx, &ntp); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	devices, err := targetDevices(ctx, r.Client, ntp.Namespace, &ntp.Spec.TargetRef)
 	if err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
+		// the state of deleted devices is no longer watched, the Device controller
+		// removed them from the pool already
+		names := make([]string, 0, len(ntp.Status.Devices))
+		for _, devStatus := range ntp.Status.Devices {
+			names = append(names, devStatus.Name)
+		}
+		deleted, derr := deletedDevices(ctx, r.Client, ntp.Namespace, names)
+		if derr != nil {
+			return ctrl.Result{}, derr
+		}
+		for _, name := range deleted {
+			r.watcher.Unwatch(types.NamespacedName{Namespace: ntp.Namespace, Name: name}.String(), srlmodels.NtpPath(), req.NamespacedName)
+		}
+		r.Recorder.Eventf(&ntp, corev1.EventTypeWarning, "TargetFailed", "cannot select devices: %v", err)
+		setCondition(&ntp.Status.Conditions, ntp.Generation, srlinuxv1alpha1.ConditionTypeReady, false, "TargetFailed", err.Error())
+		ntp.Status.ObservedGeneration = ntp.Generation
+		if err := r.Status().Update(ctx, &ntp); err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, err
+	}
+
+	// rejected devices are retried when the spec or the Device or NetworkInstance
+	// changes, not on every state change pushed by the devices
+	retry := r.watcher.TakeRetry(req.NamespacedName)
+	previous := make(map[string]srlinuxv1alpha1.NtpDeviceStatus, len(ntp.Status.Devices))
+	for _, devStatus := range ntp.Status.Devices {
+		previous[devStatus.Name] = devStatus
+	}
+
+	policy := ntpSpec(&ntp).DriftPolicy
+	var failed int
+	var drifted []string
+	ntp.Status.Devices = make([]srlinuxv1alpha1.NtpDeviceStatus, 0, len(devices))
+	for i := range devices {
+		dev := &devices[i]
+		prev, ok := previous[dev.Name]
+		delete(previous, dev.Name)
+		if ok && prev.Result == resultRejected && prev.AppliedGeneration == ntp.Generation && !retry {
+			// the device refused this generation already
+			ntp.Status.Devices = append(ntp.Status.Devices, prev)
+			continue
+		}
+
+		var err error
+		var diffs []string
+		devStatus := srlinuxv1alpha1.NtpDeviceStatus{
+			Name:           dev.Name,
+			Result:         resultApplied,
+			AppliedServers: prev.AppliedServers,
+		}
+		if ok && prev.Result == resultApplied && prev.AppliedGeneration == ntp.Generation {
+			// the spec is applied already, look for changes made on the device
+			devStatus = prev
+			devStatus.Message = ""
+			diffs, err = r.checkDrift(ctx, &ntp, dev, &devStatus, policy)
+		} else {
+			err = r.applyAndRead(ctx, &ntp, dev, &devStatus)
+			if err == nil {
+				r.Recorder.Eventf(&ntp, corev1.EventTypeNormal, "Applied", "applied ntp to device %s", dev.Name)
+			}
+		}
+		if err != nil {
+			log.Error(err, "cannot apply ntp", "device", dev.Name)
+			r.Recorder.Eventf(&ntp, corev1.EventTypeWarning, "ApplyFailed", "cannot apply ntp to device %s: %v", dev.Name, err)
+			devStatus.Result = deviceResult(err)
+			devStatus.Message = err.Error()
+			if devStatus.Result == resultFailed {
+				failed++
+			} else {
+				// the generation the device rejected
+				devStatus.AppliedGeneration = ntp.Generation
+			}
+		} else if devStatus.Message != "" {
+			r.Recorder.Eventf(&ntp, corev1.EventTypeWarning, "StateFailed", "device %s: %s", dev.Name, devStatus.Message)
+		}
+		if len(diffs) > 0 {
+			log.Info("ntp drifted", "device", dev.Name, "diff", diffs)
+			drifted = append(drifted, fmt.Sprintf("%s: %s", dev.Name, driftSummary(diffs)))
+			if policy == driftPolicyRemediate {
+				r.Recorder.Eventf(&ntp, corev1.EventTypeNormal, "Remediated", "remediated ntp drift on device %s: %s", dev.Name, driftSummary(diffs))
+			} else {
+				r.Recorder.Eventf(&ntp, corev1.EventTypeWarning, "Drifted", "ntp drifted on device %s: %s", dev.Name, driftSummary(diffs))
+			}
+		}
+		ntp.Status.Devices = append(ntp.Status.Devices, devStatus)
+	}
+	setNtpDriftCondition(&ntp, policy, drifted)
+
+	// devices that are no longer targeted converge to having none of the servers
+	for name, prev := range previous {
+		r.watcher.Unwatch(types.NamespacedName{Namespace: ntp.Namespace, Name: name}.String(), srlmodels.NtpPath(), req.NamespacedName)
+		appliedServers := prev.AppliedServers
+		var dev srlinuxv1alpha1.Device
+		if err := r.Get(ctx, types.NamespacedName{Namespace: ntp.Namespace, Name: name}, &dev); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return ctrl.Result{}, err
+		}
+		if err := r.cleanup(ctx, &ntp, &dev, appliedServers); err != nil {
+			log.Error(err, "cannot remove ntp", "device", name)
+			r.Recorder.Eventf(&ntp, corev1.EventTypeWarning, "CleanupFailed", "cannot remove ntp from device %s: %v", name, err)
+			ntp.Status.Devices = append(ntp.Status.Devices, srlinuxv1alpha1.NtpDeviceStatus{
+				Name:           name,
+				Result:         deviceResult(err),
+				Message:        err.Error(),
+				AppliedServers: appliedServers,
+			})
+			if deviceResult(err) == resultFailed {
+				failed++
+			}
+			continue
+		}
+		r.Recorder.Eventf(&ntp, corev1.EventTypeNormal, "Removed", "removed ntp from device %s", name)
+	}
+
+	if policy == driftPolicyRemediate {
+		// remediated devices are in sync again
+		drifted = nil
+	}
+	setNtpConditions(&ntp, drifted)
+	ntp.Status.ObservedGeneration = ntp.Generation
+	if err := r.Status().Update(ctx, &ntp); err != nil {
+		return ctrl.Result{}, err
+	}
+	if failed > 0 {
+		// transient failures are requeued with the exponential backoff of the
+		// controller, rejected configurations wait for a spec change or the resync
+		return ctrl.Result{}, fmt.Errorf("failed applying ntp to %d devices", failed)
 	}
 
-	specBytes, _ := json.Marshal(ntp.Spec)
-	fmt.Printf("bytes: %s \n", specBytes)
-	value := new(gnmi.TypedValue)
-	value.Value = &gnmi.TypedValue_JsonIetfVal{
-		JsonIetfVal: bytes.Trim(specBytes, " \r\n\t"),
+	if policy != driftPolicyIgnore && r.ResyncPeriod > 0 {
+		return ctrl.Result{RequeueAfter: r.ResyncPeriod}, nil
 	}
+	return ctrl.Result{}, nil
+}
 
-	gnmiPrefix, err := gnmic.CreatePrefix("", r.GnmiClient.Target)
+// checkDrift refreshes the ntp state in devStatus, compares the ntp configuration
+// of the device with the spec and re-applies the spec when the policy asks for it.
+// It returns the differences that were found.
+func (r *NtpReconciler) checkDrift(ctx context.Context, ntp *srlinuxv1alpha1.Ntp, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.NtpDeviceStatus, policy string) ([]string, error) {
+	g, err := r.deviceClient(ctx, ntp, dev)
 	if err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
+		return nil, err
+	}
+	// the subscription keeps the tree of the device current, only read it when
+	// the subscription is not synchronized
+	actual, ok := r.watcher.State(deviceKey(dev), srlmodels.NtpPath())
+	if !ok {
+		if actual, err = getNtp(ctx, g); err != nil {
+			return nil, err
+		}
+	}
+	if policy == driftPolicyIgnore {
+		return nil, copyNtpState(actual, devStatus)
 	}
+	config, err := translate.NtpFromSpec(ntpSpec(ntp)).Config()
+	if err != nil {
+		return nil, err
+	}
+	desired, err := toGeneric(config)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs := diffConfig("/system/ntp", desired, actual, ntpListKeys)
+	if len(diffs) > 0 && policy == driftPolicyRemediate {
+		r.Log.Info("remediating ntp drift", "device", dev.Name)
+		onDevice, err := translate.NtpFromTree(actual)
+		if err != nil {
+			return nil, err
+		}
+		// the servers added on the device are deleted like the servers removed
+		// from the spec
+		devStatus.AppliedServers = mergeNtpServers(devStatus.AppliedServers, onDevice.Server)
+		return diffs, r.applyAndRead(ctx, ntp, dev, devStatus)
+	}
+	return diffs, copyNtpState(actual, devStatus)
+}
+
+// setNtpDriftCondition reports the devices in drifted in the Drifted condition. The
+// condition is Unknown when the policy ignores drift, so a drift reported before
+// the policy changed does not stay behind.
+func setNtpDriftCondition(ntp *srlinuxv1alpha1.Ntp, policy string, drifted []string) {
+	c := srlinuxv1alpha1.Condition{
+		Type:               srlinuxv1alpha1.ConditionTypeDrifted,
+		Status:             corev1.ConditionFalse,
+		ObservedGeneration: ntp.Generation,
+		Reason:             "InSync",
+	}
+	switch {
+	case policy == driftPolicyIgnore:
+		c.Status = corev1.ConditionUnknown
+		c.Reason = "Ignored"
+	case len(drifted) == 0:
+	case policy == driftPolicyRemediate:
+		c.Reason = "Remediated"
+		c.Message = strings.Join(drifted, "; ")
+	default:
+		c.Status = corev1.ConditionTrue
+		c.Reason = "DriftDetected"
+		c.Message = strings.Join(drifted, "; ")
+	}
+	srlinuxv1alpha1.SetCondition(&ntp.Status.Conditions, c)
+}
+
+// setNtpConditions summarizes the device statuses of the Ntp and the devices in
+// drifted in its Applied, Synced, Degraded and Ready conditions
+func setNtpConditions(ntp *srlinuxv1alpha1.Ntp, drifted []string) {
+	results := make([]srlinuxv1alpha1.DeviceResult, 0, len(ntp.Status.Devices))
+	for _, devStatus := range ntp.Status.Devices {
+		results = append(results, srlinuxv1alpha1.DeviceResult{
+			Name:    devStatus.Name,
+			Result:  devStatus.Result,
+			Message: devStatus.Message,
+		})
+	}
+	setDeviceConditions(&ntp.Status.Conditions, ntp.Generation, results, drifted)
+}
+
+// applyAndRead sends the ntp configuration to the device and reads back the
+// resulting ntp state into devStatus
+func (r *NtpReconciler) applyAndRead(ctx context.Context, ntp *srlinuxv1alpha1.Ntp, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.NtpDeviceStatus) error {
+	g, err := r.deviceClient(ctx, ntp, dev)
+	if err != nil {
+		return err
+	}
+	if err := r.apply(ctx, ntp, g, devStatus.AppliedServers); err != nil {
+		return err
+	}
+	devStatus.AppliedGeneration = ntp.Generation
+	devStatus.AppliedServers = make([]string, 0, len(ntp.Spec.Server))
+	for _, server := range ntp.Spec.Server {
+		devStatus.AppliedServers = append(devStatus.AppliedServers, server.Address)
+	}
+	if err := getNtpState(ctx, g, devStatus); err != nil {
+		// the configuration was applied, only the state is missing
+		r.Log.Error(err, "cannot get ntp state", "device", dev.Name)
+		devStatus.Message = fmt.Sprintf("cannot get ntp state: %v", err)
+	}
+	return nil
+}
 
-	setReq := &gnmi.SetRequest{
-		Prefix:  gnmiPrefix,
-		Delete:  make([]*gnmi.Path, 0, 0),
-		Replace: make([]*gnmi.Update, 0),
-		Update:  make([]*gnmi.Update, 0),
+// deviceClient returns the gnmi client of the device and makes sure the ntp state
+// of the device is watched on behalf of the Ntp
+func (r *NtpReconciler) deviceClient(ctx context.Context, ntp *srlinuxv1alpha1.Ntp, dev *srlinuxv1alpha1.Device) (*gnmic.GnmiClient, error) {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return nil, err
 	}
+	r.watcher.Watch(deviceKey(dev), srlmodels.NtpPath(), types.NamespacedName{Namespace: ntp.Namespace, Name: ntp.Name}, g)
+	return g, nil
+}
 
-	setReq.Update = append(setReq.Update, &gnmi.Update{
-		Path: gnmiPath,
-		Val:  value,
+// apply sends the ntp configuration to the device and deletes the servers that
+// were applied before but are no longer part of the spec
+func (r *NtpReconciler) apply(ctx context.Context, ntp *srlinuxv1alpha1.Ntp, g *gnmic.GnmiClient, appliedServers []string) error {
+	root, err := translate.NtpFromSpec(ntpSpec(ntp)).Device()
+	if err != nil {
+		return gnmic.NewInvalidRequestError("SetRequest", g.Target, err)
+	}
+
+	var deletes []*gnmi.Path
+	for _, address := range removedNtpServers(appliedServers, ntp.Spec.Server) {
+		deletes = append(deletes, srlmodels.NtpServerPath(address))
+	}
+
+	setReq, err := g.CreateSetRequestFromStruct(root, deletes...)
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// finalize removes the ntp configuration from the devices it was applied to and
+// releases the Ntp. Devices that cannot be cleaned up are reported in the Deleted
+// condition and retried.
+func (r *NtpReconciler) finalize(ctx context.Context, ntp *srlinuxv1alpha1.Ntp) error {
+	log := r.Log.WithValues("ntp", types.NamespacedName{Namespace: ntp.Namespace, Name: ntp.Name})
+
+	if !controllerutil.ContainsFinalizer(ntp, ntpFinalizer) {
+		return nil
+	}
+
+	names := make([]string, 0, len(ntp.Status.Devices))
+	applied := make(map[string][]string, len(ntp.Status.Devices))
+	for _, devStatus := range ntp.Status.Devices {
+		r.watcher.Unwatch(types.NamespacedName{Namespace: ntp.Namespace, Name: devStatus.Name}.String(), srlmodels.NtpPath(),
+			types.NamespacedName{Namespace: ntp.Namespace, Name: ntp.Name})
+		names = append(names, devStatus.Name)
+		applied[devStatus.Name] = devStatus.AppliedServers
+	}
+	failed, retryable, err := cleanupDevices(ctx, r.Client, ntp.Namespace, names, func(dev *srlinuxv1alpha1.Device) error {
+		if err := r.cleanup(ctx, ntp, dev, applied[dev.Name]); err != nil {
+			log.Error(err, "cannot remove ntp", "device", dev.Name)
+			r.Recorder.Eventf(ntp, corev1.EventTypeWarning, "CleanupFailed", "cannot remove ntp from device %s: %v", dev.Name, err)
+			return err
+		}
+		r.Recorder.Eventf(ntp, corev1.EventTypeNormal, "Removed", "removed ntp from device %s", dev.Name)
+		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	if len(failed) > 0 {
+		setDeletedCondition(&ntp.Status.Conditions, ntp.Generation, failed, retryable)
+		if err := r.Status().Update(ctx, ntp); err != nil {
+			return err
+		}
+		if retryable == 0 {
+			// the devices will refuse the cleanup again, the finalizer has to be
+			// removed by hand once the devices are fixed
+			return nil
+		}
+		return fmt.Errorf("failed removing ntp from %d devices", retryable)
+	}
+
+	log.Info("removed ntp from all devices")
+	controllerutil.RemoveFinalizer(ntp, ntpFinalizer)
+	return r.Update(ctx, ntp)
+}
 
-	_, err = r.GnmiClient.Set(ctx, setReq)
+// cleanup removes the servers of the Ntp, and the servers applied by an earlier
+// version of it, from the device, or restores the baseline when the Ntp has one
+func (r *NtpReconciler) cleanup(ctx context.Context, ntp *srlinuxv1alpha1.Ntp, dev *srlinuxv1alpha1.Device, appliedServers []string) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
 	if err != nil {
-		return ctrl.Result{}, client.IgnoreNotFound(err)
+		return err
 	}
-	//log.Info(resp.GetResponse())
 
-	return ctrl.Result{}, nil
+	addresses := removedNtpServers(appliedServers, ntp.Spec.Server)
+	for _, server := range ntp.Spec.Server {
+		addresses = append(addresses, server.Address)
+	}
+	deletes := make([]*gnmi.Path, 0, len(addresses))
+	for _, address := range addresses {
+		deletes = append(deletes, srlmodels.NtpServerPath(address))
+	}
+
+	var root ygot.ValidatedGoStruct
+	if ntp.Spec.Baseline != nil {
+		baseline, err := translate.NtpFromBaseline(ntp.Spec.Baseline).Device()
+		if err != nil {
+			return gnmic.NewInvalidRequestError("SetRequest", g.Target, err)
+		}
+		root = baseline
+	}
+
+	if len(deletes) == 0 && root == nil {
+		return nil
+	}
+	setReq, err := g.CreateSetRequestFromStruct(root, deletes...)
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// mergeNtpServers returns the addresses in applied followed by the addresses of
+// servers that are not in applied
+func mergeNtpServers(applied []string, servers []translate.NtpServer) []string {
+	merged := append([]string(nil), applied...)
+	seen := make(map[string]bool, len(applied))
+	for _, address := range applied {
+		seen[address] = true
+	}
+	for _, server := range servers {
+		if !seen[server.Address] {
+			seen[server.Address] = true
+			merged = append(merged, server.Address)
+		}
+	}
+	return merged
+}
+
+// ntpSpec returns the spec of ntp with the defaults of the webhook, Ntps created
+// while the defaulting webhook was not running are applied with the same defaults.
+// ntp is left untouched so the defaults are not written back with its finalizer.
+func ntpSpec(ntp *srlinuxv1alpha1.Ntp) *srlinuxv1alpha1.NtpSpec {
+	defaulted := ntp.DeepCopy()
+	defaulted.Default()
+	return &defaulted.Spec
+}
+
+// removedNtpServers returns the addresses in applied that are not in servers
+func removedNtpServers(applied []string, servers []srlinuxv1alpha1.NtpServer) []string {
+	keep := make(map[string]bool, len(servers))
+	for _, server := range servers {
+		keep[server.Address] = true
+	}
+	var removed []string
+	for _, address := range applied {
+		if !keep[address] {
+			removed = append(removed, address)
+		}
+	}
+	return removed
 }
 
 // SetupWithManager function
 func (r *NtpReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	r.watcher = newStateWatcher(r.Log.WithName("watcher"), func() watchedObject {
+		return &srlinuxv1alpha1.Ntp{}
+	})
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&srlinuxv1alpha1.Ntp{}).
+		// status updates must not trigger a reconcile, the resync period takes care of refreshing it
+		For(&srlinuxv1alpha1.Ntp{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &srlinuxv1alpha1.Device{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.ntpsForDevice),
+		}).
+		// a device may reject an ntp until its network instance is created
+		Watches(&source.Kind{Type: &srlinuxv1alpha1.NetworkInstance{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.ntpsForNetworkInstance),
+		}).
+		// changes of the ntp state pushed by the devices
+		Watches(&source.Channel{Source: r.watcher.events}, &handler.EnqueueRequestForObject{}).
 		Complete(r)
 }
+
+// ntpsForDevice maps a Device to the Ntps targeting it
+func (r *NtpReconciler) ntpsForDevice(o handler.MapObject) []reconcile.Request {
+	var list srlinuxv1alpha1.NtpList
+	if err := r.List(context.Background(), &list, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "cannot list ntps", "device", o.Meta.GetName())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, ntp := range list.Items {
+		if ntp.Spec.TargetRef.Selects(o.Meta) {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: ntp.Namespace, Name: ntp.Name},
+			})
+		}
+	}
+	return r.watcher.Retry(reqs)
+}
+
+// ntpsForNetworkInstance maps a NetworkInstance to the Ntps using it
+func (r *NtpReconciler) ntpsForNetworkInstance(o handler.MapObject) []reconcile.Request {
+	var list srlinuxv1alpha1.NtpList
+	if err := r.List(context.Background(), &list, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "cannot list ntps", "networkinstance", o.Meta.GetName())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, ntp := range list.Items {
+		if ntpSpec(&ntp).NetworkInstance == o.Meta.GetName() {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: ntp.Namespace, Name: ntp.Name},
+			})
+		}
+	}
+	return r.watcher.Retry(reqs)
+}
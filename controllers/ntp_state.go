@@ -0,0 +1,54 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/translate"
+)
+
+// getNtp reads /system/ntp from the device and returns it in its generic json
+// representation with the module prefixes removed
+func getNtp(ctx context.Context, g *gnmic.GnmiClient) (interface{}, error) {
+	return g.GetTree(ctx, srlmodels.NtpPath(), gnmi.GetRequest_ALL)
+}
+
+// getNtpState reads the ntp state of the device and copies it into devStatus
+func getNtpState(ctx context.Context, g *gnmic.GnmiClient, devStatus *srlinuxv1alpha1.NtpDeviceStatus) error {
+	ntp := &translate.Ntp{}
+	if err := g.GetInto(ctx, srlmodels.NtpPath(), gnmi.GetRequest_ALL, ntp); err != nil {
+		return err
+	}
+	ntp.Status(devStatus)
+	return nil
+}
+
+// copyNtpState copies the generic json representation of /system/ntp into devStatus
+func copyNtpState(tree interface{}, devStatus *srlinuxv1alpha1.NtpDeviceStatus) error {
+	ntp, err := translate.NtpFromTree(tree)
+	if err != nil {
+		return err
+	}
+	ntp.Status(devStatus)
+	return nil
+}
@@ -0,0 +1,309 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+)
+
+// watchedObject is the kind of the objects queued by a stateWatcher
+type watchedObject interface {
+	metav1.Object
+	runtime.Object
+}
+
+// stateEventInterval is the minimum interval between two events queued for the
+// same object, counters and offsets change far more often than objects need to
+// be reconciled
+const stateEventInterval = 10 * time.Second
+
+// stateWatcher keeps on-change subscriptions to a path on the devices, caches
+// the tree below the path and queues the objects that registered for the path
+// of a device whenever it changes
+type stateWatcher struct {
+	mu        sync.Mutex
+	log       logr.Logger
+	events    chan event.GenericEvent
+	newObject func() watchedObject
+	watches   map[string]*stateWatch
+	// interval is the minimum interval between two events of an object
+	interval time.Duration
+	// queued holds the time the last event was queued for an object, pending the
+	// objects with an event waiting for the interval to pass
+	queued  map[types.NamespacedName]time.Time
+	pending map[types.NamespacedName]bool
+	// retries holds the objects whose rejected devices are retried on their
+	// next reconcile
+	retries retrySet
+}
+
+// stateWatch is the subscription to a single path of a single device
+type stateWatch struct {
+	client  *gnmic.GnmiClient
+	cancel  context.CancelFunc
+	path    *gnmi.Path
+	objects map[types.NamespacedName]bool
+	tree    map[string]interface{}
+	synced  bool
+}
+
+// newStateWatcher returns a stateWatcher queueing the objects returned by
+// newObject
+func newStateWatcher(log logr.Logger, newObject func() watchedObject) *stateWatcher {
+	return &stateWatcher{
+		log:       log,
+		events:    make(chan event.GenericEvent, 1024),
+		newObject: newObject,
+		watches:   make(map[string]*stateWatch),
+		interval:  stateEventInterval,
+		queued:    make(map[types.NamespacedName]time.Time),
+		pending:   make(map[types.NamespacedName]bool),
+	}
+}
+
+// watchKey returns the key of the subscription to path on device
+func watchKey(device string, path *gnmi.Path) string {
+	return device + gnmic.PathToString(path)
+}
+
+// Watch registers obj for path on device and subscribes to the device through g.
+// The subscription is restarted when the device was re-dialed.
+func (w *stateWatcher) Watch(device string, path *gnmi.Path, obj types.NamespacedName, g *gnmic.GnmiClient) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	key := watchKey(device, path)
+	sw, ok := w.watches[key]
+	if ok && sw.client == g && !g.Closed() {
+		sw.objects[obj] = true
+		return
+	}
+	objects := map[types.NamespacedName]bool{obj: true}
+	if ok {
+		sw.cancel()
+		for o := range sw.objects {
+			objects[o] = true
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sw = &stateWatch{
+		client:  g,
+		cancel:  cancel,
+		path:    path,
+		objects: objects,
+	}
+	w.watches[key] = sw
+
+	sub, err := g.Subscribe(ctx, &gnmic.SubscribeInput{
+		Paths:      []string{gnmic.PathToString(path)},
+		Mode:       "stream",
+		StreamMode: "on_change",
+	})
+	if err != nil {
+		w.log.Error(err, "cannot subscribe", "device", device, "path", gnmic.PathToString(path))
+		cancel()
+		delete(w.watches, key)
+		return
+	}
+	go w.run(key, sw, sub)
+}
+
+// Unwatch removes obj from the objects registered for path on device and ends
+// the subscription when no object is registered anymore
+func (w *stateWatcher) Unwatch(device string, path *gnmi.Path, obj types.NamespacedName) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	key := watchKey(device, path)
+	sw, ok := w.watches[key]
+	if !ok {
+		return
+	}
+	delete(sw.objects, obj)
+	delete(w.queued, obj)
+	if len(sw.objects) == 0 {
+		sw.cancel()
+		delete(w.watches, key)
+	}
+}
+
+// State returns the cached tree below path on device, ok is false as long as
+// the subscription is not synchronized
+func (w *stateWatcher) State(device string, path *gnmi.Path) (interface{}, bool) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	sw, ok := w.watches[watchKey(device, path)]
+	if !ok || !sw.synced {
+		return nil, false
+	}
+	tree, err := toGeneric(sw.tree)
+	if err != nil {
+		return nil, false
+	}
+	return tree, true
+}
+
+// run applies the responses of the subscription to the cached tree. The
+// subscription ends when the pool closes the client of the device, the watch is
+// removed then and its objects are queued to watch the device again through the
+// client that replaced it.
+func (w *stateWatcher) run(key string, sw *stateWatch, sub *gnmic.Subscription) {
+	defer func() {
+		w.mu.Lock()
+		var objects []types.NamespacedName
+		if w.watches[key] == sw {
+			delete(w.watches, key)
+			sw.cancel()
+			for o := range sw.objects {
+				objects = append(objects, o)
+			}
+		}
+		w.mu.Unlock()
+		w.enqueue(objects)
+	}()
+
+	for rsp := range sub.Responses() {
+		w.mu.Lock()
+		if w.watches[key] != sw {
+			// the subscription was replaced or removed
+			w.mu.Unlock()
+			sub.Close()
+			return
+		}
+		switch {
+		case rsp.Err != nil:
+			w.log.Error(rsp.Err, "subscription failed", "key", key)
+			sw.synced = false
+			sw.tree = nil
+		case rsp.SyncResponse:
+			sw.synced = true
+		default:
+			if sw.tree == nil {
+				sw.tree = make(map[string]interface{})
+			}
+			for _, u := range rsp.Updates {
+				if elems := relativePath(sw.path, u.Path); elems != nil {
+					gnmic.SetTreeValue(sw.tree, elems, gnmic.StripModulePrefixes(u.Value))
+				}
+			}
+			for _, d := range rsp.Deletes {
+				if elems := relativePath(sw.path, d); elems != nil {
+					gnmic.DeleteTreeValue(sw.tree, elems)
+				}
+			}
+		}
+		var objects []types.NamespacedName
+		if sw.synced || rsp.Err != nil {
+			for o := range sw.objects {
+				objects = append(objects, o)
+			}
+		}
+		w.mu.Unlock()
+		w.enqueue(objects)
+	}
+}
+
+// enqueue queues the objects. The events of an object are coalesced into one
+// event per interval.
+func (w *stateWatcher) enqueue(objects []types.NamespacedName) {
+	var now []types.NamespacedName
+	w.mu.Lock()
+	for _, o := range objects {
+		if w.pending[o] {
+			continue
+		}
+		wait := w.interval - time.Since(w.queued[o])
+		if wait <= 0 {
+			w.queued[o] = time.Now()
+			now = append(now, o)
+			continue
+		}
+		w.pending[o] = true
+		o := o
+		time.AfterFunc(wait, func() {
+			w.mu.Lock()
+			delete(w.pending, o)
+			w.queued[o] = time.Now()
+			w.mu.Unlock()
+			w.send(o)
+		})
+	}
+	w.mu.Unlock()
+
+	for _, o := range now {
+		w.send(o)
+	}
+}
+
+// send queues an event for o
+func (w *stateWatcher) send(o types.NamespacedName) {
+	obj := w.newObject()
+	obj.SetNamespace(o.Namespace)
+	obj.SetName(o.Name)
+	w.events <- event.GenericEvent{Meta: obj, Object: obj}
+}
+
+// Retry marks the objects of reqs to retry their rejected devices on the next
+// reconcile and returns reqs. It wraps the mapping of the objects the spec depends
+// on, state changes pushed by the devices do not retry rejected devices.
+func (w *stateWatcher) Retry(reqs []reconcile.Request) []reconcile.Request {
+	return w.retries.Mark(reqs)
+}
+
+// TakeRetry reports whether the rejected devices of obj are to be retried and
+// clears the mark
+func (w *stateWatcher) TakeRetry(obj types.NamespacedName) bool {
+	return w.retries.Take(obj)
+}
+
+// relativePath returns the elements of path below base, or nil when path is
+// not below base. Keys that are set in base must match the keys of path.
+func relativePath(base *gnmi.Path, path string) []*gnmi.PathElem {
+	p, err := gnmic.ParsePath(path)
+	if err != nil {
+		return nil
+	}
+	elems := p.GetElem()
+	if len(elems) < len(base.GetElem()) {
+		return nil
+	}
+	for i, e := range base.GetElem() {
+		if gnmic.StripModulePrefix(elems[i].GetName()) != e.GetName() {
+			return nil
+		}
+		for k, v := range e.GetKey() {
+			if elems[i].GetKey()[k] != v {
+				return nil
+			}
+		}
+	}
+	return elems[len(base.GetElem()):]
+}
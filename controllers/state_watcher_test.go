@@ -0,0 +1,73 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+)
+
+func TestStateWatcherCoalescesEvents(t *testing.T) {
+	w := newStateWatcher(ctrl.Log, func() watchedObject { return &srlinuxv1alpha1.Ntp{} })
+	w.interval = 50 * time.Millisecond
+	a := types.NamespacedName{Namespace: "default", Name: "a"}
+	b := types.NamespacedName{Namespace: "default", Name: "b"}
+
+	for i := 0; i < 10; i++ {
+		w.enqueue([]types.NamespacedName{a, b})
+	}
+	// one event per object right away, one for the changes that followed it
+	// once the interval passed
+	counts := map[string]int{}
+	timeout := time.After(200 * time.Millisecond)
+	for done := false; !done; {
+		select {
+		case e := <-w.events:
+			counts[e.Meta.GetName()]++
+		case <-timeout:
+			done = true
+		}
+	}
+	if counts["a"] != 2 || counts["b"] != 2 {
+		t.Errorf("got events %v, want 2 per object", counts)
+	}
+}
+
+func TestStateWatcherRetry(t *testing.T) {
+	w := newStateWatcher(ctrl.Log, func() watchedObject { return &srlinuxv1alpha1.Ntp{} })
+	a := types.NamespacedName{Namespace: "default", Name: "a"}
+
+	if w.TakeRetry(a) {
+		t.Error("retry without a change of a dependency")
+	}
+	reqs := w.Retry([]reconcile.Request{{NamespacedName: a}})
+	if len(reqs) != 1 {
+		t.Errorf("Retry() = %v, want the requests", reqs)
+	}
+	if !w.TakeRetry(a) {
+		t.Error("no retry after a change of a dependency")
+	}
+	if w.TakeRetry(a) {
+		t.Error("the retry was not cleared")
+	}
+}
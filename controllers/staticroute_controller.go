@@ -0,0 +1,323 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/translate"
+)
+
+// staticRouteFinalizer holds the StaticRoute until it is removed from the devices
+const staticRouteFinalizer = "staticroute.srlinux.henderiw.be/cleanup"
+
+// StaticRouteReconciler reconciles a StaticRoute object
+type StaticRouteReconciler struct {
+	client.Client
+	Pool *gnmic.Pool
+	// ResyncPeriod is the interval at which the route tables of the devices are
+	// read to find out whether the routes are installed
+	ResyncPeriod time.Duration
+	Log          logr.Logger
+	Scheme       *runtime.Scheme
+	Recorder     record.EventRecorder
+
+	retries retrySet
+}
+
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=staticroutes,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=staticroutes/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=devices,verbs=get;list;watch
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=networkinstances,verbs=get;list;watch
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=nexthopgroups,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile function
+func (r *StaticRouteReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	var route srlinuxv1alpha1.StaticRoute
+	if err := r.Get(ctx, req.NamespacedName, &route); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	d := r.devices(ctx, &route)
+	previous := make([]deviceEntry, 0, len(route.Status.Devices))
+	for i := range route.Status.Devices {
+		previous = append(previous, &route.Status.Devices[i])
+	}
+	if !route.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, d.finalize(ctx, staticRouteFinalizer, &route.Status.Conditions, previous)
+	}
+	if !controllerutil.ContainsFinalizer(&route, staticRouteFinalizer) {
+		controllerutil.AddFinalizer(&route, staticRouteFinalizer)
+		if err := r.Update(ctx, &route); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	devices, err := targetDevices(ctx, r.Client, route.Namespace, &route.Spec.TargetRef)
+	if err != nil {
+		return ctrl.Result{}, d.targetFailed(ctx, &route.Status.Conditions, &route.Status.ObservedGeneration, err)
+	}
+
+	// rejected devices are retried when the spec or the Device, the NetworkInstance or the NextHopGroup changes, not on
+	// every resync
+	d.retry = r.retries.Take(req.NamespacedName)
+	statuses, failed, err := d.run(ctx, devices, previous)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	route.Status.Devices = make([]srlinuxv1alpha1.StaticRouteDeviceStatus, 0, len(statuses))
+	for _, devStatus := range statuses {
+		route.Status.Devices = append(route.Status.Devices, *devStatus.(*srlinuxv1alpha1.StaticRouteDeviceStatus))
+	}
+
+	setDeviceConditions(&route.Status.Conditions, route.Generation, deviceResults(statuses), nil)
+	route.Status.ObservedGeneration = route.Generation
+	if err := r.Status().Update(ctx, &route); err != nil {
+		return ctrl.Result{}, err
+	}
+	return d.result(failed, r.ResyncPeriod)
+}
+
+// devices returns how route is applied to and removed from the devices. The
+// network instance and the prefixes applied to a device are recorded, so the
+// routes are removed after the spec moved or dropped them or the StaticRoute no
+// longer targets the device.
+func (r *StaticRouteReconciler) devices(ctx context.Context, route *srlinuxv1alpha1.StaticRoute) *deviceReconcile {
+	return &deviceReconcile{
+		client:   r.Client,
+		log:      r.Log.WithValues("staticroute", types.NamespacedName{Namespace: route.Namespace, Name: route.Name}),
+		recorder: r.Recorder,
+		object:   route,
+		what:     "static routes",
+		newStatus: func(name string, prev deviceEntry) deviceEntry {
+			devStatus := &srlinuxv1alpha1.StaticRouteDeviceStatus{DeviceResult: srlinuxv1alpha1.DeviceResult{Name: name}}
+			if prev != nil {
+				applied := prev.(*srlinuxv1alpha1.StaticRouteDeviceStatus)
+				devStatus.AppliedNetworkInstance = applied.AppliedNetworkInstance
+				devStatus.AppliedPrefixes = applied.AppliedPrefixes
+			}
+			return devStatus
+		},
+		apply: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+			return r.applyAndRead(ctx, route, dev, devStatus.(*srlinuxv1alpha1.StaticRouteDeviceStatus))
+		},
+		refresh: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+			return r.refresh(ctx, route, dev, devStatus.(*srlinuxv1alpha1.StaticRouteDeviceStatus))
+		},
+		cleanup: func(dev *srlinuxv1alpha1.Device, prev deviceEntry) error {
+			var applied srlinuxv1alpha1.StaticRouteDeviceStatus
+			if prev != nil {
+				applied = *prev.(*srlinuxv1alpha1.StaticRouteDeviceStatus)
+			}
+			return r.cleanup(ctx, route, dev, applied)
+		},
+	}
+}
+
+// applyAndRead sends the static routes to the device and reads back from the
+// route table whether they are installed
+func (r *StaticRouteReconciler) applyAndRead(ctx context.Context, route *srlinuxv1alpha1.StaticRoute, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.StaticRouteDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	if err := r.apply(ctx, route, g, devStatus); err != nil {
+		return err
+	}
+	devStatus.AppliedGeneration = route.Generation
+	devStatus.AppliedNetworkInstance = route.Spec.NetworkInstance
+	devStatus.AppliedPrefixes = append([]string(nil), route.Spec.Prefixes...)
+	if err := getStaticRouteState(ctx, g, route, devStatus); err != nil {
+		// the configuration was applied, only the state is missing
+		r.Log.Error(err, "cannot get route table", "device", dev.Name)
+		devStatus.Message = fmt.Sprintf("cannot get route table: %v", err)
+	}
+	return nil
+}
+
+// refresh reads from the route table of the device whether the routes are
+// installed
+func (r *StaticRouteReconciler) refresh(ctx context.Context, route *srlinuxv1alpha1.StaticRoute, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.StaticRouteDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	return getStaticRouteState(ctx, g, route, devStatus)
+}
+
+// apply sends the static routes to the device. The prefixes that were applied
+// before but are no longer part of the spec are deleted, as well as the metric and
+// preference when they are not set. The routes are removed from the network
+// instance they were applied to before when the spec moved them to another one.
+func (r *StaticRouteReconciler) apply(ctx context.Context, route *srlinuxv1alpha1.StaticRoute, g *gnmic.GnmiClient, devStatus *srlinuxv1alpha1.StaticRouteDeviceStatus) error {
+	ni := route.Spec.NetworkInstance
+	root, err := translate.StaticRoutesFromSpec(&route.Spec).Device(ni)
+	if err != nil {
+		return gnmic.NewInvalidRequestError("SetRequest", g.Target, err)
+	}
+
+	var deletes []*gnmi.Path
+	removed := devStatus.AppliedPrefixes
+	if devStatus.AppliedNetworkInstance == "" || devStatus.AppliedNetworkInstance == ni {
+		removed = removedStrings(devStatus.AppliedPrefixes, route.Spec.Prefixes)
+	}
+	for _, prefix := range removed {
+		deletes = append(deletes, srlmodels.StaticRoutePath(devStatus.AppliedNetworkInstance, prefix))
+	}
+	for _, prefix := range route.Spec.Prefixes {
+		if route.Spec.Metric == nil {
+			deletes = append(deletes, srlmodels.StaticRoutePath(ni, prefix, "metric"))
+		}
+		if route.Spec.Preference == nil {
+			deletes = append(deletes, srlmodels.StaticRoutePath(ni, prefix, "preference"))
+		}
+	}
+
+	setReq, err := g.CreateSetRequestFromStruct(root, deletes...)
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// getStaticRouteState reads the route table entries of the prefixes of the
+// StaticRoute from the device and copies whether they are installed into devStatus
+func getStaticRouteState(ctx context.Context, g *gnmic.GnmiClient, route *srlinuxv1alpha1.StaticRoute, devStatus *srlinuxv1alpha1.StaticRouteDeviceStatus) error {
+	ni := route.Spec.NetworkInstance
+	routes := make([]srlinuxv1alpha1.StaticRouteState, 0, len(route.Spec.Prefixes))
+	for _, prefix := range route.Spec.Prefixes {
+		var entries []translate.RouteTableRoute
+		path := srlmodels.RouteTablePath(ni, addressFamily(prefix), prefix)
+		if err := g.GetInto(ctx, path, gnmi.GetRequest_STATE, &entries); err != nil {
+			return err
+		}
+		routes = append(routes, translate.StaticRouteState(prefix, entries))
+	}
+	devStatus.Routes = routes
+	return nil
+}
+
+// cleanup deletes the routes recorded in devStatus, and the routes of the spec,
+// from the device
+func (r *StaticRouteReconciler) cleanup(ctx context.Context, route *srlinuxv1alpha1.StaticRoute, dev *srlinuxv1alpha1.Device, devStatus srlinuxv1alpha1.StaticRouteDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+
+	ni := devStatus.AppliedNetworkInstance
+	if ni == "" {
+		ni = route.Spec.NetworkInstance
+	}
+	prefixes := append(removedStrings(devStatus.AppliedPrefixes, route.Spec.Prefixes), route.Spec.Prefixes...)
+	deletes := make([]*gnmi.Path, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		deletes = append(deletes, srlmodels.StaticRoutePath(ni, prefix))
+	}
+
+	setReq, err := g.CreateSetRequestFromStruct(nil, deletes...)
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// SetupWithManager function
+func (r *StaticRouteReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// status updates must not trigger a reconcile, the resync period takes care of refreshing it
+		For(&srlinuxv1alpha1.StaticRoute{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &srlinuxv1alpha1.Device{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.staticRoutesForDevice),
+		}).
+		// a device rejects a route until its network instance and its next-hop
+		// group are created
+		Watches(&source.Kind{Type: &srlinuxv1alpha1.NetworkInstance{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.staticRoutesForNetworkInstance),
+		}).
+		Watches(&source.Kind{Type: &srlinuxv1alpha1.NextHopGroup{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.staticRoutesForNextHopGroup),
+		}).
+		Complete(r)
+}
+
+// staticRoutesForDevice maps a Device to the StaticRoutes targeting it
+func (r *StaticRouteReconciler) staticRoutesForDevice(o handler.MapObject) []reconcile.Request {
+	return r.staticRoutesMatching(o, "device", func(route *srlinuxv1alpha1.StaticRoute) bool {
+		return route.Spec.TargetRef.Selects(o.Meta)
+	})
+}
+
+// staticRoutesForNetworkInstance maps a NetworkInstance to the StaticRoutes in it
+func (r *StaticRouteReconciler) staticRoutesForNetworkInstance(o handler.MapObject) []reconcile.Request {
+	return r.staticRoutesMatching(o, "networkinstance", func(route *srlinuxv1alpha1.StaticRoute) bool {
+		return route.Spec.NetworkInstance == o.Meta.GetName()
+	})
+}
+
+// staticRoutesForNextHopGroup maps a NextHopGroup to the StaticRoutes using it
+func (r *StaticRouteReconciler) staticRoutesForNextHopGroup(o handler.MapObject) []reconcile.Request {
+	return r.staticRoutesMatching(o, "nexthopgroup", func(route *srlinuxv1alpha1.StaticRoute) bool {
+		return route.Spec.NextHopGroup == o.Meta.GetName()
+	})
+}
+
+// staticRoutesMatching returns the requests of the StaticRoutes in the namespace
+// of o that match, kind names o in the log
+func (r *StaticRouteReconciler) staticRoutesMatching(o handler.MapObject, kind string, match func(*srlinuxv1alpha1.StaticRoute) bool) []reconcile.Request {
+	var list srlinuxv1alpha1.StaticRouteList
+	if err := r.List(context.Background(), &list, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "cannot list static routes", kind, o.Meta.GetName())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for i := range list.Items {
+		route := &list.Items[i]
+		if match(route) {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: route.Namespace, Name: route.Name},
+			})
+		}
+	}
+	// a device may accept the routes it rejected once the Device, the
+	// NetworkInstance or the NextHopGroup changed
+	return r.retries.Mark(reqs)
+}
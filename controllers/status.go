@@ -0,0 +1,401 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+)
+
+const (
+	resultApplied = "Applied"
+	// resultFailed is a failure that is retried with backoff, e.g. an unreachable device
+	resultFailed = "Failed"
+	// resultRejected is a failure that is only retried when the spec changes or
+	// on resync, e.g. a configuration the device refused. Controllers that
+	// subscribe to state retry it when the spec or its dependencies change.
+	resultRejected = "Rejected"
+)
+
+// deviceResult returns the device result for err
+func deviceResult(err error) string {
+	if gnmic.IsTerminal(err) {
+		return resultRejected
+	}
+	return resultFailed
+}
+
+// setDeviceConditions summarizes the results of applying a resource of generation
+// to its devices, and the devices in drifted, in the Applied, Synced, Degraded and
+// Ready conditions
+func setDeviceConditions(conditions *[]srlinuxv1alpha1.Condition, generation int64, results []srlinuxv1alpha1.DeviceResult, drifted []string) {
+	var failed, unsynced []string
+	failedReason := "Rejected"
+	for _, r := range results {
+		switch {
+		case r.Result == resultFailed || r.Result == resultRejected:
+			if r.Result == resultFailed {
+				failedReason = "ApplyFailed"
+			}
+			failed = append(failed, fmt.Sprintf("%s: %s", r.Name, r.Message))
+		case r.Message != "":
+			// applied, but the state could not be read back
+			unsynced = append(unsynced, fmt.Sprintf("%s: %s", r.Name, r.Message))
+		}
+	}
+
+	if len(failed) > 0 {
+		setCondition(conditions, generation, srlinuxv1alpha1.ConditionTypeApplied, false, failedReason, strings.Join(failed, "; "))
+	} else {
+		setCondition(conditions, generation, srlinuxv1alpha1.ConditionTypeApplied, true, "Applied",
+			fmt.Sprintf("applied to %d devices", len(results)))
+	}
+
+	synced, syncedReason, syncedMessage := true, "InSync", ""
+	switch {
+	case len(failed) > 0:
+		synced, syncedReason, syncedMessage = false, failedReason, strings.Join(failed, "; ")
+	case len(unsynced) > 0:
+		synced, syncedReason, syncedMessage = false, "StateUnavailable", strings.Join(unsynced, "; ")
+	case len(drifted) > 0:
+		synced, syncedReason, syncedMessage = false, "DriftDetected", strings.Join(drifted, "; ")
+	}
+	setCondition(conditions, generation, srlinuxv1alpha1.ConditionTypeSynced, synced, syncedReason, syncedMessage)
+
+	if synced {
+		setCondition(conditions, generation, srlinuxv1alpha1.ConditionTypeDegraded, false, "AsExpected", "")
+	} else {
+		setCondition(conditions, generation, srlinuxv1alpha1.ConditionTypeDegraded, true, syncedReason, syncedMessage)
+	}
+
+	switch {
+	case len(results) == 0:
+		setCondition(conditions, generation, srlinuxv1alpha1.ConditionTypeReady, false, "NoDevices", "no devices are targeted")
+	case !synced:
+		setCondition(conditions, generation, srlinuxv1alpha1.ConditionTypeReady, false, syncedReason, syncedMessage)
+	default:
+		setCondition(conditions, generation, srlinuxv1alpha1.ConditionTypeReady, true, "Ready", "")
+	}
+}
+
+// setCondition sets the condition of type t in conditions for generation
+func setCondition(conditions *[]srlinuxv1alpha1.Condition, generation int64, t srlinuxv1alpha1.ConditionType, status bool, reason, message string) {
+	c := srlinuxv1alpha1.Condition{
+		Type:               t,
+		Status:             corev1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	}
+	if status {
+		c.Status = corev1.ConditionTrue
+	}
+	srlinuxv1alpha1.SetCondition(conditions, c)
+}
+
+// cleanupDevices calls cleanup for each of the devices in names that still exists
+// in namespace. Devices that are gone have nothing left to clean up. It returns the
+// devices that could not be cleaned up, and how many of them failed with an error
+// that is worth retrying.
+func cleanupDevices(ctx context.Context, c client.Client, namespace string, names []string, cleanup func(dev *srlinuxv1alpha1.Device) error) ([]string, int, error) {
+	var failed []string
+	var retryable int
+	for _, name := range names {
+		var dev srlinuxv1alpha1.Device
+		if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, &dev); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, 0, err
+		}
+		if err := cleanup(&dev); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", name, err))
+			if gnmic.IsRetryable(err) {
+				retryable++
+			}
+		}
+	}
+	return failed, retryable, nil
+}
+
+// setDeletedCondition reports the devices in failed in the Deleted condition
+func setDeletedCondition(conditions *[]srlinuxv1alpha1.Condition, generation int64, failed []string, retryable int) {
+	reason := "CleanupFailed"
+	if retryable == 0 {
+		reason = "CleanupRejected"
+	}
+	setCondition(conditions, generation, srlinuxv1alpha1.ConditionTypeDeleted, false, reason, strings.Join(failed, "; "))
+}
+
+// deviceEntry is the status of a resource on a single device, the device statuses
+// of the resources embed a DeviceResult
+type deviceEntry interface {
+	GetDeviceResult() *srlinuxv1alpha1.DeviceResult
+}
+
+// deviceObject is a resource that is applied to devices
+type deviceObject interface {
+	runtime.Object
+	metav1.Object
+}
+
+// deviceReconcile applies a resource to the devices it targets and removes it from
+// the devices it no longer targets. The kinds of resources provide how a single
+// device is applied, refreshed and cleaned up, and what is recorded about it.
+type deviceReconcile struct {
+	client   client.Client
+	log      logr.Logger
+	recorder record.EventRecorder
+	object   deviceObject
+	// what names the resource in events and errors, e.g. "interface ethernet-1/1"
+	what string
+	// retry applies the generation of the object again to the devices that rejected
+	// it, e.g. because a Device the spec depends on changed
+	retry bool
+
+	// newStatus returns the status of the device named name, carrying over what
+	// was applied to the device according to prev. prev is nil when the device was
+	// not targeted before.
+	newStatus func(name string, prev deviceEntry) deviceEntry
+	// apply applies the spec to dev and records what was applied in devStatus
+	apply func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error
+	// refresh reads the state of dev, to which the spec is applied already, into
+	// devStatus
+	refresh func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error
+	// cleanup removes the resource from dev as it was applied according to prev
+	cleanup func(dev *srlinuxv1alpha1.Device, prev deviceEntry) error
+}
+
+// targetFailed reports that the devices of the resource cannot be selected
+func (d *deviceReconcile) targetFailed(ctx context.Context, conditions *[]srlinuxv1alpha1.Condition, observedGeneration *int64, err error) error {
+	d.recorder.Eventf(d.object, corev1.EventTypeWarning, "TargetFailed", "cannot select devices: %v", err)
+	setCondition(conditions, d.object.GetGeneration(), srlinuxv1alpha1.ConditionTypeReady, false, "TargetFailed", err.Error())
+	*observedGeneration = d.object.GetGeneration()
+	if err := d.client.Status().Update(ctx, d.object); err != nil {
+		return err
+	}
+	return err
+}
+
+// run applies the resource to devices and removes it from the devices in previous
+// that are no longer targeted. previous holds the device statuses of the last
+// reconcile. A device the spec is applied to already is only refreshed, a device
+// that rejected the spec is left alone until the spec changes or retry is set. It
+// returns the new device statuses and how many devices failed with an error that
+// is worth retrying.
+func (d *deviceReconcile) run(ctx context.Context, devices []srlinuxv1alpha1.Device, previous []deviceEntry) ([]deviceEntry, int, error) {
+	generation := d.object.GetGeneration()
+	untargeted := make(map[string]deviceEntry, len(previous))
+	for _, prev := range previous {
+		untargeted[prev.GetDeviceResult().Name] = prev
+	}
+
+	var failed int
+	statuses := make([]deviceEntry, 0, len(devices))
+	for i := range devices {
+		dev := &devices[i]
+		prev, ok := untargeted[dev.Name]
+		delete(untargeted, dev.Name)
+
+		var devStatus deviceEntry
+		var err error
+		switch {
+		case ok && prev.GetDeviceResult().Result == resultRejected && prev.GetDeviceResult().AppliedGeneration == generation && !d.retry:
+			// the device refused this generation already
+			statuses = append(statuses, prev)
+			continue
+		case ok && prev.GetDeviceResult().Result == resultApplied && prev.GetDeviceResult().AppliedGeneration == generation:
+			// the spec is applied already, only refresh the state
+			devStatus = prev
+			devStatus.GetDeviceResult().Message = ""
+			err = d.refresh(dev, devStatus)
+		default:
+			devStatus = d.newStatus(dev.Name, prev)
+			devStatus.GetDeviceResult().Result = resultApplied
+			err = d.apply(dev, devStatus)
+			if err == nil {
+				d.recorder.Eventf(d.object, corev1.EventTypeNormal, "Applied", "applied %s to device %s", d.what, dev.Name)
+			}
+		}
+		result := devStatus.GetDeviceResult()
+		if err != nil {
+			d.log.Error(err, "cannot apply "+d.what, "device", dev.Name)
+			d.recorder.Eventf(d.object, corev1.EventTypeWarning, "ApplyFailed", "cannot apply %s to device %s: %v", d.what, dev.Name, err)
+			result.Result = deviceResult(err)
+			result.Message = err.Error()
+			if result.Result == resultFailed {
+				failed++
+			} else {
+				// the generation the device rejected
+				result.AppliedGeneration = generation
+			}
+		} else if result.Message != "" {
+			d.recorder.Eventf(d.object, corev1.EventTypeWarning, "StateFailed", "device %s: %s", dev.Name, result.Message)
+		}
+		statuses = append(statuses, devStatus)
+	}
+
+	// the resource is removed from the devices that are no longer targeted
+	for _, prev := range previous {
+		name := prev.GetDeviceResult().Name
+		if _, ok := untargeted[name]; !ok {
+			continue
+		}
+		var dev srlinuxv1alpha1.Device
+		if err := d.client.Get(ctx, types.NamespacedName{Namespace: d.object.GetNamespace(), Name: name}, &dev); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, 0, err
+		}
+		if err := d.cleanup(&dev, prev); err != nil {
+			d.log.Error(err, "cannot remove "+d.what, "device", name)
+			d.recorder.Eventf(d.object, corev1.EventTypeWarning, "CleanupFailed", "cannot remove %s from device %s: %v", d.what, name, err)
+			devStatus := d.newStatus(name, prev)
+			devStatus.GetDeviceResult().Result = deviceResult(err)
+			devStatus.GetDeviceResult().Message = err.Error()
+			if deviceResult(err) == resultFailed {
+				failed++
+			}
+			statuses = append(statuses, devStatus)
+			continue
+		}
+		d.recorder.Eventf(d.object, corev1.EventTypeNormal, "Removed", "removed %s from device %s", d.what, name)
+	}
+	return statuses, failed, nil
+}
+
+// result returns the result of a reconcile in which failed devices failed with an
+// error worth retrying. The state of the devices is refreshed every resyncPeriod,
+// it changes without the resource changing.
+func (d *deviceReconcile) result(failed int, resyncPeriod time.Duration) (ctrl.Result, error) {
+	if failed > 0 {
+		// transient failures are requeued with the exponential backoff of the
+		// controller, rejected configurations wait for a change or the resync
+		return ctrl.Result{}, fmt.Errorf("failed applying %s to %d devices", d.what, failed)
+	}
+	if resyncPeriod > 0 {
+		return ctrl.Result{RequeueAfter: resyncPeriod}, nil
+	}
+	return ctrl.Result{}, nil
+}
+
+// finalize removes the resource from the devices in previous and then releases it
+// by removing finalizer. Devices that cannot be cleaned up are reported in the
+// Deleted condition in conditions and retried.
+func (d *deviceReconcile) finalize(ctx context.Context, finalizer string, conditions *[]srlinuxv1alpha1.Condition, previous []deviceEntry) error {
+	if !controllerutil.ContainsFinalizer(d.object, finalizer) {
+		return nil
+	}
+
+	names := make([]string, 0, len(previous))
+	applied := make(map[string]deviceEntry, len(previous))
+	for _, prev := range previous {
+		names = append(names, prev.GetDeviceResult().Name)
+		applied[prev.GetDeviceResult().Name] = prev
+	}
+	failed, retryable, err := cleanupDevices(ctx, d.client, d.object.GetNamespace(), names, func(dev *srlinuxv1alpha1.Device) error {
+		if err := d.cleanup(dev, applied[dev.Name]); err != nil {
+			d.log.Error(err, "cannot remove "+d.what, "device", dev.Name)
+			d.recorder.Eventf(d.object, corev1.EventTypeWarning, "CleanupFailed", "cannot remove %s from device %s: %v", d.what, dev.Name, err)
+			return err
+		}
+		d.recorder.Eventf(d.object, corev1.EventTypeNormal, "Removed", "removed %s from device %s", d.what, dev.Name)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(failed) > 0 {
+		setDeletedCondition(conditions, d.object.GetGeneration(), failed, retryable)
+		if err := d.client.Status().Update(ctx, d.object); err != nil {
+			return err
+		}
+		if retryable == 0 {
+			// the devices will refuse the cleanup again, the finalizer has to be
+			// removed by hand once the devices are fixed
+			return nil
+		}
+		return fmt.Errorf("failed removing %s from %d devices", d.what, retryable)
+	}
+
+	d.log.Info("removed " + d.what + " from all devices")
+	controllerutil.RemoveFinalizer(d.object, finalizer)
+	return d.client.Update(ctx, d.object)
+}
+
+// deviceResults returns the results of statuses
+func deviceResults(statuses []deviceEntry) []srlinuxv1alpha1.DeviceResult {
+	results := make([]srlinuxv1alpha1.DeviceResult, 0, len(statuses))
+	for _, devStatus := range statuses {
+		results = append(results, *devStatus.GetDeviceResult())
+	}
+	return results
+}
+
+// retrySet holds the objects whose rejected devices are retried on their next
+// reconcile, they are marked when an object their spec depends on changes. The
+// zero value is ready to use.
+type retrySet struct {
+	mu      sync.Mutex
+	objects map[types.NamespacedName]bool
+}
+
+// Mark marks the objects of reqs to retry their rejected devices and returns reqs,
+// it wraps the mapping of the objects the spec depends on
+func (s *retrySet) Mark(reqs []reconcile.Request) []reconcile.Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.objects == nil {
+		s.objects = make(map[types.NamespacedName]bool)
+	}
+	for _, req := range reqs {
+		s.objects[req.NamespacedName] = true
+	}
+	return reqs
+}
+
+// Take reports whether the rejected devices of obj are to be retried and clears
+// the mark
+func (s *retrySet) Take(obj types.NamespacedName) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	retry := s.objects[obj]
+	delete(s.objects, obj)
+	return retry
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+)
+
+func TestDeviceReconcileRejected(t *testing.T) {
+	rejected := gnmic.NewInvalidRequestError("SetRequest", "d1", errors.New("bad value"))
+	tests := []struct {
+		name      string
+		prev      *srlinuxv1alpha1.DeviceResult
+		retry     bool
+		applyErr  error
+		wantApply bool
+		want      srlinuxv1alpha1.DeviceResult
+		wantFail  int
+	}{
+		{
+			name:      "new device rejects",
+			applyErr:  rejected,
+			wantApply: true,
+			want:      srlinuxv1alpha1.DeviceResult{Name: "d1", Result: resultRejected, Message: rejected.Error(), AppliedGeneration: 2},
+		},
+		{
+			name: "rejected generation is skipped",
+			prev: &srlinuxv1alpha1.DeviceResult{Name: "d1", Result: resultRejected, Message: "bad value", AppliedGeneration: 2},
+			want: srlinuxv1alpha1.DeviceResult{Name: "d1", Result: resultRejected, Message: "bad value", AppliedGeneration: 2},
+		},
+		{
+			name:      "rejected generation is retried",
+			prev:      &srlinuxv1alpha1.DeviceResult{Name: "d1", Result: resultRejected, Message: "bad value", AppliedGeneration: 2},
+			retry:     true,
+			wantApply: true,
+			want:      srlinuxv1alpha1.DeviceResult{Name: "d1", Result: resultApplied, AppliedGeneration: 2},
+		},
+		{
+			name:      "older rejected generation is applied",
+			prev:      &srlinuxv1alpha1.DeviceResult{Name: "d1", Result: resultRejected, Message: "bad value", AppliedGeneration: 1},
+			wantApply: true,
+			want:      srlinuxv1alpha1.DeviceResult{Name: "d1", Result: resultApplied, AppliedGeneration: 2},
+		},
+		{
+			name:      "transient failure",
+			applyErr:  errors.New("dial timeout"),
+			wantApply: true,
+			want:      srlinuxv1alpha1.DeviceResult{Name: "d1", Result: resultFailed, Message: "dial timeout"},
+			wantFail:  1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var applied bool
+			d := &deviceReconcile{
+				log:      ctrl.Log,
+				recorder: record.NewFakeRecorder(10),
+				object:   &srlinuxv1alpha1.Interface{ObjectMeta: metav1.ObjectMeta{Name: "i1", Generation: 2}},
+				what:     "interface ethernet-1/1",
+				retry:    tt.retry,
+				newStatus: func(name string, prev deviceEntry) deviceEntry {
+					return &srlinuxv1alpha1.DeviceResult{Name: name}
+				},
+				apply: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+					applied = true
+					if tt.applyErr == nil {
+						devStatus.GetDeviceResult().AppliedGeneration = 2
+					}
+					return tt.applyErr
+				},
+				refresh: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+					t.Error("refresh() called")
+					return nil
+				},
+			}
+			var previous []deviceEntry
+			if tt.prev != nil {
+				previous = append(previous, tt.prev)
+			}
+			devices := []srlinuxv1alpha1.Device{{ObjectMeta: metav1.ObjectMeta{Name: "d1"}}}
+
+			statuses, failed, err := d.run(context.Background(), devices, previous)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if applied != tt.wantApply {
+				t.Errorf("apply() called = %v, want %v", applied, tt.wantApply)
+			}
+			if failed != tt.wantFail {
+				t.Errorf("run() failed = %d, want %d", failed, tt.wantFail)
+			}
+			if len(statuses) != 1 || *statuses[0].GetDeviceResult() != tt.want {
+				t.Errorf("run() statuses = %+v, want %+v", deviceResults(statuses), tt.want)
+			}
+		})
+	}
+}
+
+func TestRetrySet(t *testing.T) {
+	var s retrySet
+	obj := types.NamespacedName{Namespace: "default", Name: "i1"}
+	if s.Take(obj) {
+		t.Error("Take() = true before Mark()")
+	}
+	s.Mark([]reconcile.Request{{NamespacedName: obj}})
+	if !s.Take(obj) {
+		t.Error("Take() = false after Mark()")
+	}
+	if s.Take(obj) {
+		t.Error("Take() = true after Take()")
+	}
+}
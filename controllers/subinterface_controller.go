@@ -0,0 +1,316 @@
+/*
+Copyright 2020 Wim Henderickx.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/translate"
+)
+
+// subinterfaceFinalizer holds the Subinterface until it is removed from the devices
+const subinterfaceFinalizer = "subinterface.srlinux.henderiw.be/cleanup"
+
+// SubinterfaceReconciler reconciles a Subinterface object
+type SubinterfaceReconciler struct {
+	client.Client
+	Pool *gnmic.Pool
+	// ResyncPeriod is the interval at which the oper-state and the counters of the
+	// subinterfaces are read from the devices
+	ResyncPeriod time.Duration
+	Log          logr.Logger
+	Scheme       *runtime.Scheme
+	Recorder     record.EventRecorder
+
+	retries retrySet
+}
+
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=subinterfaces,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=subinterfaces/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=srlinux.henderiw.be,resources=devices,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reconcile function
+func (r *SubinterfaceReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := context.Background()
+
+	var sub srlinuxv1alpha1.Subinterface
+	if err := r.Get(ctx, req.NamespacedName, &sub); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	d := r.devices(ctx, &sub)
+	previous := make([]deviceEntry, 0, len(sub.Status.Devices))
+	for i := range sub.Status.Devices {
+		previous = append(previous, &sub.Status.Devices[i])
+	}
+	if !sub.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, d.finalize(ctx, subinterfaceFinalizer, &sub.Status.Conditions, previous)
+	}
+	if !controllerutil.ContainsFinalizer(&sub, subinterfaceFinalizer) {
+		controllerutil.AddFinalizer(&sub, subinterfaceFinalizer)
+		if err := r.Update(ctx, &sub); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	devices, err := targetDevices(ctx, r.Client, sub.Namespace, &sub.Spec.TargetRef)
+	if err != nil {
+		return ctrl.Result{}, d.targetFailed(ctx, &sub.Status.Conditions, &sub.Status.ObservedGeneration, err)
+	}
+
+	// rejected devices are retried when the spec or the Device changes, not on
+	// every resync
+	d.retry = r.retries.Take(req.NamespacedName)
+	statuses, failed, err := d.run(ctx, devices, previous)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	sub.Status.Devices = make([]srlinuxv1alpha1.SubinterfaceDeviceStatus, 0, len(statuses))
+	for _, devStatus := range statuses {
+		sub.Status.Devices = append(sub.Status.Devices, *devStatus.(*srlinuxv1alpha1.SubinterfaceDeviceStatus))
+	}
+
+	setDeviceConditions(&sub.Status.Conditions, sub.Generation, deviceResults(statuses), nil)
+	sub.Status.ObservedGeneration = sub.Generation
+	if err := r.Status().Update(ctx, &sub); err != nil {
+		return ctrl.Result{}, err
+	}
+	return d.result(failed, r.ResyncPeriod)
+}
+
+// devices returns how sub is applied to and removed from the devices. The
+// subinterface and the addresses applied to a device are recorded, so they are
+// removed after the spec moved the subinterface or the Subinterface no longer
+// targets the device.
+func (r *SubinterfaceReconciler) devices(ctx context.Context, sub *srlinuxv1alpha1.Subinterface) *deviceReconcile {
+	return &deviceReconcile{
+		client:   r.Client,
+		log:      r.Log.WithValues("subinterface", types.NamespacedName{Namespace: sub.Namespace, Name: sub.Name}),
+		recorder: r.Recorder,
+		object:   sub,
+		what:     "subinterface " + subinterfaceName(sub),
+		newStatus: func(name string, prev deviceEntry) deviceEntry {
+			devStatus := &srlinuxv1alpha1.SubinterfaceDeviceStatus{DeviceResult: srlinuxv1alpha1.DeviceResult{Name: name}}
+			if prev != nil {
+				applied := prev.(*srlinuxv1alpha1.SubinterfaceDeviceStatus)
+				devStatus.AppliedInterface = applied.AppliedInterface
+				devStatus.AppliedIndex = applied.AppliedIndex
+				devStatus.AppliedAddresses = applied.AppliedAddresses
+			}
+			return devStatus
+		},
+		apply: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+			return r.applyAndRead(ctx, sub, dev, devStatus.(*srlinuxv1alpha1.SubinterfaceDeviceStatus))
+		},
+		refresh: func(dev *srlinuxv1alpha1.Device, devStatus deviceEntry) error {
+			return r.refresh(ctx, sub, dev, devStatus.(*srlinuxv1alpha1.SubinterfaceDeviceStatus))
+		},
+		cleanup: func(dev *srlinuxv1alpha1.Device, prev deviceEntry) error {
+			devStatus, _ := prev.(*srlinuxv1alpha1.SubinterfaceDeviceStatus)
+			return r.cleanup(ctx, sub, dev, devStatus)
+		},
+	}
+}
+
+// applyAndRead sends the subinterface configuration to the device and reads back
+// the resulting subinterface state into devStatus
+func (r *SubinterfaceReconciler) applyAndRead(ctx context.Context, sub *srlinuxv1alpha1.Subinterface, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.SubinterfaceDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	if err := r.apply(ctx, sub, g, devStatus); err != nil {
+		return err
+	}
+	devStatus.AppliedGeneration = sub.Generation
+	devStatus.AppliedInterface = sub.Spec.Interface
+	devStatus.AppliedIndex = sub.Spec.Index
+	devStatus.AppliedAddresses = subinterfaceAddresses(&sub.Spec)
+	if err := getSubinterfaceState(ctx, g, sub, devStatus); err != nil {
+		// the configuration was applied, only the state is missing
+		r.Log.Error(err, "cannot get subinterface state", "device", dev.Name)
+		devStatus.Message = fmt.Sprintf("cannot get subinterface state: %v", err)
+	}
+	return nil
+}
+
+// refresh reads the subinterface state of the device into devStatus
+func (r *SubinterfaceReconciler) refresh(ctx context.Context, sub *srlinuxv1alpha1.Subinterface, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.SubinterfaceDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	return getSubinterfaceState(ctx, g, sub, devStatus)
+}
+
+// apply sends the subinterface configuration to the device and deletes the
+// addresses that were applied before but are no longer part of the spec. The
+// optional leaves that are not set in the spec are deleted as well. The
+// subinterface applied before is removed when the spec moved it to another
+// interface or index.
+func (r *SubinterfaceReconciler) apply(ctx context.Context, sub *srlinuxv1alpha1.Subinterface, g *gnmic.GnmiClient, devStatus *srlinuxv1alpha1.SubinterfaceDeviceStatus) error {
+	root, err := translate.SubinterfaceFromSpec(&sub.Spec).Device(sub.Spec.Interface)
+	if err != nil {
+		return gnmic.NewInvalidRequestError("SetRequest", g.Target, err)
+	}
+
+	var deletes []*gnmi.Path
+	if sub.Spec.Description == "" {
+		deletes = append(deletes, srlmodels.SubinterfacePath(sub.Spec.Interface, sub.Spec.Index, "description"))
+	}
+	if sub.Spec.VlanID == nil {
+		deletes = append(deletes, srlmodels.SubinterfacePath(sub.Spec.Interface, sub.Spec.Index, "vlan"))
+	}
+	if subinterfaceMoved(sub, devStatus) {
+		deletes = append(deletes, srlmodels.SubinterfacePath(devStatus.AppliedInterface, devStatus.AppliedIndex))
+	} else {
+		for _, prefix := range removedStrings(devStatus.AppliedAddresses, subinterfaceAddresses(&sub.Spec)) {
+			deletes = append(deletes, srlmodels.SubinterfaceAddressPath(sub.Spec.Interface, sub.Spec.Index, addressFamily(prefix), prefix))
+		}
+	}
+
+	setReq, err := g.CreateSetRequestFromStruct(root, deletes...)
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// getSubinterfaceState reads the state of the subinterface from the device and
+// copies it into devStatus
+func getSubinterfaceState(ctx context.Context, g *gnmic.GnmiClient, sub *srlinuxv1alpha1.Subinterface, devStatus *srlinuxv1alpha1.SubinterfaceDeviceStatus) error {
+	s := &translate.Subinterface{}
+	if err := g.GetInto(ctx, srlmodels.SubinterfacePath(sub.Spec.Interface, sub.Spec.Index), gnmi.GetRequest_ALL, s); err != nil {
+		return err
+	}
+	s.Status(devStatus)
+	return nil
+}
+
+// cleanup deletes the subinterface last applied to the device as recorded in
+// devStatus, or the subinterface of the spec when nothing was applied yet
+func (r *SubinterfaceReconciler) cleanup(ctx context.Context, sub *srlinuxv1alpha1.Subinterface, dev *srlinuxv1alpha1.Device, devStatus *srlinuxv1alpha1.SubinterfaceDeviceStatus) error {
+	g, err := gnmiClientForDevice(ctx, r.Client, r.Pool, dev)
+	if err != nil {
+		return err
+	}
+	path := srlmodels.SubinterfacePath(sub.Spec.Interface, sub.Spec.Index)
+	if devStatus != nil && devStatus.AppliedInterface != "" {
+		path = srlmodels.SubinterfacePath(devStatus.AppliedInterface, devStatus.AppliedIndex)
+	}
+	setReq, err := g.CreateSetRequestFromStruct(nil, path)
+	if err != nil {
+		return err
+	}
+	_, err = g.Set(ctx, setReq)
+	return err
+}
+
+// subinterfaceName returns the name of the subinterface on the devices, e.g.
+// ethernet-1/1.10
+func subinterfaceName(sub *srlinuxv1alpha1.Subinterface) string {
+	return fmt.Sprintf("%s.%d", sub.Spec.Interface, sub.Spec.Index)
+}
+
+// subinterfaceMoved returns true if the subinterface recorded in devStatus was
+// applied to another interface or index than the one of the spec
+func subinterfaceMoved(sub *srlinuxv1alpha1.Subinterface, devStatus *srlinuxv1alpha1.SubinterfaceDeviceStatus) bool {
+	return devStatus.AppliedInterface != "" &&
+		(devStatus.AppliedInterface != sub.Spec.Interface || devStatus.AppliedIndex != sub.Spec.Index)
+}
+
+// subinterfaceAddresses returns the ipv4 and ipv6 addresses of spec
+func subinterfaceAddresses(spec *srlinuxv1alpha1.SubinterfaceSpec) []string {
+	addresses := make([]string, 0, len(spec.IPv4)+len(spec.IPv6))
+	addresses = append(addresses, spec.IPv4...)
+	return append(addresses, spec.IPv6...)
+}
+
+// addressFamily returns ipv4 or ipv6, the address family of prefix
+func addressFamily(prefix string) string {
+	if strings.Contains(prefix, ":") {
+		return "ipv6"
+	}
+	return "ipv4"
+}
+
+// removedStrings returns the strings in applied that are not in desired
+func removedStrings(applied, desired []string) []string {
+	keep := make(map[string]bool, len(desired))
+	for _, s := range desired {
+		keep[s] = true
+	}
+	var removed []string
+	for _, s := range applied {
+		if !keep[s] {
+			removed = append(removed, s)
+		}
+	}
+	return removed
+}
+
+// SetupWithManager function
+func (r *SubinterfaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		// status updates must not trigger a reconcile, the resync period takes care of refreshing it
+		For(&srlinuxv1alpha1.Subinterface{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&source.Kind{Type: &srlinuxv1alpha1.Device{}}, &handler.EnqueueRequestsFromMapFunc{
+			ToRequests: handler.ToRequestsFunc(r.subinterfacesForDevice),
+		}).
+		Complete(r)
+}
+
+// subinterfacesForDevice maps a Device to the Subinterfaces targeting it
+func (r *SubinterfaceReconciler) subinterfacesForDevice(o handler.MapObject) []reconcile.Request {
+	var list srlinuxv1alpha1.SubinterfaceList
+	if err := r.List(context.Background(), &list, client.InNamespace(o.Meta.GetNamespace())); err != nil {
+		r.Log.Error(err, "cannot list subinterfaces", "device", o.Meta.GetName())
+		return nil
+	}
+	var reqs []reconcile.Request
+	for _, sub := range list.Items {
+		if sub.Spec.TargetRef.Selects(o.Meta) {
+			reqs = append(reqs, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: sub.Namespace, Name: sub.Name},
+			})
+		}
+	}
+	// a changed Device may accept the configuration it rejected
+	return r.retries.Mark(reqs)
+}
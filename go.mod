@@ -7,14 +7,17 @@ require (
 	github.com/deislabs/oras v0.8.1
 	github.com/docker/docker v1.13.1
 	github.com/go-logr/logr v0.1.0
-	github.com/gobuffalo/envy v1.7.0
+	github.com/golang/protobuf v1.4.2
 	github.com/google/gnxi v0.0.0-20201015131541-8b27e9559e9b
 	github.com/onsi/ginkgo v1.12.1
 	github.com/onsi/gomega v1.10.1
 	github.com/openconfig/gnmi v0.0.0-20200617225440-d2b4e6a45802
+	github.com/openconfig/goyang v0.0.0-20200623182805-6be32aef2bcd
+	github.com/openconfig/ygot v0.8.0
 	github.com/spf13/viper v1.7.0
 	google.golang.org/grpc v1.30.0
 	gopkg.in/yaml.v2 v2.3.0
+	k8s.io/api v0.18.6
 	k8s.io/apimachinery v0.18.6
 	k8s.io/client-go v0.18.6
 	sigs.k8s.io/controller-runtime v0.6.2
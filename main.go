@@ -19,9 +19,11 @@ package main
 import (
 	"flag"
 	"os"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -30,6 +32,7 @@ import (
 	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
 	"github.com/srl-wim/srlinux-k8s-operator/controllers"
 	gnmiclient "github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/translate"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -48,10 +51,18 @@ func init() {
 func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
+	var resyncPeriod time.Duration
+	var yangDir string
 	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.DurationVar(&resyncPeriod, "resync-period", 5*time.Minute,
+		"The interval at which the configuration of the devices is compared with the spec to detect drift "+
+			"and the network instances of the devices are read.")
+	flag.StringVar(&yangDir, "yang-dir", "",
+		"A directory of SR Linux YANG modules the configuration is validated against before it is sent "+
+			"to the devices. Validation is disabled when empty.")
 	flag.Parse()
 
 	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
@@ -68,25 +79,125 @@ func main() {
 		os.Exit(1)
 	}
 
-	g := gnmiclient.NewGnmiClient()
-	if err = g.ParseEnvironment(); err != nil {
-		setupLog.Error(err, "unable to parse the environment or setup the GNMI connection")
-		os.Exit(1)
+	pool := gnmiclient.NewPool()
+	defer pool.Close()
+
+	if yangDir != "" {
+		schema, err := gnmiclient.LoadSchema(yangDir)
+		if err != nil {
+			setupLog.Error(err, "unable to load yang schema", "dir", yangDir)
+			os.Exit(1)
+		}
+		pool.Schema = schema
+		srlinuxv1alpha1.NtpConfigValidator = func(spec *srlinuxv1alpha1.NtpSpec, fldPath *field.Path) field.ErrorList {
+			return translate.ValidateNtp(schema, spec, fldPath)
+		}
 	}
 
-	if err = g.Initialize(); err != nil {
-		setupLog.Error(err, "unable to setup the GNMI connection")
-		os.Exit(1)
-	}
 	if err = (&controllers.NtpReconciler{
-		Client:     mgr.GetClient(),
-		GnmiClient: g,
-		Log:        ctrl.Log.WithName("controllers").WithName("Ntp"),
-		Scheme:     mgr.GetScheme(),
+		Client:       mgr.GetClient(),
+		Pool:         pool,
+		ResyncPeriod: resyncPeriod,
+		Log:          ctrl.Log.WithName("controllers").WithName("Ntp"),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     mgr.GetEventRecorderFor("ntp-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Ntp")
 		os.Exit(1)
 	}
+	if err = (&controllers.DeviceReconciler{
+		Client:       mgr.GetClient(),
+		Pool:         pool,
+		ResyncPeriod: resyncPeriod,
+		Log:          ctrl.Log.WithName("controllers").WithName("Device"),
+		Scheme:       mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Device")
+		os.Exit(1)
+	}
+	if err = (&controllers.InterfaceReconciler{
+		Client:       mgr.GetClient(),
+		Pool:         pool,
+		ResyncPeriod: resyncPeriod,
+		Log:          ctrl.Log.WithName("controllers").WithName("Interface"),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     mgr.GetEventRecorderFor("interface-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Interface")
+		os.Exit(1)
+	}
+	if err = (&controllers.SubinterfaceReconciler{
+		Client:       mgr.GetClient(),
+		Pool:         pool,
+		ResyncPeriod: resyncPeriod,
+		Log:          ctrl.Log.WithName("controllers").WithName("Subinterface"),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     mgr.GetEventRecorderFor("subinterface-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Subinterface")
+		os.Exit(1)
+	}
+	if err = (&controllers.NetworkInstanceReconciler{
+		Client:       mgr.GetClient(),
+		Pool:         pool,
+		ResyncPeriod: resyncPeriod,
+		Log:          ctrl.Log.WithName("controllers").WithName("NetworkInstance"),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     mgr.GetEventRecorderFor("networkinstance-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NetworkInstance")
+		os.Exit(1)
+	}
+	if err = (&controllers.BgpReconciler{
+		Client:       mgr.GetClient(),
+		Pool:         pool,
+		ResyncPeriod: resyncPeriod,
+		Log:          ctrl.Log.WithName("controllers").WithName("Bgp"),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     mgr.GetEventRecorderFor("bgp-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Bgp")
+		os.Exit(1)
+	}
+	if err = (&controllers.NextHopGroupReconciler{
+		Client:       mgr.GetClient(),
+		Pool:         pool,
+		ResyncPeriod: resyncPeriod,
+		Log:          ctrl.Log.WithName("controllers").WithName("NextHopGroup"),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     mgr.GetEventRecorderFor("nexthopgroup-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "NextHopGroup")
+		os.Exit(1)
+	}
+	if err = (&controllers.StaticRouteReconciler{
+		Client:       mgr.GetClient(),
+		Pool:         pool,
+		ResyncPeriod: resyncPeriod,
+		Log:          ctrl.Log.WithName("controllers").WithName("StaticRoute"),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     mgr.GetEventRecorderFor("staticroute-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "StaticRoute")
+		os.Exit(1)
+	}
+	if err = (&controllers.AclReconciler{
+		Client:       mgr.GetClient(),
+		Pool:         pool,
+		ResyncPeriod: resyncPeriod,
+		Log:          ctrl.Log.WithName("controllers").WithName("Acl"),
+		Scheme:       mgr.GetScheme(),
+		Recorder:     mgr.GetEventRecorderFor("acl-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Acl")
+		os.Exit(1)
+	}
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err = (&srlinuxv1alpha1.Ntp{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Ntp")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	setupLog.Info("starting manager")
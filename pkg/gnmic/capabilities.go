@@ -0,0 +1,106 @@
+package gnmic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// releasePath is the path of the software version of an SR Linux target
+const releasePath = "/system/information/version"
+
+// Model is a YANG model supported by the target
+type Model struct {
+	Name         string
+	Organization string
+	Version      string
+}
+
+// Capabilities holds what the target reported during the handshake
+type Capabilities struct {
+	GnmiVersion string
+	Encodings   []string
+	Models      []Model
+	// Release is the SR Linux release of the target, e.g. v20.6.1-286-g118bc26.
+	// It is empty when the target did not report it.
+	Release string
+}
+
+// Capabilities sends a gnmi.CapabilityRequest to the target and returns a
+// gnmi.CapabilityResponse and an error
+func (g *GnmiClient) Capabilities(ctx context.Context) (*gnmi.CapabilityResponse, error) {
+	nctx, cancel := context.WithTimeout(ctx, g.Timeout)
+	defer cancel()
+	nctx = metadata.AppendToOutgoingContext(nctx, "username", g.Username, "password", g.Password)
+	c, err := g.gnmiClient("CapabilityRequest")
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.Capabilities(nctx, &gnmi.CapabilityRequest{})
+	if err != nil {
+		return nil, newError("CapabilityRequest", g.Target, err)
+	}
+	return response, nil
+}
+
+// handshake asks the target for its capabilities, stores them in g.Caps and
+// checks that the target supports the configured encoding
+func (g *GnmiClient) handshake(ctx context.Context) error {
+	rsp, err := g.Capabilities(ctx)
+	if err != nil {
+		return err
+	}
+	caps := &Capabilities{GnmiVersion: rsp.GetGNMIVersion()}
+	for _, e := range rsp.GetSupportedEncodings() {
+		caps.Encodings = append(caps.Encodings, e.String())
+	}
+	for _, m := range rsp.GetSupportedModels() {
+		caps.Models = append(caps.Models, Model{
+			Name:         m.GetName(),
+			Organization: m.GetOrganization(),
+			Version:      m.GetVersion(),
+		})
+	}
+	if !caps.SupportsEncoding(g.Encoding) {
+		return &Error{
+			Op:     "CapabilityRequest",
+			Target: g.Target,
+			Code:   codes.FailedPrecondition,
+			Err:    fmt.Errorf("encoding '%s' is not supported, supported encodings: %v", g.Encoding, caps.Encodings),
+		}
+	}
+	// the release is informational, targets that do not expose it still connect
+	caps.Release, _ = g.release(ctx)
+	g.Caps = caps
+	return nil
+}
+
+// release reads the SR Linux release of the target
+func (g *GnmiClient) release(ctx context.Context) (string, error) {
+	gnmiPath, err := ParsePath(releasePath)
+	if err != nil {
+		return "", err
+	}
+	var version string
+	if err := g.GetInto(ctx, gnmiPath, gnmi.GetRequest_STATE, &version); err != nil {
+		return "", err
+	}
+	if version == "" {
+		return "", fmt.Errorf("target '%s' did not report '%s'", g.Target, releasePath)
+	}
+	return version, nil
+}
+
+// SupportsEncoding returns true if the target supports encoding
+func (c *Capabilities) SupportsEncoding(encoding string) bool {
+	for _, e := range c.Encodings {
+		if strings.EqualFold(e, encoding) {
+			return true
+		}
+	}
+	return false
+}
@@ -12,12 +12,11 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strconv"
-	"strings"
+	"sync"
 	"time"
 
-	"github.com/gobuffalo/envy"
-	"github.com/google/gnxi/utils/xpath"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 
 	"github.com/openconfig/gnmi/proto/gnmi"
@@ -30,9 +29,9 @@ type GnmiClient struct {
 	Password   string
 	Proxy      bool
 	NoTLS      bool
-	TLSCA      string
-	TLSCert    string
-	TLSKey     string
+	TLSCA      []byte
+	TLSCert    []byte
+	TLSKey     []byte
 	SkipVerify bool
 	Insecure   bool
 	Encoding   string
@@ -40,29 +39,30 @@ type GnmiClient struct {
 	Target     string
 	MaxMsgSize int
 	Client     gnmi.GNMIClient
-}
+	// Caps holds the capabilities the target reported when it was dialed
+	Caps *Capabilities
+	// Schema, when set, validates SetRequests before they are sent
+	Schema *Schema
 
-func NewGnmiClient() *GnmiClient {
-	return new(GnmiClient)
+	// mu guards conn and closed, the client is shared by all the reconcilers and
+	// watchers of the target and may be closed while they are using it
+	mu     sync.RWMutex
+	conn   *grpc.ClientConn
+	closed bool
 }
 
-// ParseEnvironment loads a sibling `.env` file then looks through all environment
-func (g *GnmiClient) ParseEnvironment() error {
-	g.Username = envy.Get("SRL_USERNAME", "admin")
-	g.Password = envy.Get("SRL_PASSWORD", "admin")
-	g.Proxy = false
-	g.NoTLS = false
-	g.TLSCA = envy.Get("SRL_TLSCA", "")
-	g.TLSCert = envy.Get("SRL_TLSCERT", "")
-	g.TLSKey = envy.Get("SRL_TLSKEY", "")
-	g.SkipVerify = true
-	g.Insecure = false
-	g.Encoding = envy.Get("SRL_ENCODING", "JSON_IETF")
-	g.Timeout = 30 * time.Second
-	g.Target = envy.Get("SRL_ENCODING", "172.19.19.2:57400")
-	g.MaxMsgSize = 512 * 1024 * 1024
+// ErrClientClosed is returned by the RPCs of a client that was closed, e.g.
+// because the pool replaced it after the credentials of the target changed
+var ErrClientClosed = errors.New("client is closed")
 
-	return nil
+// NewGnmiClient returns a GnmiClient with the default settings, the target,
+// credentials and TLS material are filled in by the caller
+func NewGnmiClient() *GnmiClient {
+	return &GnmiClient{
+		Encoding:   "JSON_IETF",
+		Timeout:    30 * time.Second,
+		MaxMsgSize: 512 * 1024 * 1024,
+	}
 }
 
 // NewTLS //
@@ -78,23 +78,19 @@ func (g *GnmiClient) newTLS() (*tls.Config, error) {
 	return tlsConfig, nil
 }
 
-// loadCertificates loads certificates from file.
+// loadCerts loads the PEM encoded certificates held by the client.
 func (g *GnmiClient) loadCerts(tlscfg *tls.Config) error {
-	if g.TLSCert != "" && g.TLSKey != "" {
-		certificate, err := tls.LoadX509KeyPair(g.TLSCert, g.TLSKey)
+	if len(g.TLSCert) > 0 && len(g.TLSKey) > 0 {
+		certificate, err := tls.X509KeyPair(g.TLSCert, g.TLSKey)
 		if err != nil {
 			return err
 		}
 		tlscfg.Certificates = []tls.Certificate{certificate}
 		tlscfg.BuildNameToCertificate()
 	}
-	if g.TLSCA != "" {
+	if len(g.TLSCA) > 0 {
 		certPool := x509.NewCertPool()
-		caFile, err := ioutil.ReadFile(g.TLSCA)
-		if err != nil {
-			return err
-		}
-		if ok := certPool.AppendCertsFromPEM(caFile); !ok {
+		if ok := certPool.AppendCertsFromPEM(g.TLSCA); !ok {
 			return errors.New("failed to append certificate")
 		}
 		tlscfg.RootCAs = certPool
@@ -123,20 +119,90 @@ func (g *GnmiClient) Initialize() error {
 	if err != nil {
 		return err
 	}
+	g.mu.Lock()
+	g.conn = conn
 	g.Client = gnmi.NewGNMIClient(conn)
+	g.closed = false
+	g.mu.Unlock()
+	if err := g.handshake(context.Background()); err != nil {
+		g.Close()
+		return err
+	}
 	return nil
 
 }
 
+// Close tears down the connection to the target. RPCs in flight fail and the
+// RPCs sent afterwards return ErrClientClosed.
+func (g *GnmiClient) Close() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.closed {
+		return nil
+	}
+	g.closed = true
+	if g.conn == nil {
+		return nil
+	}
+	return g.conn.Close()
+}
+
+// Closed returns true once Close was called
+func (g *GnmiClient) Closed() bool {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	return g.closed
+}
+
+// gnmiClient returns the stub the RPCs of op are sent through, or an Error
+// wrapping ErrClientClosed once the client is closed
+func (g *GnmiClient) gnmiClient(op string) (gnmi.GNMIClient, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.closed || g.Client == nil {
+		// the pool dials a new client, so the request may succeed when retried
+		return nil, &Error{Op: op, Target: g.Target, Code: codes.Unavailable, Err: ErrClientClosed}
+	}
+	return g.Client, nil
+}
+
+// splitPathValue splits a path-value pair at the first ':' that is not in the keys
+// of the path, so key values may contain ':', e.g. IPv6 addresses
+func splitPathValue(s string) []string {
+	var inKey, escaped bool
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case escaped:
+			escaped = false
+		case inKey && c == '\\':
+			escaped = true
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case inKey && (c == '"' || c == '\'') && s[i-1] == '=':
+			quote = c
+		case c == '[':
+			inKey = true
+		case c == ']':
+			inKey = false
+		case c == ':' && !inKey:
+			return []string{s[:i], s[i+1:]}
+		}
+	}
+	return []string{s}
+}
+
 func buildPbUpdateList(pathValuePairs []string) ([]*pb.Update, error) {
 	var pbUpdateList []*pb.Update
 	for _, item := range pathValuePairs {
-		pathValuePair := strings.SplitN(item, ":", 2)
-		// TODO (leguo): check if any path attribute contains ':'
+		pathValuePair := splitPathValue(item)
 		if len(pathValuePair) != 2 || len(pathValuePair[1]) == 0 {
 			return nil, fmt.Errorf("invalid path-value pair: %v", item)
 		}
-		pbPath, err := xpath.ToGNMIPath(pathValuePair[0])
+		pbPath, err := ParsePath(pathValuePair[0])
 		if err != nil {
 			return nil, fmt.Errorf("error in parsing xpath %q to gnmi path", pathValuePair[0])
 		}
@@ -0,0 +1,37 @@
+package gnmic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func TestClosedClient(t *testing.T) {
+	g := &GnmiClient{Encoding: "JSON_IETF", Timeout: time.Second, Client: &getClient{rsp: &gnmi.GetResponse{}}}
+	if err := g.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !g.Closed() {
+		t.Error("Closed() = false after Close")
+	}
+	// closing twice is a no-op
+	if err := g.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	_, getErr := g.Get(ctx, &gnmi.GetRequest{})
+	_, setErr := g.Set(ctx, &gnmi.SetRequest{})
+	_, capErr := g.Capabilities(ctx)
+	for name, err := range map[string]error{"Get": getErr, "Set": setErr, "Capabilities": capErr} {
+		if !errors.Is(err, ErrClientClosed) {
+			t.Errorf("%s() error = %v, want %v", name, err, ErrClientClosed)
+		}
+		if !IsRetryable(err) {
+			t.Errorf("%s() error %v is not retryable", name, err)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package gnmic
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error is returned for a failed RPC to a target, it carries the gRPC status code
+// returned by the target
+type Error struct {
+	// Op is the message or stream that failed, e.g. SetRequest
+	Op     string
+	Target string
+	Code   codes.Code
+	Err    error
+}
+
+// newError wraps err returned by the target for op
+func newError(op, target string, err error) *Error {
+	return &Error{
+		Op:     op,
+		Target: target,
+		Code:   status.Code(err),
+		Err:    err,
+	}
+}
+
+// NewInvalidRequestError returns a terminal Error for a request to target that was
+// not sent because it is invalid
+func NewInvalidRequestError(op, target string, err error) *Error {
+	return &Error{
+		Op:     op,
+		Target: target,
+		Code:   codes.InvalidArgument,
+		Err:    err,
+	}
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("failed sending %s to '%s': %v", e.Op, e.Target, e.Err)
+}
+
+// Unwrap returns the error returned by the target
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Retryable returns true if the request may succeed when it is sent again
+// unchanged, e.g. because the target was unreachable or busy
+func (e *Error) Retryable() bool {
+	switch e.Code {
+	case codes.InvalidArgument,
+		codes.FailedPrecondition,
+		codes.OutOfRange,
+		codes.NotFound,
+		codes.AlreadyExists,
+		codes.PermissionDenied,
+		codes.Unauthenticated,
+		codes.Unimplemented:
+		return false
+	default:
+		// Unavailable, DeadlineExceeded, ResourceExhausted, Aborted and errors the
+		// target did not classify
+		return true
+	}
+}
+
+// IsRetryable returns true unless err is a terminal Error. Errors that did not come
+// from a target are considered retryable.
+func IsRetryable(err error) bool {
+	return err != nil && !IsTerminal(err)
+}
+
+// IsTerminal returns true if err is an Error the target will return again for the
+// same request, e.g. because the configuration was rejected
+func IsTerminal(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && !e.Retryable()
+}
+
+// Code returns the gRPC status code of err, codes.Unknown when err did not come
+// from a target
+func Code(err error) codes.Code {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Code
+	}
+	if err == nil {
+		return codes.OK
+	}
+	return codes.Unknown
+}
@@ -0,0 +1,65 @@
+package gnmic
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestErrorClass(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		terminal bool
+		code     codes.Code
+	}{
+		{name: "nil", err: nil, terminal: false, code: codes.OK},
+		{name: "not from a target", err: errors.New("dial timeout"), terminal: false, code: codes.Unknown},
+		{name: "unavailable", err: newError("SetRequest", "t", status.Error(codes.Unavailable, "")), terminal: false, code: codes.Unavailable},
+		{name: "deadline exceeded", err: newError("SetRequest", "t", status.Error(codes.DeadlineExceeded, "")), terminal: false, code: codes.DeadlineExceeded},
+		{name: "resource exhausted", err: newError("SetRequest", "t", status.Error(codes.ResourceExhausted, "")), terminal: false, code: codes.ResourceExhausted},
+		{name: "aborted", err: newError("SetRequest", "t", status.Error(codes.Aborted, "")), terminal: false, code: codes.Aborted},
+		{name: "unclassified", err: newError("SetRequest", "t", errors.New("eof")), terminal: false, code: codes.Unknown},
+		{name: "invalid argument", err: newError("SetRequest", "t", status.Error(codes.InvalidArgument, "")), terminal: true, code: codes.InvalidArgument},
+		{name: "failed precondition", err: newError("SetRequest", "t", status.Error(codes.FailedPrecondition, "")), terminal: true, code: codes.FailedPrecondition},
+		{name: "out of range", err: newError("SetRequest", "t", status.Error(codes.OutOfRange, "")), terminal: true, code: codes.OutOfRange},
+		{name: "not found", err: newError("SetRequest", "t", status.Error(codes.NotFound, "")), terminal: true, code: codes.NotFound},
+		{name: "already exists", err: newError("SetRequest", "t", status.Error(codes.AlreadyExists, "")), terminal: true, code: codes.AlreadyExists},
+		{name: "permission denied", err: newError("SetRequest", "t", status.Error(codes.PermissionDenied, "")), terminal: true, code: codes.PermissionDenied},
+		{name: "unauthenticated", err: newError("SetRequest", "t", status.Error(codes.Unauthenticated, "")), terminal: true, code: codes.Unauthenticated},
+		{name: "unimplemented", err: newError("SetRequest", "t", status.Error(codes.Unimplemented, "")), terminal: true, code: codes.Unimplemented},
+		{name: "invalid request", err: NewInvalidRequestError("SetRequest", "t", errors.New("bad path")), terminal: true, code: codes.InvalidArgument},
+		{name: "wrapped terminal", err: fmt.Errorf("device d1: %w", newError("SetRequest", "t", status.Error(codes.InvalidArgument, ""))), terminal: true, code: codes.InvalidArgument},
+		{name: "wrapped retryable", err: fmt.Errorf("device d1: %w", newError("SetRequest", "t", status.Error(codes.Unavailable, ""))), terminal: false, code: codes.Unavailable},
+		{name: "wrapped invalid request", err: fmt.Errorf("device d1: %w", NewInvalidRequestError("SetRequest", "t", errors.New("bad path"))), terminal: true, code: codes.InvalidArgument},
+		{name: "client closed", err: &Error{Op: "SetRequest", Target: "t", Code: codes.Unavailable, Err: ErrClientClosed}, terminal: false, code: codes.Unavailable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsTerminal(tt.err); got != tt.terminal {
+				t.Errorf("IsTerminal() = %v, want %v", got, tt.terminal)
+			}
+			if got, want := IsRetryable(tt.err), tt.err != nil && !tt.terminal; got != want {
+				t.Errorf("IsRetryable() = %v, want %v", got, want)
+			}
+			if got := Code(tt.err); got != tt.code {
+				t.Errorf("Code() = %v, want %v", got, tt.code)
+			}
+		})
+	}
+}
+
+func TestErrorUnwrap(t *testing.T) {
+	cause := errors.New("bad path")
+	err := fmt.Errorf("device d1: %w", NewInvalidRequestError("SetRequest", "t", cause))
+	if !errors.Is(err, cause) {
+		t.Errorf("errors.Is(%v, %v) = false", err, cause)
+	}
+	var e *Error
+	if !errors.As(err, &e) || e.Op != "SetRequest" || e.Target != "t" {
+		t.Errorf("errors.As(%v) = %+v", err, e)
+	}
+}
@@ -0,0 +1,63 @@
+package gnmic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// GetTree sends a GetRequest of dataType for path, in the encoding of the client,
+// and merges the updates of all the notifications in the response into a single
+// generic json document rooted at path. The module prefixes of JSON_IETF member
+// names are removed. GetTree returns nil when the target has no data at path.
+func (g *GnmiClient) GetTree(ctx context.Context, path *gnmi.Path, dataType gnmi.GetRequest_DataType) (interface{}, error) {
+	encoding, ok := gnmi.Encoding_value[strings.ToUpper(g.Encoding)]
+	if !ok {
+		return nil, fmt.Errorf("encoding '%s' not supported", g.Encoding)
+	}
+	gnmiPrefix, err := CreatePrefix("", g.Target)
+	if err != nil {
+		return nil, err
+	}
+	rsp, err := g.Get(ctx, &gnmi.GetRequest{
+		Prefix:   gnmiPrefix,
+		Path:     []*gnmi.Path{path},
+		Type:     dataType,
+		Encoding: gnmi.Encoding(encoding),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tree := make(map[string]interface{})
+	for _, n := range rsp.GetNotification() {
+		for _, u := range n.GetUpdate() {
+			v, err := DecodeValue(u.GetVal())
+			if err != nil {
+				return nil, fmt.Errorf("failed decoding value of '%s': %v", PathToString(u.GetPath()), err)
+			}
+			SetTreeValue(tree, joinPaths(n.GetPrefix(), u.GetPath()).GetElem(), StripModulePrefixes(v))
+		}
+	}
+	return treeAt(tree, path.GetElem()), nil
+}
+
+// GetInto is GetTree with the document unmarshaled into out, out is left untouched
+// when the target has no data at path
+func (g *GnmiClient) GetInto(ctx context.Context, path *gnmi.Path, dataType gnmi.GetRequest_DataType, out interface{}) error {
+	tree, err := g.GetTree(ctx, path, dataType)
+	if err != nil || tree == nil {
+		return err
+	}
+	b, err := json.Marshal(tree)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("failed decoding '%s' from target '%s': %v", PathToString(path), g.Target, err)
+	}
+	return nil
+}
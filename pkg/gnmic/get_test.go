@@ -0,0 +1,137 @@
+package gnmic
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+)
+
+// getClient is a gnmi.GNMIClient that answers every GetRequest with rsp
+type getClient struct {
+	gnmi.GNMIClient
+	rsp *gnmi.GetResponse
+	req *gnmi.GetRequest
+}
+
+func (c *getClient) Get(ctx context.Context, req *gnmi.GetRequest, opts ...grpc.CallOption) (*gnmi.GetResponse, error) {
+	c.req = req
+	return c.rsp, nil
+}
+
+func mustParsePath(t *testing.T, p string) *gnmi.Path {
+	t.Helper()
+	path, err := ParsePath(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func jsonIetf(s string) *gnmi.TypedValue {
+	return &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{JsonIetfVal: []byte(s)}}
+}
+
+func TestGetInto(t *testing.T) {
+	type server struct {
+		Address string `json:"address"`
+		Prefer  bool   `json:"prefer"`
+		Stratum int    `json:"stratum"`
+	}
+	type ntp struct {
+		AdminState string   `json:"admin-state"`
+		Server     []server `json:"server"`
+	}
+
+	tests := []struct {
+		name          string
+		path          string
+		notifications func(t *testing.T) []*gnmi.Notification
+		want          ntp
+	}{
+		{
+			name: "json_ietf at the requested path",
+			path: "/system/ntp",
+			notifications: func(t *testing.T) []*gnmi.Notification {
+				return []*gnmi.Notification{{
+					Update: []*gnmi.Update{{
+						Path: mustParsePath(t, "/system/ntp"),
+						Val:  jsonIetf(`{"srl_nokia-ntp:admin-state": "enable", "srl_nokia-ntp:server": [{"address": "10.0.0.1", "prefer": true, "stratum": 2}]}`),
+					}},
+				}}
+			},
+			want: ntp{AdminState: "enable", Server: []server{{Address: "10.0.0.1", Prefer: true, Stratum: 2}}},
+		},
+		{
+			name: "json rooted at /",
+			path: "/system/ntp",
+			notifications: func(t *testing.T) []*gnmi.Notification {
+				return []*gnmi.Notification{{
+					Update: []*gnmi.Update{{
+						Path: &gnmi.Path{},
+						Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: []byte(`{"system": {"ntp": {"admin-state": "disable"}}}`)}},
+					}},
+				}}
+			},
+			want: ntp{AdminState: "disable"},
+		},
+		{
+			name: "updates of multiple notifications",
+			path: "/system/ntp",
+			notifications: func(t *testing.T) []*gnmi.Notification {
+				return []*gnmi.Notification{
+					{
+						Prefix: mustParsePath(t, "/system/ntp"),
+						Update: []*gnmi.Update{
+							{Path: mustParsePath(t, "/admin-state"), Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "enable"}}},
+							{Path: mustParsePath(t, "/server[address=2001:db8::1]/stratum"), Val: &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 3}}},
+						},
+					},
+					{
+						Update: []*gnmi.Update{{
+							Path: mustParsePath(t, "/system/ntp/server[address=2001:db8::1]"),
+							Val:  jsonIetf(`{"prefer": true}`),
+						}},
+					},
+				}
+			},
+			want: ntp{AdminState: "enable", Server: []server{{Address: "2001:db8::1", Prefer: true, Stratum: 3}}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &getClient{rsp: &gnmi.GetResponse{Notification: tt.notifications(t)}}
+			g := &GnmiClient{Encoding: "json_ietf", Timeout: time.Second, Client: c}
+			var got ntp
+			if err := g.GetInto(context.Background(), mustParsePath(t, tt.path), gnmi.GetRequest_ALL, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetInto() = %+v, want %+v", got, tt.want)
+			}
+			if c.req.GetType() != gnmi.GetRequest_ALL || c.req.GetEncoding() != gnmi.Encoding_JSON_IETF {
+				t.Errorf("unexpected request %v", c.req)
+			}
+		})
+	}
+}
+
+func TestGetIntoWildcard(t *testing.T) {
+	c := &getClient{rsp: &gnmi.GetResponse{Notification: []*gnmi.Notification{{
+		Update: []*gnmi.Update{
+			{Path: mustParsePath(t, "/network-instance[name=mgmt]/name"), Val: jsonIetf(`"mgmt"`)},
+			{Path: mustParsePath(t, "/network-instance[name=default]"), Val: jsonIetf(`{"srl_nokia-network-instance:name": "default"}`)},
+		},
+	}}}}
+	g := &GnmiClient{Encoding: "JSON_IETF", Timeout: time.Second, Client: c}
+	var got []string
+	if err := g.GetInto(context.Background(), mustParsePath(t, "/network-instance[name=*]/name"), gnmi.GetRequest_CONFIG, &got); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"mgmt", "default"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetInto() = %v, want %v", got, want)
+	}
+}
@@ -2,20 +2,29 @@ package gnmic
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"google.golang.org/grpc/metadata"
 )
 
-// Set sends a gnmi.SetRequest to the target *t and returns a gnmi.SetResponse and an error
+// Set sends a gnmi.SetRequest to the target *t and returns a gnmi.SetResponse and an error.
+// When the client has a Schema the request is validated first and not sent when invalid.
 func (g *GnmiClient) Set(ctx context.Context, req *gnmi.SetRequest) (*gnmi.SetResponse, error) {
+	if g.Schema != nil {
+		if err := g.Schema.ValidateSetRequest(req); err != nil {
+			return nil, NewInvalidRequestError("SetRequest", g.Target, err)
+		}
+	}
 	nctx, cancel := context.WithTimeout(ctx, g.Timeout)
 	defer cancel()
 	nctx = metadata.AppendToOutgoingContext(nctx, "username", g.Username, "password", g.Password)
-	response, err := g.Client.Set(nctx, req)
+	c, err := g.gnmiClient("SetRequest")
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.Set(nctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed sending SetRequest to '%s': %v", g.Target, err)
+		return nil, newError("SetRequest", g.Target, err)
 	}
 	return response, nil
 }
@@ -25,9 +34,13 @@ func (g *GnmiClient) Get(ctx context.Context, req *gnmi.GetRequest) (*gnmi.GetRe
 	nctx, cancel := context.WithTimeout(ctx, g.Timeout)
 	defer cancel()
 	nctx = metadata.AppendToOutgoingContext(nctx, "username", g.Username, "password", g.Password)
-	response, err := g.Client.Get(nctx, req)
+	c, err := g.gnmiClient("GetRequest")
+	if err != nil {
+		return nil, err
+	}
+	response, err := c.Get(nctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed sending GetRequest to '%s': %v", g.Target, err)
+		return nil, newError("GetRequest", g.Target, err)
 	}
 	return response, nil
 }
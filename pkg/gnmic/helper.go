@@ -1,9 +1,10 @@
 package gnmic
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
-	"github.com/google/gnxi/utils/xpath"
 	"github.com/openconfig/gnmi/proto/gnmi"
 )
 
@@ -22,22 +23,218 @@ func CreatePrefix(prefix, target string) (*gnmi.Path, error) {
 	return p, nil
 }
 
-// ParsePath creates a gnmi.Path out of a p string, check if the first element is prefixed by an origin,
-// removes it from the xpath and adds it to the returned gnmiPath
+// ParsePath creates a gnmi.Path out of a p string. When p does not start with a '/'
+// and its first element is prefixed by "origin:", the origin is removed from the
+// path and set in the returned gnmi.Path.
+//
+// Key values are taken literally up to the closing ']', so they may contain '/',
+// ':' and '=', e.g. interface[name=ethernet-1/1] or server[address=2001:db8::1].
+// A backslash escapes the next character and a value may be quoted with double or
+// single quotes, e.g. [description="a ] b"]. Element names and key values may be
+// the wildcard '*'.
 func ParsePath(p string) (*gnmi.Path, error) {
-	var origin string
-	elems := strings.Split(p, "/")
-	if len(elems) > 0 {
-		f := strings.Split(elems[0], ":")
-		if len(f) > 1 {
-			origin = f[0]
-			elems[0] = strings.Join(f[1:], ":")
+	pp := &pathParser{s: strings.TrimSpace(p)}
+	return pp.parse()
+}
+
+// pathParser tokenizes an xpath
+type pathParser struct {
+	s   string
+	pos int
+}
+
+func (pp *pathParser) parse() (*gnmi.Path, error) {
+	path := &gnmi.Path{}
+	if origin, ok := pp.origin(); ok {
+		path.Origin = origin
+	}
+	if pp.peek() == '/' {
+		pp.pos++
+	}
+	for !pp.done() {
+		elem, err := pp.elem()
+		if err != nil {
+			return nil, err
+		}
+		path.Elem = append(path.Elem, elem)
+		if pp.done() {
+			break
+		}
+		// elem stops at a '/'
+		pp.pos++
+		if pp.done() {
+			// trailing '/'
+			break
 		}
 	}
-	gnmiPath, err := xpath.ToGNMIPath(strings.Join(elems, "/"))
-	if err != nil {
-		return nil, err
+	return path, nil
+}
+
+// origin consumes the origin of a path not starting with a '/', that is the text
+// before a ':' that comes before the first '/' or '['
+func (pp *pathParser) origin() (string, bool) {
+	if strings.HasPrefix(pp.s, "/") {
+		return "", false
+	}
+	end := strings.IndexAny(pp.s, "/[")
+	if end < 0 {
+		end = len(pp.s)
+	}
+	i := strings.Index(pp.s[:end], ":")
+	if i < 0 {
+		return "", false
+	}
+	pp.pos = i + 1
+	return pp.s[:i], true
+}
+
+// elem consumes an element name and its keys, up to the next '/' or the end
+func (pp *pathParser) elem() (*gnmi.PathElem, error) {
+	start := pp.pos
+	for !pp.done() && pp.peek() != '/' && pp.peek() != '[' {
+		if pp.peek() == ']' {
+			return nil, pp.errorf("unexpected ']'")
+		}
+		pp.pos++
+	}
+	elem := &gnmi.PathElem{Name: pp.s[start:pp.pos]}
+	if elem.Name == "" {
+		return nil, pp.errorf("empty element name")
+	}
+	for pp.peek() == '[' {
+		pp.pos++
+		name, value, err := pp.key()
+		if err != nil {
+			return nil, err
+		}
+		if elem.Key == nil {
+			elem.Key = make(map[string]string)
+		}
+		if _, ok := elem.Key[name]; ok {
+			return nil, pp.errorf("duplicate key %q in element %q", name, elem.Name)
+		}
+		elem.Key[name] = value
+	}
+	if !pp.done() && pp.peek() != '/' {
+		return nil, pp.errorf("unexpected %q after the keys of element %q", pp.peek(), elem.Name)
+	}
+	return elem, nil
+}
+
+// key consumes "name=value]", the opening '[' has been consumed
+func (pp *pathParser) key() (string, string, error) {
+	start := pp.pos
+	for !pp.done() && pp.peek() != '=' {
+		switch pp.peek() {
+		case ']', '[', '/':
+			return "", "", pp.errorf("missing '=' in key")
+		}
+		pp.pos++
+	}
+	if pp.done() {
+		return "", "", pp.errorf("unterminated key")
+	}
+	name := strings.TrimSpace(pp.s[start:pp.pos])
+	if name == "" {
+		return "", "", pp.errorf("empty key name")
+	}
+	// skip '='
+	pp.pos++
+
+	var sb strings.Builder
+	quote := byte(0)
+	if c := pp.peek(); c == '"' || c == '\'' {
+		quote = c
+		pp.pos++
+	}
+	for {
+		if pp.done() {
+			return "", "", pp.errorf("unterminated value of key %q", name)
+		}
+		c := pp.s[pp.pos]
+		pp.pos++
+		switch {
+		case c == '\\':
+			if pp.done() {
+				return "", "", pp.errorf("unterminated escape in value of key %q", name)
+			}
+			sb.WriteByte(pp.s[pp.pos])
+			pp.pos++
+		case quote != 0 && c == quote:
+			if pp.peek() != ']' {
+				return "", "", pp.errorf("expected ']' after the quoted value of key %q", name)
+			}
+			pp.pos++
+			return name, sb.String(), nil
+		case quote == 0 && c == ']':
+			return name, sb.String(), nil
+		default:
+			sb.WriteByte(c)
+		}
+	}
+}
+
+func (pp *pathParser) done() bool {
+	return pp.pos >= len(pp.s)
+}
+
+// peek returns the next character, 0 at the end of the path
+func (pp *pathParser) peek() byte {
+	if pp.done() {
+		return 0
+	}
+	return pp.s[pp.pos]
+}
+
+func (pp *pathParser) errorf(format string, a ...interface{}) error {
+	return fmt.Errorf("invalid path %q at position %d: %s", pp.s, pp.pos, fmt.Sprintf(format, a...))
+}
+
+// PathToString returns the canonical xpath representation of a gnmi.Path, prefixed
+// by its origin when it has one. Keys are sorted by name and backslashes and ']' in
+// key values are escaped, so that ParsePath returns an equal gnmi.Path. The target of
+// the path is not part of the result.
+func PathToString(p *gnmi.Path) string {
+	if p == nil {
+		return ""
+	}
+	var sb strings.Builder
+	if p.GetOrigin() != "" {
+		sb.WriteString(p.GetOrigin())
+		sb.WriteString(":")
+	}
+	for _, e := range p.GetElem() {
+		sb.WriteString("/")
+		sb.WriteString(e.GetName())
+		keys := make([]string, 0, len(e.GetKey()))
+		for k := range e.GetKey() {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			sb.WriteString("[")
+			sb.WriteString(k)
+			sb.WriteString("=")
+			sb.WriteString(escapeKeyValue(e.GetKey()[k]))
+			sb.WriteString("]")
+		}
+	}
+	if len(p.GetElem()) == 0 {
+		sb.WriteString("/")
+	}
+	return sb.String()
+}
+
+// escapeKeyValue escapes the characters of a key value that ParsePath would not
+// take literally
+func escapeKeyValue(v string) string {
+	var sb strings.Builder
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		if c == '\\' || c == ']' || (i == 0 && (c == '"' || c == '\'')) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteByte(c)
 	}
-	gnmiPath.Origin = origin
-	return gnmiPath, nil
+	return sb.String()
 }
@@ -0,0 +1,280 @@
+package gnmic
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func elem(name string, keys ...string) *gnmi.PathElem {
+	e := &gnmi.PathElem{Name: name}
+	for i := 0; i+1 < len(keys); i += 2 {
+		if e.Key == nil {
+			e.Key = make(map[string]string)
+		}
+		e.Key[keys[i]] = keys[i+1]
+	}
+	return e
+}
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want *gnmi.Path
+		// canonical is the PathToString of want, in when empty
+		canonical string
+	}{
+		{
+			name:      "empty",
+			in:        "",
+			want:      &gnmi.Path{},
+			canonical: "/",
+		},
+		{
+			name: "root",
+			in:   "/",
+			want: &gnmi.Path{},
+		},
+		{
+			name: "single element",
+			in:   "/system",
+			want: &gnmi.Path{Elem: []*gnmi.PathElem{elem("system")}},
+		},
+		{
+			name: "elements",
+			in:   "/system/ntp/admin-state",
+			want: &gnmi.Path{Elem: []*gnmi.PathElem{elem("system"), elem("ntp"), elem("admin-state")}},
+		},
+		{
+			name:      "relative",
+			in:        "system/ntp",
+			want:      &gnmi.Path{Elem: []*gnmi.PathElem{elem("system"), elem("ntp")}},
+			canonical: "/system/ntp",
+		},
+		{
+			name:      "trailing slash",
+			in:        "/system/ntp/",
+			want:      &gnmi.Path{Elem: []*gnmi.PathElem{elem("system"), elem("ntp")}},
+			canonical: "/system/ntp",
+		},
+		{
+			name:      "surrounding spaces",
+			in:        "  /system/ntp  ",
+			want:      &gnmi.Path{Elem: []*gnmi.PathElem{elem("system"), elem("ntp")}},
+			canonical: "/system/ntp",
+		},
+		{
+			name: "key",
+			in:   "/network-instance[name=mgmt]/protocols",
+			want: &gnmi.Path{Elem: []*gnmi.PathElem{elem("network-instance", "name", "mgmt"), elem("protocols")}},
+		},
+		{
+			name: "key with slash",
+			in:   "/interface[name=ethernet-1/1]/subinterface[index=0]/admin-state",
+			want: &gnmi.Path{Elem: []*gnmi.PathElem{
+				elem("interface", "name", "ethernet-1/1"),
+				elem("subinterface", "index", "0"),
+				elem("admin-state"),
+			}},
+		},
+		{
+			name: "ipv6 key",
+			in:   "/system/ntp/server[address=2001:db8::1]/prefer",
+			want: &gnmi.Path{Elem: []*gnmi.PathElem{
+				elem("system"), elem("ntp"), elem("server", "address", "2001:db8::1"), elem("prefer"),
+			}},
+		},
+		{
+			name: "key with equal sign",
+			in:   "/a[k=x=y]",
+			want: &gnmi.Path{Elem: []*gnmi.PathElem{elem("a", "k", "x=y")}},
+		},
+		{
+			name: "multiple keys",
+			in:   "/network-instance[name=default]/protocols/bgp/neighbor[peer-address=10.0.0.1][afi=ipv4]",
+			want: &gnmi.Path{Elem: []*gnmi.PathElem{
+				elem("network-instance", "name", "default"),
+				elem("protocols"),
+				elem("bgp"),
+				elem("neighbor", "peer-address", "10.0.0.1", "afi", "ipv4"),
+			}},
+			canonical: "/network-instance[name=default]/protocols/bgp/neighbor[afi=ipv4][peer-address=10.0.0.1]",
+		},
+		{
+			name:      "double quoted key",
+			in:        `/interface[description="uplink ] to /spine"]`,
+			want:      &gnmi.Path{Elem: []*gnmi.PathElem{elem("interface", "description", "uplink ] to /spine")}},
+			canonical: `/interface[description=uplink \] to /spine]`,
+		},
+		{
+			name:      "single quoted key",
+			in:        `/interface[name='ethernet-1/1']`,
+			want:      &gnmi.Path{Elem: []*gnmi.PathElem{elem("interface", "name", "ethernet-1/1")}},
+			canonical: "/interface[name=ethernet-1/1]",
+		},
+		{
+			name:      "quote in quoted key",
+			in:        `/a[k="say \"hi\""]`,
+			want:      &gnmi.Path{Elem: []*gnmi.PathElem{elem("a", "k", `say "hi"`)}},
+			canonical: `/a[k=say "hi"]`,
+		},
+		{
+			name: "escaped key",
+			in:   `/a[k=x\]y\\z]`,
+			want: &gnmi.Path{Elem: []*gnmi.PathElem{elem("a", "k", `x]y\z`)}},
+		},
+		{
+			name: "escaped leading quote",
+			in:   `/a[k=\"x]`,
+			want: &gnmi.Path{Elem: []*gnmi.PathElem{elem("a", "k", `"x`)}},
+		},
+		{
+			name:      "empty key value",
+			in:        `/a[k=""]`,
+			want:      &gnmi.Path{Elem: []*gnmi.PathElem{elem("a", "k", "")}},
+			canonical: "/a[k=]",
+		},
+		{
+			name: "wildcards",
+			in:   "/interface[name=*]/*/description",
+			want: &gnmi.Path{Elem: []*gnmi.PathElem{elem("interface", "name", "*"), elem("*"), elem("description")}},
+		},
+		{
+			name: "origin",
+			in:   "openconfig:/interfaces/interface[name=ethernet-1/1]",
+			want: &gnmi.Path{Origin: "openconfig", Elem: []*gnmi.PathElem{elem("interfaces"), elem("interface", "name", "ethernet-1/1")}},
+		},
+		{
+			name:      "origin without slash",
+			in:        "openconfig:interfaces",
+			want:      &gnmi.Path{Origin: "openconfig", Elem: []*gnmi.PathElem{elem("interfaces")}},
+			canonical: "openconfig:/interfaces",
+		},
+		{
+			name:      "only origin",
+			in:        "openconfig:",
+			want:      &gnmi.Path{Origin: "openconfig"},
+			canonical: "openconfig:/",
+		},
+		{
+			name: "module prefix is not an origin",
+			in:   "/srl_nokia-system:system/srl_nokia-ntp:ntp",
+			want: &gnmi.Path{Elem: []*gnmi.PathElem{elem("srl_nokia-system:system"), elem("srl_nokia-ntp:ntp")}},
+		},
+		{
+			name:      "colon in first key is not an origin",
+			in:        "server[address=2001:db8::1]",
+			want:      &gnmi.Path{Elem: []*gnmi.PathElem{elem("server", "address", "2001:db8::1")}},
+			canonical: "/server[address=2001:db8::1]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePath(tt.in)
+			if err != nil {
+				t.Fatalf("ParsePath(%q): %v", tt.in, err)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Fatalf("ParsePath(%q) = %v, want %v", tt.in, got, tt.want)
+			}
+			canonical := tt.canonical
+			if canonical == "" {
+				canonical = tt.in
+			}
+			s := PathToString(got)
+			if s != canonical {
+				t.Errorf("PathToString() = %q, want %q", s, canonical)
+			}
+			again, err := ParsePath(s)
+			if err != nil {
+				t.Fatalf("ParsePath(%q): %v", s, err)
+			}
+			if !proto.Equal(again, got) {
+				t.Errorf("ParsePath(%q) = %v, want %v", s, again, got)
+			}
+		})
+	}
+}
+
+func TestParsePathErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{name: "empty element", in: "/system//ntp"},
+		{name: "unterminated key", in: "/interface[name=ethernet-1/1"},
+		{name: "unterminated key name", in: "/interface[name"},
+		{name: "missing equal sign", in: "/interface[name]"},
+		{name: "empty key name", in: "/interface[=ethernet-1/1]"},
+		{name: "unterminated quote", in: `/interface[name="ethernet-1/1]`},
+		{name: "text after quote", in: `/interface[name="a"b]`},
+		{name: "unterminated escape", in: `/interface[name=a\`},
+		{name: "duplicate key", in: "/a[k=1][k=2]"},
+		{name: "text after keys", in: "/a[k=1]b/c"},
+		{name: "unexpected bracket", in: "/a]/b"},
+		{name: "keys without element", in: "/[k=1]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if p, err := ParsePath(tt.in); err == nil {
+				t.Errorf("ParsePath(%q) = %v, expected an error", tt.in, p)
+			}
+		})
+	}
+}
+
+func TestPathToString(t *testing.T) {
+	tests := []struct {
+		name string
+		in   *gnmi.Path
+		want string
+	}{
+		{name: "nil", in: nil, want: ""},
+		{name: "target is left out", in: &gnmi.Path{Target: "leaf1:57400", Elem: []*gnmi.PathElem{elem("system")}}, want: "/system"},
+		{name: "sorted keys", in: &gnmi.Path{Elem: []*gnmi.PathElem{elem("a", "z", "1", "b", "2", "m", "3")}}, want: "/a[b=2][m=3][z=1]"},
+		{name: "escaped backslash", in: &gnmi.Path{Elem: []*gnmi.PathElem{elem("a", "k", `x\y`)}}, want: `/a[k=x\\y]`},
+		{name: "single quote", in: &gnmi.Path{Elem: []*gnmi.PathElem{elem("a", "k", "'x'")}}, want: `/a[k=\'x']`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PathToString(tt.in); got != tt.want {
+				t.Errorf("PathToString() = %q, want %q", got, tt.want)
+			}
+			if tt.in == nil {
+				return
+			}
+			got, err := ParsePath(tt.want)
+			if err != nil {
+				t.Fatalf("ParsePath(%q): %v", tt.want, err)
+			}
+			want := proto.Clone(tt.in).(*gnmi.Path)
+			want.Target = ""
+			if !proto.Equal(got, want) {
+				t.Errorf("ParsePath(%q) = %v, want %v", tt.want, got, want)
+			}
+		})
+	}
+}
+
+func TestSplitPathValue(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{in: "/system/name/host-name:leaf1", want: []string{"/system/name/host-name", "leaf1"}},
+		{in: "/system/ntp/server[address=2001:db8::1]/prefer:true", want: []string{"/system/ntp/server[address=2001:db8::1]/prefer", "true"}},
+		{in: `/a[k="x]:y"]/b:"v:w"`, want: []string{`/a[k="x]:y"]/b`, `"v:w"`}},
+		{in: `/a[k=x\]:y]/b:1`, want: []string{`/a[k=x\]:y]/b`, "1"}},
+		{in: "/system/name/host-name", want: []string{"/system/name/host-name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := splitPathValue(tt.in); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("splitPathValue(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
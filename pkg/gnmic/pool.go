@@ -0,0 +1,175 @@
+package gnmic
+
+import (
+	"bytes"
+	"fmt"
+	"sync"
+)
+
+// Pool holds a GnmiClient per target, keyed by an arbitrary name
+type Pool struct {
+	// Schema is set on the clients of the pool to validate their SetRequests
+	Schema *Schema
+
+	mu      sync.Mutex
+	clients map[string]*poolEntry
+}
+
+// poolEntry is a client of the pool, ready is closed once the client is dialed
+// and err holds the result of dialing it
+type poolEntry struct {
+	client *GnmiClient
+	ready  chan struct{}
+	err    error
+}
+
+// NewPool returns an empty Pool
+func NewPool() *Pool {
+	return &Pool{
+		clients: make(map[string]*poolEntry),
+	}
+}
+
+// Get returns the client cached under key. When no client is cached or the cached
+// client was dialed with a configuration different from cfg, the cached client is
+// closed and cfg is dialed and cached instead. Dialing happens outside the lock of
+// the pool, so a target that does not answer only holds up the callers asking for
+// that target; concurrent callers asking for the same configuration wait for the
+// same dial.
+func (p *Pool) Get(key string, cfg *GnmiClient) (*GnmiClient, error) {
+	// the pool owns the client it dials, cfg is left untouched
+	client := cfg.config()
+	client.Schema = p.Schema
+
+	p.mu.Lock()
+	var old *GnmiClient
+	for {
+		e, ok := p.clients[key]
+		if !ok {
+			break
+		}
+		select {
+		case <-e.ready:
+		default:
+			// another caller is dialing the target
+			p.mu.Unlock()
+			<-e.ready
+			if e.err != nil && e.client.sameConfig(client) {
+				return nil, e.err
+			}
+			p.mu.Lock()
+			continue
+		}
+		if e.client.sameConfig(client) {
+			p.mu.Unlock()
+			return e.client, nil
+		}
+		old = e.client
+		delete(p.clients, key)
+		break
+	}
+	e := &poolEntry{client: client, ready: make(chan struct{})}
+	p.clients[key] = e
+	p.mu.Unlock()
+
+	if old != nil {
+		old.Close()
+	}
+	err := client.Initialize()
+
+	p.mu.Lock()
+	if err == nil && p.clients[key] != e {
+		// the client was deleted or replaced while it was dialed
+		err = fmt.Errorf("client of '%s' was removed from the pool while dialing", key)
+		client.Close()
+	}
+	if err != nil && p.clients[key] == e {
+		delete(p.clients, key)
+	}
+	e.err = err
+	close(e.ready)
+	p.mu.Unlock()
+
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// Delete closes the client cached under key and removes it from the pool. A
+// client that is still being dialed is closed once it is dialed.
+func (p *Pool) Delete(key string) error {
+	p.mu.Lock()
+	e, ok := p.clients[key]
+	delete(p.clients, key)
+	p.mu.Unlock()
+
+	if !ok {
+		return nil
+	}
+	return e.close()
+}
+
+// Close closes all the clients in the pool
+func (p *Pool) Close() {
+	p.mu.Lock()
+	entries := p.clients
+	p.clients = make(map[string]*poolEntry)
+	p.mu.Unlock()
+
+	for _, e := range entries {
+		e.close()
+	}
+}
+
+// close closes the client of a dialed entry, Get closes the client of an entry
+// that is still being dialed when it finds the entry removed
+func (e *poolEntry) close() error {
+	select {
+	case <-e.ready:
+		if e.err != nil {
+			return nil
+		}
+		return e.client.Close()
+	default:
+		return nil
+	}
+}
+
+// sameConfig returns true if o would dial the target the same way as g
+func (g *GnmiClient) sameConfig(o *GnmiClient) bool {
+	return g.Username == o.Username &&
+		g.Password == o.Password &&
+		g.Proxy == o.Proxy &&
+		g.NoTLS == o.NoTLS &&
+		bytes.Equal(g.TLSCA, o.TLSCA) &&
+		bytes.Equal(g.TLSCert, o.TLSCert) &&
+		bytes.Equal(g.TLSKey, o.TLSKey) &&
+		g.SkipVerify == o.SkipVerify &&
+		g.Insecure == o.Insecure &&
+		g.Encoding == o.Encoding &&
+		g.Timeout == o.Timeout &&
+		g.Target == o.Target &&
+		g.MaxMsgSize == o.MaxMsgSize
+}
+
+// config returns a new client with the configuration of g, without its connection
+// and capabilities
+func (g *GnmiClient) config() *GnmiClient {
+	return &GnmiClient{
+		Username:   g.Username,
+		Password:   g.Password,
+		Proxy:      g.Proxy,
+		NoTLS:      g.NoTLS,
+		TLSCA:      g.TLSCA,
+		TLSCert:    g.TLSCert,
+		TLSKey:     g.TLSKey,
+		SkipVerify: g.SkipVerify,
+		Insecure:   g.Insecure,
+		Encoding:   g.Encoding,
+		Timeout:    g.Timeout,
+		Target:     g.Target,
+		MaxMsgSize: g.MaxMsgSize,
+		Schema:     g.Schema,
+	}
+}
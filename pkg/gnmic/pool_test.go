@@ -0,0 +1,88 @@
+package gnmic
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+// blackhole returns the address of a listener that accepts connections and never
+// answers on them
+func blackhole(t *testing.T) string {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			t.Cleanup(func() { conn.Close() })
+		}
+	}()
+	return l.Addr().String()
+}
+
+func TestPoolDialOutsideLock(t *testing.T) {
+	p := NewPool()
+	cached := &GnmiClient{Target: "cached:57400", Insecure: true, Timeout: time.Second}
+	ready := make(chan struct{})
+	close(ready)
+	p.clients["cached"] = &poolEntry{client: cached, ready: ready}
+
+	dialed := make(chan error, 1)
+	go func() {
+		_, err := p.Get("blackhole", &GnmiClient{Target: blackhole(t), Insecure: true, Encoding: "JSON_IETF", Timeout: 500 * time.Millisecond})
+		dialed <- err
+	}()
+	for {
+		p.mu.Lock()
+		_, ok := p.clients["blackhole"]
+		p.mu.Unlock()
+		if ok {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	// the handshake with the blackholed target must not hold up the other targets
+	start := time.Now()
+	g, err := p.Get("cached", &GnmiClient{Target: "cached:57400", Insecure: true, Timeout: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g != cached {
+		t.Error("Get() did not return the cached client")
+	}
+	if err := p.Delete("blackhole"); err != nil {
+		t.Fatal(err)
+	}
+	if d := time.Since(start); d > 100*time.Millisecond {
+		t.Errorf("Get() and Delete() took %v while another target was dialed", d)
+	}
+
+	if err := <-dialed; err == nil {
+		t.Error("expected dialing the blackholed target to fail")
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.clients["blackhole"]; ok {
+		t.Error("the failed client is still in the pool")
+	}
+}
+
+func TestPoolGetKeepsConfig(t *testing.T) {
+	p := NewPool()
+	p.Schema = &Schema{}
+	cfg := &GnmiClient{Target: blackhole(t), Insecure: true, Encoding: "JSON_IETF", Timeout: 100 * time.Millisecond}
+	if _, err := p.Get("blackhole", cfg); err == nil {
+		t.Fatal("expected dialing the blackholed target to fail")
+	}
+	if cfg.Schema != nil || cfg.Client != nil || cfg.Caps != nil {
+		t.Errorf("Get() modified the config it was given: %+v", cfg)
+	}
+}
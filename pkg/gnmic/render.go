@@ -0,0 +1,125 @@
+package gnmic
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// StructToJSONIETF validates s against its YANG schema and returns it encoded as
+// JSON_IETF, with the module prefixes where the namespace changes
+func StructToJSONIETF(s ygot.ValidatedGoStruct) ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %v", err)
+	}
+	v, err := ygot.ConstructIETFJSON(s, &ygot.RFC7951JSONConfig{AppendModuleName: true})
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// StructToJSON validates s against its YANG schema and returns it encoded as JSON,
+// without module prefixes
+func StructToJSON(s ygot.ValidatedGoStruct) ([]byte, error) {
+	if err := s.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %v", err)
+	}
+	v, err := ygot.ConstructInternalJSON(s)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(v)
+}
+
+// StructUpdate returns an update of path with s encoded in encoding, JSON or
+// JSON_IETF
+func StructUpdate(path *gnmi.Path, s ygot.ValidatedGoStruct, encoding string) (*gnmi.Update, error) {
+	switch strings.ToUpper(encoding) {
+	case gnmi.Encoding_JSON_IETF.String():
+		b, err := StructToJSONIETF(s)
+		if err != nil {
+			return nil, err
+		}
+		return &gnmi.Update{
+			Path: path,
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{JsonIetfVal: b}},
+		}, nil
+	case gnmi.Encoding_JSON.String():
+		b, err := StructToJSON(s)
+		if err != nil {
+			return nil, err
+		}
+		return &gnmi.Update{
+			Path: path,
+			Val:  &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: b}},
+		}, nil
+	default:
+		return nil, fmt.Errorf("encoding '%s' cannot be used for structs", encoding)
+	}
+}
+
+// StructNotifications validates s against its YANG schema and renders it into
+// notifications holding an update per leaf, below prefix
+func StructNotifications(s ygot.ValidatedGoStruct, ts int64, prefix *gnmi.Path) ([]*gnmi.Notification, error) {
+	if err := s.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %v", err)
+	}
+	return ygot.TogNMINotifications(s, ts, ygot.GNMINotificationsConfig{
+		UsePathElem:    true,
+		PathElemPrefix: prefix.GetElem(),
+	})
+}
+
+// CreateSetRequestFromStruct returns a SetRequest to the target that deletes the
+// paths in deletes and merges root into the configuration of the target, in the
+// encoding of the client or else one the target negotiated. root is
+// the root of the generated bindings, nil when only deletes are sent. A root that
+// is not valid against its YANG schema is reported as a terminal Error, the target
+// would reject it.
+func (g *GnmiClient) CreateSetRequestFromStruct(root ygot.ValidatedGoStruct, deletes ...*gnmi.Path) (*gnmi.SetRequest, error) {
+	gnmiPrefix, err := CreatePrefix("", g.Target)
+	if err != nil {
+		return nil, err
+	}
+	req := &gnmi.SetRequest{
+		Prefix: gnmiPrefix,
+		Delete: deletes,
+	}
+	if root == nil {
+		return req, nil
+	}
+	encoding, err := g.structEncoding()
+	if err != nil {
+		return nil, NewInvalidRequestError("SetRequest", g.Target, err)
+	}
+	update, err := StructUpdate(&gnmi.Path{}, root, encoding)
+	if err != nil {
+		return nil, NewInvalidRequestError("SetRequest", g.Target, err)
+	}
+	req.Update = []*gnmi.Update{update}
+	return req, nil
+}
+
+// structEncoding returns the encoding of the updates rendered from structs: the
+// encoding of the client when it is JSON or JSON_IETF, otherwise the first of them
+// the target reported during the handshake
+func (g *GnmiClient) structEncoding() (string, error) {
+	structEncodings := []string{gnmi.Encoding_JSON_IETF.String(), gnmi.Encoding_JSON.String()}
+	for _, e := range structEncodings {
+		if strings.EqualFold(g.Encoding, e) {
+			return e, nil
+		}
+	}
+	if g.Caps != nil {
+		for _, e := range structEncodings {
+			if g.Caps.SupportsEncoding(e) {
+				return e, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("encoding '%s' cannot be used for structs and target '%s' did not report any of %v", g.Encoding, g.Target, structEncodings)
+}
@@ -0,0 +1,75 @@
+package gnmic
+
+import (
+	"testing"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+)
+
+func TestCreateSetRequestFromStructEncoding(t *testing.T) {
+	root := &srlmodels.Device{}
+	root.GetOrCreateSystem().GetOrCreateNtp().AdminState = srlmodels.SrlNokiaNtp_AdminState_enable
+
+	tests := []struct {
+		name     string
+		encoding string
+		caps     *Capabilities
+		ietf     bool
+		want     string
+		wantErr  bool
+	}{
+		{
+			name:     "json_ietf",
+			encoding: "JSON_IETF",
+			ietf:     true,
+			want:     `{"srl_nokia-system:system":{"srl_nokia-ntp:ntp":{"admin-state":"enable"}}}`,
+		},
+		{
+			name:     "json",
+			encoding: "json",
+			want:     `{"system":{"ntp":{"admin-state":"enable"}}}`,
+		},
+		{
+			name:     "negotiated encoding",
+			encoding: "PROTO",
+			caps:     &Capabilities{Encodings: []string{"PROTO", "JSON"}},
+			want:     `{"system":{"ntp":{"admin-state":"enable"}}}`,
+		},
+		{
+			name:     "no struct encoding",
+			encoding: "PROTO",
+			caps:     &Capabilities{Encodings: []string{"PROTO", "ASCII"}},
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := &GnmiClient{Target: "t", Encoding: tt.encoding, Caps: tt.caps}
+			req, err := g.CreateSetRequestFromStruct(root)
+			if tt.wantErr {
+				if !IsTerminal(err) {
+					t.Errorf("CreateSetRequestFromStruct() error = %v, want a terminal error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []byte
+			switch v := req.GetUpdate()[0].GetVal().GetValue().(type) {
+			case *gnmi.TypedValue_JsonIetfVal:
+				got = v.JsonIetfVal
+			case *gnmi.TypedValue_JsonVal:
+				got = v.JsonVal
+			}
+			if _, ietf := req.GetUpdate()[0].GetVal().GetValue().(*gnmi.TypedValue_JsonIetfVal); ietf != tt.ietf {
+				t.Errorf("CreateSetRequestFromStruct() update is %T", req.GetUpdate()[0].GetVal().GetValue())
+			}
+			if string(got) != tt.want {
+				t.Errorf("CreateSetRequestFromStruct() update = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,553 @@
+package gnmic
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// Schema validates the paths and values of SetRequests offline against a set of
+// YANG modules, so that invalid configuration is rejected before it is sent
+type Schema struct {
+	root *yang.Entry
+
+	// patterns caches the compiled patterns of the string types, nil for the
+	// patterns that have no go equivalent
+	mu       sync.RWMutex
+	patterns map[string]*regexp.Regexp
+}
+
+// ValidationError is a path or value rejected by the schema
+type ValidationError struct {
+	Path *gnmi.Path
+	// Value is the rejected leaf or key value, nil when the path was rejected
+	Value   interface{}
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", PathToString(e.Path), e.Message)
+}
+
+// ValidationErrors holds all the errors found while validating a request
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// LoadSchema reads and processes all the .yang files in dir. Modules imported by
+// those files must be in dir as well, the search path of goyang is not used so
+// that schemas loaded from different directories do not mix.
+func LoadSchema(dir string) (*Schema, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.yang"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no yang modules found in %s", dir)
+	}
+
+	ms := yang.NewModules()
+	for _, f := range files {
+		data, err := ioutil.ReadFile(f)
+		if err != nil {
+			return nil, err
+		}
+		if err := ms.Parse(string(data), f); err != nil {
+			return nil, err
+		}
+	}
+	if errs := ms.Process(); len(errs) > 0 {
+		return nil, fmt.Errorf("failed processing yang modules in %s: %v", dir, errs)
+	}
+
+	root := &yang.Entry{
+		Name: "device",
+		Kind: yang.DirectoryEntry,
+		Dir:  make(map[string]*yang.Entry),
+	}
+	names := make([]string, 0, len(ms.Modules))
+	for name := range ms.Modules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for n, e := range yang.ToEntry(ms.Modules[name]).Dir {
+			root.Dir[n] = e
+		}
+	}
+	return &Schema{root: root, patterns: make(map[string]*regexp.Regexp)}, nil
+}
+
+// ValidateSetRequest validates the paths of the deletes and the paths and values of
+// the replaces and updates of req. It returns ValidationErrors when req is invalid.
+func (s *Schema) ValidateSetRequest(req *gnmi.SetRequest) error {
+	v := &validator{s: s}
+	for _, p := range req.GetDelete() {
+		s.resolve(v, joinPaths(req.GetPrefix(), p))
+	}
+	for _, u := range append(req.GetReplace(), req.GetUpdate()...) {
+		s.validate(v, joinPaths(req.GetPrefix(), u.GetPath()), u.GetVal())
+	}
+	return v.result()
+}
+
+// Validate validates val at path. It returns ValidationErrors when path does not
+// exist in the schema or val is invalid.
+func (s *Schema) Validate(path *gnmi.Path, val *gnmi.TypedValue) error {
+	v := &validator{s: s}
+	s.validate(v, path, val)
+	return v.result()
+}
+
+func (s *Schema) validate(v *validator, path *gnmi.Path, val *gnmi.TypedValue) {
+	e, p, ok := s.resolve(v, path)
+	if !ok {
+		return
+	}
+	value, err := schemaValue(val)
+	if err != nil {
+		v.add(p, "%v", err)
+		return
+	}
+	elems := path.GetElem()
+	keyed := len(elems) > 0 && len(elems[len(elems)-1].GetKey()) > 0
+	v.node(e, p, value, keyed)
+}
+
+// resolve returns the schema entry of path and path with its module prefixes
+// removed
+func (s *Schema) resolve(v *validator, path *gnmi.Path) (*yang.Entry, *gnmi.Path, bool) {
+	e := s.root
+	p := &gnmi.Path{}
+	for _, elem := range path.GetElem() {
+		name := StripModulePrefix(elem.GetName())
+		p = appendElem(p, name, elem.GetKey())
+		c := findChild(e, name)
+		if c == nil {
+			v.add(p, "unknown element %q", name)
+			return nil, nil, false
+		}
+		if len(elem.GetKey()) > 0 && !c.IsList() {
+			v.add(p, "%q is not a list", name)
+			return nil, nil, false
+		}
+		keys := make([]string, 0, len(elem.GetKey()))
+		for k := range elem.GetKey() {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			kv := elem.GetKey()[k]
+			ke := c.Dir[k]
+			if ke == nil || !isListKey(c, k) {
+				v.add(p, "%q is not a key of %q", k, name)
+				return nil, nil, false
+			}
+			if kv == "*" {
+				continue
+			}
+			if err := s.checkValue(ke, ke.Type, kv); err != nil {
+				v.invalid(appendElem(p, k, nil), kv, err)
+			}
+		}
+		e = c
+	}
+	return e, p, true
+}
+
+// validator collects the errors found in a request
+type validator struct {
+	s    *Schema
+	errs ValidationErrors
+}
+
+func (v *validator) add(p *gnmi.Path, format string, a ...interface{}) {
+	v.errs = append(v.errs, &ValidationError{Path: p, Message: fmt.Sprintf(format, a...)})
+}
+
+func (v *validator) invalid(p *gnmi.Path, val interface{}, err error) {
+	v.errs = append(v.errs, &ValidationError{Path: p, Value: val, Message: err.Error()})
+}
+
+func (v *validator) result() error {
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+// node validates val against e, keyed is true when p selects a single entry of a
+// list
+func (v *validator) node(e *yang.Entry, p *gnmi.Path, val interface{}, keyed bool) {
+	if e.ReadOnly() {
+		v.add(p, "%q is not configurable", e.Name)
+		return
+	}
+	switch {
+	case e.IsList() && !keyed:
+		items, ok := val.([]interface{})
+		if !ok {
+			v.add(p, "expected a list, got %s", describe(val))
+			return
+		}
+		v.maxElements(e, p, len(items))
+		for _, item := range items {
+			v.listEntry(e, p, item)
+		}
+	case e.IsDir():
+		v.dir(e, p, val)
+	case e.IsLeafList():
+		items, ok := val.([]interface{})
+		if !ok {
+			items = []interface{}{val}
+		}
+		v.maxElements(e, p, len(items))
+		for _, item := range items {
+			if err := v.s.checkValue(e, e.Type, item); err != nil {
+				v.invalid(p, item, err)
+			}
+		}
+	default:
+		if err := v.s.checkValue(e, e.Type, val); err != nil {
+			v.invalid(p, val, err)
+		}
+	}
+}
+
+// listEntry validates an entry of list e, the keys of the entry are added to p
+func (v *validator) listEntry(e *yang.Entry, p *gnmi.Path, val interface{}) {
+	m, ok := val.(map[string]interface{})
+	if !ok {
+		v.add(p, "expected an object, got %s", describe(val))
+		return
+	}
+	keys := make(map[string]string)
+	for _, k := range strings.Fields(e.Key) {
+		kv, ok := lookup(m, k)
+		if !ok {
+			v.add(p, "list entry is missing key %q", k)
+			return
+		}
+		keys[k] = fmt.Sprint(kv)
+	}
+	elems := p.GetElem()
+	ep := appendElem(&gnmi.Path{Elem: elems[:len(elems)-1]}, elems[len(elems)-1].GetName(), keys)
+	v.dir(e, ep, m)
+}
+
+func (v *validator) dir(e *yang.Entry, p *gnmi.Path, val interface{}) {
+	m, ok := val.(map[string]interface{})
+	if !ok {
+		v.add(p, "expected an object, got %s", describe(val))
+		return
+	}
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		// skip rfc7951 metadata
+		if strings.HasPrefix(name, "@") {
+			continue
+		}
+		n := StripModulePrefix(name)
+		cp := appendElem(p, n, nil)
+		c := findChild(e, n)
+		if c == nil {
+			v.add(cp, "unknown element %q", n)
+			continue
+		}
+		v.node(c, cp, m[name], false)
+	}
+}
+
+func (v *validator) maxElements(e *yang.Entry, p *gnmi.Path, n int) {
+	if e.ListAttr == nil || e.ListAttr.MaxElements == nil {
+		return
+	}
+	max, err := strconv.Atoi(e.ListAttr.MaxElements.Name)
+	if err != nil {
+		// unbounded
+		return
+	}
+	if n > max {
+		v.add(p, "%d entries exceed max-elements %d", n, max)
+	}
+}
+
+// pattern returns the compiled yang pattern, or nil when it is an xsd regular
+// expression that has no go equivalent
+func (s *Schema) pattern(pattern string) *regexp.Regexp {
+	s.mu.RLock()
+	re, ok := s.patterns[pattern]
+	s.mu.RUnlock()
+	if ok {
+		return re
+	}
+	re, err := regexp.Compile(`^(?:` + pattern + `)$`)
+	if err != nil {
+		re = nil
+	}
+	s.mu.Lock()
+	s.patterns[pattern] = re
+	s.mu.Unlock()
+	return re
+}
+
+// checkValue validates the leaf value val against type t of entry e
+func (s *Schema) checkValue(e *yang.Entry, t *yang.YangType, val interface{}) error {
+	if t == nil {
+		return nil
+	}
+	switch t.Kind {
+	case yang.Ystring:
+		str, ok := val.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got %s", describe(val))
+		}
+		if !inRange(t.Length, yang.FromInt(int64(utf8.RuneCountInString(str)))) {
+			return fmt.Errorf("length of %q is outside %v", str, t.Length)
+		}
+		for _, pattern := range t.Pattern {
+			if re := s.pattern(pattern); re != nil && !re.MatchString(str) {
+				return fmt.Errorf("%q does not match pattern %q", str, pattern)
+			}
+		}
+	case yang.Ybool:
+		switch val {
+		case true, false, "true", "false":
+		default:
+			return fmt.Errorf("expected a boolean, got %s", describe(val))
+		}
+	case yang.Yint8, yang.Yint16, yang.Yint32, yang.Yint64,
+		yang.Yuint8, yang.Yuint16, yang.Yuint32, yang.Yuint64:
+		n, err := yang.ParseInt(numberString(val))
+		if err != nil {
+			return fmt.Errorf("expected an integer, got %s", describe(val))
+		}
+		if !inRange(t.Range, n) {
+			return fmt.Errorf("%s is outside %v", n, t.Range)
+		}
+	case yang.Ydecimal64:
+		n, err := yang.ParseDecimal(numberString(val), uint8(t.FractionDigits))
+		if err != nil {
+			return fmt.Errorf("expected a decimal with at most %d fraction digits, got %s", t.FractionDigits, describe(val))
+		}
+		if !inRange(t.Range, n) {
+			return fmt.Errorf("%s is outside %v", n, t.Range)
+		}
+	case yang.Yenum:
+		str, ok := val.(string)
+		if t.Enum == nil || (ok && t.Enum.IsDefined(str)) {
+			return nil
+		}
+		return fmt.Errorf("%s is not one of %v", describe(val), t.Enum.Names())
+	case yang.Yidentityref:
+		str, ok := val.(string)
+		if !ok {
+			return fmt.Errorf("expected an identity, got %s", describe(val))
+		}
+		if t.IdentityBase != nil && !isIdentity(t.IdentityBase, StripModulePrefix(str)) {
+			return fmt.Errorf("%q is not derived from identity %q", str, t.IdentityBase.Name)
+		}
+	case yang.Yunion:
+		for _, ut := range t.Type {
+			if s.checkValue(e, ut, val) == nil {
+				return nil
+			}
+		}
+		return fmt.Errorf("%s does not match any type of union %s", describe(val), t.Name)
+	case yang.Yleafref:
+		// the value must be valid for the referenced leaf, whether the referenced
+		// entry exists is up to the target
+		if ref := s.leafref(e, t.Path); ref != nil && ref != e && ref.Type != nil {
+			return s.checkValue(ref, ref.Type, val)
+		}
+	case yang.Yempty:
+		if items, ok := val.([]interface{}); val != nil && !(ok && len(items) == 1 && items[0] == nil) {
+			return fmt.Errorf("expected an empty value, got %s", describe(val))
+		}
+	case yang.Ybinary:
+		str, ok := val.(string)
+		if !ok {
+			return fmt.Errorf("expected base64 encoded binary, got %s", describe(val))
+		}
+		if _, err := base64.StdEncoding.DecodeString(str); err != nil {
+			return fmt.Errorf("expected base64 encoded binary: %v", err)
+		}
+	case yang.Ybits:
+		str, ok := val.(string)
+		if !ok {
+			return fmt.Errorf("expected bits, got %s", describe(val))
+		}
+		for _, b := range strings.Fields(str) {
+			if t.Bit == nil || !t.Bit.IsDefined(b) {
+				return fmt.Errorf("bit %q is not defined", b)
+			}
+		}
+	}
+	return nil
+}
+
+// schemaValue decodes val, json numbers are kept as json.Number to keep the
+// precision of 64 bit integers and decimals
+func schemaValue(val *gnmi.TypedValue) (interface{}, error) {
+	var b []byte
+	switch v := val.GetValue().(type) {
+	case *gnmi.TypedValue_JsonVal:
+		b = v.JsonVal
+	case *gnmi.TypedValue_JsonIetfVal:
+		b = v.JsonIetfVal
+	default:
+		return DecodeValue(val)
+	}
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	var out interface{}
+	if err := d.Decode(&out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// leafref returns the leaf referenced by path from e. Absolute paths are resolved
+// from the root of the schema as they may cross modules, e.g. from an augment.
+func (s *Schema) leafref(e *yang.Entry, path string) *yang.Entry {
+	if !strings.HasPrefix(path, "/") {
+		return e.Find(path)
+	}
+	ref := s.root
+	for _, name := range strings.Split(strings.TrimPrefix(path, "/"), "/") {
+		if i := strings.Index(name, "["); i >= 0 {
+			name = name[:i]
+		}
+		if ref = findChild(ref, StripModulePrefix(strings.TrimSpace(name))); ref == nil {
+			return nil
+		}
+	}
+	return ref
+}
+
+// findChild returns the data node name of e, looking through choices and cases
+func findChild(e *yang.Entry, name string) *yang.Entry {
+	if c, ok := e.Dir[name]; ok && !c.IsChoice() && !c.IsCase() {
+		return c
+	}
+	for _, c := range e.Dir {
+		if c.IsChoice() || c.IsCase() {
+			if cc := findChild(c, name); cc != nil {
+				return cc
+			}
+		}
+	}
+	return nil
+}
+
+func isListKey(e *yang.Entry, name string) bool {
+	for _, k := range strings.Fields(e.Key) {
+		if k == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isIdentity(base *yang.Identity, name string) bool {
+	for _, id := range base.Values {
+		if id.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// lookup returns the value of name in m, with or without a module prefix
+func lookup(m map[string]interface{}, name string) (interface{}, bool) {
+	for k, v := range m {
+		if StripModulePrefix(k) == name {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func inRange(r yang.YangRange, n yang.Number) bool {
+	if len(r) == 0 {
+		return true
+	}
+	for _, yr := range r {
+		if !n.Less(yr.Min) && !yr.Max.Less(n) {
+			return true
+		}
+	}
+	return false
+}
+
+// numberString returns the string representation of a json or gnmi scalar number,
+// 64 bit integers and decimals are encoded as strings in json_ietf
+func numberString(val interface{}) string {
+	switch v := val.(type) {
+	case json.Number:
+		return v.String()
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint64:
+		return strconv.FormatUint(v, 10)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+func describe(val interface{}) string {
+	switch v := val.(type) {
+	case string:
+		return strconv.Quote(v)
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "a list"
+	case nil:
+		return "null"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// appendElem returns a copy of p with an element name and keys appended
+func appendElem(p *gnmi.Path, name string, keys map[string]string) *gnmi.Path {
+	elems := make([]*gnmi.PathElem, 0, len(p.GetElem())+1)
+	elems = append(elems, p.GetElem()...)
+	return &gnmi.Path{Elem: append(elems, &gnmi.PathElem{Name: name, Key: keys})}
+}
+
+// joinPaths returns the elements of prefix followed by the elements of path
+func joinPaths(prefix, path *gnmi.Path) *gnmi.Path {
+	elems := make([]*gnmi.PathElem, 0, len(prefix.GetElem())+len(path.GetElem()))
+	elems = append(elems, prefix.GetElem()...)
+	return &gnmi.Path{Elem: append(elems, path.GetElem()...)}
+}
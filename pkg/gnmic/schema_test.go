@@ -0,0 +1,185 @@
+package gnmic
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func loadTestSchema(t *testing.T) *Schema {
+	t.Helper()
+	s, err := LoadSchema("../srlmodels/yang")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSchemaValidate(t *testing.T) {
+	s := loadTestSchema(t)
+	servers := make([]string, 0, 9)
+	for i := 0; i < 9; i++ {
+		servers = append(servers, fmt.Sprintf(`{"address": "10.0.0.%d"}`, i+1))
+	}
+
+	tests := []struct {
+		name string
+		path string
+		val  *gnmi.TypedValue
+		// wantErr is a part of the expected error, empty when val is valid
+		wantErr string
+	}{
+		{
+			name: "valid",
+			path: "/system/ntp",
+			val:  jsonIetf(`{"admin-state": "enable", "network-instance": "mgmt", "server": [{"address": "10.0.0.1", "iburst": true}]}`),
+		},
+		{
+			name:    "bad enum",
+			path:    "/system/ntp/admin-state",
+			val:     jsonIetf(`"up"`),
+			wantErr: `"up" is not one of`,
+		},
+		{
+			name:    "pattern mismatch",
+			path:    "/acl/mac-filter[name=l2]/entry[sequence-id=10]/match/source-mac/address",
+			val:     jsonIetf(`"00:00:5e:00:53"`),
+			wantErr: "does not match pattern",
+		},
+		{
+			name: "pattern match",
+			path: "/acl/mac-filter[name=l2]/entry[sequence-id=10]/match/source-mac/address",
+			val:  jsonIetf(`"00:00:5e:00:53:00"`),
+		},
+		{
+			name:    "range overflow",
+			path:    "/interface[name=ethernet-1/1]/mtu",
+			val:     &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 9600}},
+			wantErr: "9600 is outside",
+		},
+		{
+			name:    "key out of range",
+			path:    "/acl/ipv4-filter[name=edge]/entry[sequence-id=65536]/description",
+			val:     jsonIetf(`"too far"`),
+			wantErr: "65536 is outside",
+		},
+		{
+			name: "union of a number",
+			path: "/acl/ipv4-filter[name=edge]/entry[sequence-id=10]/match/protocol",
+			val:  jsonIetf(`6`),
+		},
+		{
+			name: "union of an enum",
+			path: "/acl/ipv4-filter[name=edge]/entry[sequence-id=10]/match/protocol",
+			val:  jsonIetf(`"tcp"`),
+		},
+		{
+			name:    "union mismatch",
+			path:    "/acl/ipv4-filter[name=edge]/entry[sequence-id=10]/match/protocol",
+			val:     jsonIetf(`"sctp"`),
+			wantErr: "does not match any type of union",
+		},
+		{
+			name: "leafref",
+			path: "/interface[name=ethernet-1/1]/subinterface[index=10]/acl/input/ipv4-filter",
+			val:  jsonIetf(`"edge"`),
+		},
+		{
+			name:    "leafref to an invalid value",
+			path:    "/interface[name=ethernet-1/1]/subinterface[index=10]/acl/input/ipv4-filter",
+			val:     jsonIetf(`""`),
+			wantErr: "length",
+		},
+		{
+			name:    "missing list key",
+			path:    "/system/ntp",
+			val:     jsonIetf(`{"server": [{"prefer": true}]}`),
+			wantErr: `missing key "address"`,
+		},
+		{
+			name:    "max-elements",
+			path:    "/system/ntp",
+			val:     jsonIetf(`{"server": [` + strings.Join(servers, ", ") + `]}`),
+			wantErr: "9 entries exceed max-elements 8",
+		},
+		{
+			name:    "unknown element in the path",
+			path:    "/system/ntp/servers",
+			val:     jsonIetf(`[]`),
+			wantErr: `unknown element "servers"`,
+		},
+		{
+			name:    "unknown element in the value",
+			path:    "/system/ntp",
+			val:     jsonIetf(`{"admin-state": "enable", "source-address": "10.0.0.1"}`),
+			wantErr: `unknown element "source-address"`,
+		},
+		{
+			name:    "state",
+			path:    "/system/ntp/oper-state",
+			val:     jsonIetf(`"up"`),
+			wantErr: "is not configurable",
+		},
+		{
+			name:    "not a key",
+			path:    "/system/ntp/server[prefer=true]",
+			val:     jsonIetf(`{}`),
+			wantErr: `"prefer" is not a key`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.Validate(mustParsePath(t, tt.path), tt.val)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSchemaValidateSetRequest(t *testing.T) {
+	s := loadTestSchema(t)
+	req := &gnmi.SetRequest{
+		Prefix: mustParsePath(t, "/system"),
+		Delete: []*gnmi.Path{
+			mustParsePath(t, "/ntp/server[address=10.0.0.1]"),
+			mustParsePath(t, "/ntp/peer[address=10.0.0.1]"),
+		},
+		Update: []*gnmi.Update{
+			{Path: mustParsePath(t, "/ntp/admin-state"), Val: jsonIetf(`"enable"`)},
+			{Path: mustParsePath(t, "/ntp/network-instance"), Val: jsonIetf(`""`)},
+		},
+	}
+	err := s.ValidateSetRequest(req)
+	errs, ok := err.(ValidationErrors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("ValidateSetRequest() error = %v, want 2 validation errors", err)
+	}
+	if got := PathToString(errs[0].Path); got != "/system/ntp/peer[address=10.0.0.1]" {
+		t.Errorf("first error at %s", got)
+	}
+	if got := PathToString(errs[1].Path); got != "/system/ntp/network-instance" || errs[1].Value != "" {
+		t.Errorf("second error at %s with value %v", got, errs[1].Value)
+	}
+}
+
+func TestSchemaPatternCache(t *testing.T) {
+	s := loadTestSchema(t)
+	path := mustParsePath(t, "/acl/mac-filter[name=l2]/entry[sequence-id=10]/match/destination-mac/address")
+	for i := 0; i < 2; i++ {
+		if err := s.Validate(path, jsonIetf(`"01:80:c2:00:00:0e"`)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(s.patterns) == 0 {
+		t.Error("the patterns were not cached")
+	}
+}
@@ -2,6 +2,7 @@ package gnmic
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,11 +11,15 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/golang/protobuf/ptypes/any"
 	"github.com/openconfig/gnmi/proto/gnmi"
 	"gopkg.in/yaml.v2"
 )
 
-var vTypes = []string{"json", "json_ietf", "string", "int", "uint", "bool", "decimal", "float", "bytes", "ascii"}
+var vTypes = []string{"json", "json_ietf", "string", "int", "uint", "bool", "decimal", "float", "bytes", "ascii", "leaflist", "proto", "any"}
+
+// maxDecimalPrecision is the maximum number of fraction digits of a decimal64
+const maxDecimalPrecision = 18
 
 // SetCmdInput type holds set command input
 type SetCmdInput struct {
@@ -216,26 +221,23 @@ func convert(i interface{}) interface{} {
 	return i
 }
 
+// setValue sets value to val encoded as typ, one of vTypes.
+//   - json and json_ietf values are passed through when val is valid JSON, any
+//     other val is encoded as a JSON string
+//   - decimal values keep the precision of val, e.g. 1.50 has precision 2
+//   - leaflist values are a JSON array of scalars or a comma separated list of strings
+//   - proto values are base64 encoded serialized protobuf messages
+//   - any values are a JSON object {"type_url": "...", "value": "<base64>"}
 func setValue(value *gnmi.TypedValue, typ, val string) error {
 	var err error
 	switch typ {
 	case "json":
-		buff := new(bytes.Buffer)
-		err = json.NewEncoder(buff).Encode(strings.TrimRight(strings.TrimLeft(val, "["), "]"))
-		if err != nil {
-			return err
-		}
 		value.Value = &gnmi.TypedValue_JsonVal{
-			JsonVal: bytes.Trim(buff.Bytes(), " \r\n\t"),
+			JsonVal: jsonValue(val),
 		}
 	case "json_ietf":
-		buff := new(bytes.Buffer)
-		err = json.NewEncoder(buff).Encode(strings.TrimRight(strings.TrimLeft(val, "["), "]"))
-		if err != nil {
-			return err
-		}
 		value.Value = &gnmi.TypedValue_JsonIetfVal{
-			JsonIetfVal: bytes.Trim(buff.Bytes(), " \r\n\t"),
+			JsonIetfVal: jsonValue(val),
 		}
 	case "ascii":
 		value.Value = &gnmi.TypedValue_AsciiVal{
@@ -254,11 +256,13 @@ func setValue(value *gnmi.TypedValue, typ, val string) error {
 			BytesVal: []byte(val),
 		}
 	case "decimal":
-		dVal := &gnmi.Decimal64{}
+		d, err := parseDecimal64(val)
+		if err != nil {
+			return err
+		}
 		value.Value = &gnmi.TypedValue_DecimalVal{
-			DecimalVal: dVal,
+			DecimalVal: d,
 		}
-		return fmt.Errorf("decimal type not implemented")
 	case "float":
 		f, err := strconv.ParseFloat(val, 32)
 		if err != nil {
@@ -287,12 +291,128 @@ func setValue(value *gnmi.TypedValue, typ, val string) error {
 		value.Value = &gnmi.TypedValue_StringVal{
 			StringVal: val,
 		}
+	case "leaflist":
+		elems, err := leafListValue(val)
+		if err != nil {
+			return err
+		}
+		value.Value = &gnmi.TypedValue_LeaflistVal{
+			LeaflistVal: &gnmi.ScalarArray{Element: elems},
+		}
+	case "proto":
+		b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(val))
+		if err != nil {
+			return fmt.Errorf("invalid proto value: %v", err)
+		}
+		value.Value = &gnmi.TypedValue_ProtoBytes{
+			ProtoBytes: b,
+		}
+	case "any":
+		a := struct {
+			TypeURL string `json:"type_url"`
+			Value   []byte `json:"value"`
+		}{}
+		if err = json.Unmarshal([]byte(val), &a); err != nil {
+			return fmt.Errorf("invalid any value: %v", err)
+		}
+		if a.TypeURL == "" {
+			return errors.New("invalid any value: missing type_url")
+		}
+		value.Value = &gnmi.TypedValue_AnyVal{
+			AnyVal: &any.Any{TypeUrl: a.TypeURL, Value: a.Value},
+		}
 	default:
 		return fmt.Errorf("unknown type '%s', must be one of: %v", typ, vTypes)
 	}
 	return nil
 }
 
+// jsonValue returns val when it is valid JSON and val encoded as a JSON string
+// otherwise, so that e.g. enable can be given for "enable"
+func jsonValue(val string) []byte {
+	trimmed := strings.TrimSpace(val)
+	if json.Valid([]byte(trimmed)) {
+		return []byte(trimmed)
+	}
+	b, _ := json.Marshal(val)
+	return b
+}
+
+// parseDecimal64 parses a decimal number such as -12.50 into its digits and the
+// number of digits following the decimal point
+func parseDecimal64(s string) (*gnmi.Decimal64, error) {
+	s = strings.TrimSpace(s)
+	intPart, fracPart := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		intPart, fracPart = s[:i], s[i+1:]
+	}
+	if strings.ContainsAny(fracPart, "+-") {
+		return nil, fmt.Errorf("invalid decimal %q", s)
+	}
+	if len(fracPart) > maxDecimalPrecision {
+		return nil, fmt.Errorf("decimal %q has more than %d fraction digits", s, maxDecimalPrecision)
+	}
+	digits, err := strconv.ParseInt(intPart+fracPart, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid decimal %q", s)
+	}
+	return &gnmi.Decimal64{Digits: digits, Precision: uint32(len(fracPart))}, nil
+}
+
+// leafListValue returns the elements of a JSON array of scalars or of a comma
+// separated list of strings
+func leafListValue(val string) ([]*gnmi.TypedValue, error) {
+	trimmed := strings.TrimSpace(val)
+	if !strings.HasPrefix(trimmed, "[") {
+		var elems []*gnmi.TypedValue
+		for _, s := range strings.Split(trimmed, ",") {
+			elems = append(elems, &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: strings.TrimSpace(s)}})
+		}
+		return elems, nil
+	}
+
+	d := json.NewDecoder(strings.NewReader(trimmed))
+	d.UseNumber()
+	var items []interface{}
+	if err := d.Decode(&items); err != nil {
+		return nil, fmt.Errorf("invalid leaflist value: %v", err)
+	}
+	elems := make([]*gnmi.TypedValue, 0, len(items))
+	for _, item := range items {
+		elem, err := scalarValue(item)
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, elem)
+	}
+	return elems, nil
+}
+
+// scalarValue returns the TypedValue of a decoded JSON scalar. Integers become
+// int or uint values, other numbers decimal values.
+func scalarValue(v interface{}) (*gnmi.TypedValue, error) {
+	switch v := v.(type) {
+	case string:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: v}}, nil
+	case bool:
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: v}}, nil
+	case json.Number:
+		if i, err := strconv.ParseInt(v.String(), 10, 64); err == nil {
+			return &gnmi.TypedValue{Value: &gnmi.TypedValue_IntVal{IntVal: i}}, nil
+		}
+		if u, err := strconv.ParseUint(v.String(), 10, 64); err == nil {
+			return &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: u}}, nil
+		}
+		d, err := parseDecimal64(v.String())
+		if err != nil {
+			return nil, err
+		}
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_DecimalVal{DecimalVal: d}}, nil
+	default:
+		return nil, fmt.Errorf("leaflist element %v is not a scalar", v)
+	}
+}
+
 func validateSetInput(setInput *SetCmdInput) error {
 	if (len(setInput.Deletes)+len(setInput.Updates)+len(setInput.Replaces)) == 0 && (len(setInput.UpdatePaths)+len(setInput.ReplacePaths)) == 0 {
 		return errors.New("no paths provided")
@@ -0,0 +1,321 @@
+package gnmic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	subscribeModes = []string{"stream", "once", "poll"}
+	streamModes    = []string{"target_defined", "sample", "on_change"}
+)
+
+const defaultRetryInterval = 10 * time.Second
+
+// SubscribeInput type holds subscribe command input
+type SubscribeInput struct {
+	// Prefix is prepended to all the paths
+	Prefix string
+	Paths  []string
+	// Mode is one of stream, once or poll
+	Mode string
+	// StreamMode is one of target_defined, sample or on_change, used in stream mode
+	StreamMode        string
+	SampleInterval    time.Duration
+	HeartbeatInterval time.Duration
+	SuppressRedundant bool
+	UpdatesOnly       bool
+	// RetryInterval is the time waited before resubscribing when the stream to the
+	// target is lost in stream or poll mode
+	RetryInterval time.Duration
+}
+
+// Update is a decoded gnmi.Update
+type Update struct {
+	Path  string
+	Value interface{}
+}
+
+// SubscribeResponse is a decoded gnmi.SubscribeResponse. Err is set when the stream
+// to the target failed, a stream or poll subscription resubscribes after it unless
+// Err wraps ErrClientClosed.
+type SubscribeResponse struct {
+	Timestamp    int64
+	Prefix       string
+	Updates      []Update
+	Deletes      []string
+	SyncResponse bool
+	Err          error
+}
+
+// Subscription is a running subscription
+type Subscription struct {
+	mode      gnmi.SubscriptionList_Mode
+	responses chan *SubscribeResponse
+	poll      chan struct{}
+	cancel    context.CancelFunc
+	done      chan struct{}
+}
+
+// Responses returns the channel the decoded responses are delivered on. It is
+// closed when the subscription ends.
+func (s *Subscription) Responses() <-chan *SubscribeResponse {
+	return s.responses
+}
+
+// Poll asks the target for the current values of a poll subscription
+func (s *Subscription) Poll() error {
+	if s.mode != gnmi.SubscriptionList_POLL {
+		return errors.New("poll is only supported on poll subscriptions")
+	}
+	select {
+	case s.poll <- struct{}{}:
+		return nil
+	case <-s.done:
+		return errors.New("subscription is closed")
+	}
+}
+
+// Close ends the subscription
+func (s *Subscription) Close() {
+	s.cancel()
+	<-s.done
+}
+
+// CreateSubscribeRequest builds a gnmi.SubscribeRequest out of the subscribe input
+func (g *GnmiClient) CreateSubscribeRequest(in *SubscribeInput) (*gnmi.SubscribeRequest, error) {
+	if len(in.Paths) == 0 {
+		return nil, errors.New("no paths provided")
+	}
+	gnmiPrefix, err := CreatePrefix(in.Prefix, g.Target)
+	if err != nil {
+		return nil, fmt.Errorf("prefix parse error: %v", err)
+	}
+	encoding, ok := gnmi.Encoding_value[strings.ToUpper(g.Encoding)]
+	if !ok {
+		return nil, fmt.Errorf("unknown encoding '%s'", g.Encoding)
+	}
+
+	mode, ok := gnmi.SubscriptionList_Mode_value[strings.ToUpper(in.Mode)]
+	if !ok {
+		return nil, fmt.Errorf("unknown subscribe mode '%s', must be one of: %v", in.Mode, subscribeModes)
+	}
+	streamMode := gnmi.SubscriptionMode_TARGET_DEFINED
+	if in.StreamMode != "" {
+		m, ok := gnmi.SubscriptionMode_value[strings.ToUpper(in.StreamMode)]
+		if !ok {
+			return nil, fmt.Errorf("unknown stream mode '%s', must be one of: %v", in.StreamMode, streamModes)
+		}
+		streamMode = gnmi.SubscriptionMode(m)
+	}
+
+	subscriptions := make([]*gnmi.Subscription, 0, len(in.Paths))
+	for _, p := range in.Paths {
+		gnmiPath, err := ParsePath(strings.TrimSpace(p))
+		if err != nil {
+			return nil, err
+		}
+		subscription := &gnmi.Subscription{Path: gnmiPath}
+		if gnmi.SubscriptionList_Mode(mode) == gnmi.SubscriptionList_STREAM {
+			subscription.Mode = streamMode
+			subscription.SampleInterval = uint64(in.SampleInterval.Nanoseconds())
+			subscription.HeartbeatInterval = uint64(in.HeartbeatInterval.Nanoseconds())
+			subscription.SuppressRedundant = in.SuppressRedundant
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return &gnmi.SubscribeRequest{
+		Request: &gnmi.SubscribeRequest_Subscribe{
+			Subscribe: &gnmi.SubscriptionList{
+				Prefix:       gnmiPrefix,
+				Subscription: subscriptions,
+				Mode:         gnmi.SubscriptionList_Mode(mode),
+				Encoding:     gnmi.Encoding(encoding),
+				UpdatesOnly:  in.UpdatesOnly,
+			},
+		},
+	}, nil
+}
+
+// Subscribe starts a subscription to the target and delivers the decoded responses
+// on the channel of the returned Subscription. Stream and poll subscriptions
+// resubscribe when the stream to the target is lost, once subscriptions end after
+// the sync response. All subscriptions end when the client is closed.
+func (g *GnmiClient) Subscribe(ctx context.Context, in *SubscribeInput) (*Subscription, error) {
+	req, err := g.CreateSubscribeRequest(in)
+	if err != nil {
+		return nil, err
+	}
+	retryInterval := in.RetryInterval
+	if retryInterval == 0 {
+		retryInterval = defaultRetryInterval
+	}
+
+	sctx, cancel := context.WithCancel(ctx)
+	s := &Subscription{
+		mode:      req.GetSubscribe().GetMode(),
+		responses: make(chan *SubscribeResponse),
+		poll:      make(chan struct{}),
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+
+	go func() {
+		defer close(s.done)
+		defer close(s.responses)
+		for {
+			select {
+			case <-sctx.Done():
+				return
+			default:
+			}
+			err := g.subscribe(sctx, req, s)
+			if sctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				select {
+				case s.responses <- &SubscribeResponse{Err: err}:
+				case <-sctx.Done():
+					return
+				}
+			}
+			if s.mode == gnmi.SubscriptionList_ONCE || errors.Is(err, ErrClientClosed) {
+				// resubscribing through a closed client fails forever, the caller
+				// has to subscribe again through the client that replaced it
+				return
+			}
+			select {
+			case <-time.After(retryInterval):
+			case <-sctx.Done():
+				return
+			}
+		}
+	}()
+	return s, nil
+}
+
+// SubscribeFunc subscribes to the target and calls fn for every response until
+// ctx is done or, for once subscriptions, until the sync response was received
+func (g *GnmiClient) SubscribeFunc(ctx context.Context, in *SubscribeInput, fn func(*SubscribeResponse)) error {
+	s, err := g.Subscribe(ctx, in)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	for rsp := range s.Responses() {
+		fn(rsp)
+	}
+	return nil
+}
+
+// subscribe runs a single stream to the target until it fails or ends
+func (g *GnmiClient) subscribe(ctx context.Context, req *gnmi.SubscribeRequest, s *Subscription) error {
+	sctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	sctx = metadata.AppendToOutgoingContext(sctx, "username", g.Username, "password", g.Password)
+
+	c, err := g.gnmiClient("SubscribeRequest")
+	if err != nil {
+		return err
+	}
+	stream, err := c.Subscribe(sctx)
+	if err != nil {
+		return newError("SubscribeRequest", g.Target, err)
+	}
+	if err := stream.Send(req); err != nil {
+		return newError("SubscribeRequest", g.Target, err)
+	}
+
+	if s.mode == gnmi.SubscriptionList_POLL {
+		go func() {
+			for {
+				select {
+				case <-s.poll:
+					if err := stream.Send(&gnmi.SubscribeRequest{
+						Request: &gnmi.SubscribeRequest_Poll{Poll: &gnmi.Poll{}},
+					}); err != nil {
+						return
+					}
+				case <-sctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	for {
+		rsp, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return &Error{Op: "SubscribeRequest", Target: g.Target, Code: status.Code(err),
+				Err: fmt.Errorf("stream failed: %v", err)}
+		}
+		decoded, err := decodeSubscribeResponse(rsp)
+		if err != nil {
+			decoded = &SubscribeResponse{Err: err}
+		}
+		select {
+		case s.responses <- decoded:
+		case <-ctx.Done():
+			return nil
+		}
+		if decoded.SyncResponse && s.mode == gnmi.SubscriptionList_ONCE {
+			return nil
+		}
+	}
+}
+
+// decodeSubscribeResponse converts a gnmi.SubscribeResponse into path/value pairs
+func decodeSubscribeResponse(rsp *gnmi.SubscribeResponse) (*SubscribeResponse, error) {
+	switch r := rsp.GetResponse().(type) {
+	case *gnmi.SubscribeResponse_SyncResponse:
+		return &SubscribeResponse{SyncResponse: r.SyncResponse}, nil
+	case *gnmi.SubscribeResponse_Update:
+		n := r.Update
+		decoded := &SubscribeResponse{
+			Timestamp: n.GetTimestamp(),
+			Prefix:    PathToString(n.GetPrefix()),
+			Updates:   make([]Update, 0, len(n.GetUpdate())),
+			Deletes:   make([]string, 0, len(n.GetDelete())),
+		}
+		for _, u := range n.GetUpdate() {
+			value, err := DecodeValue(u.GetVal())
+			if err != nil {
+				return nil, fmt.Errorf("failed decoding value of '%s': %v", PathToString(u.GetPath()), err)
+			}
+			decoded.Updates = append(decoded.Updates, Update{
+				Path:  joinPath(decoded.Prefix, PathToString(u.GetPath())),
+				Value: value,
+			})
+		}
+		for _, d := range n.GetDelete() {
+			decoded.Deletes = append(decoded.Deletes, joinPath(decoded.Prefix, PathToString(d)))
+		}
+		return decoded, nil
+	default:
+		return nil, fmt.Errorf("unexpected SubscribeResponse type %T", r)
+	}
+}
+
+// joinPath appends path to prefix
+func joinPath(prefix, path string) string {
+	if prefix == "" || prefix == "/" {
+		return path
+	}
+	if path == "/" {
+		return prefix
+	}
+	return prefix + path
+}
@@ -0,0 +1,91 @@
+package gnmic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failingSubscribeClient is a gnmi.GNMIClient whose subscriptions fail at once
+type failingSubscribeClient struct {
+	gnmi.GNMIClient
+	calls chan struct{}
+}
+
+func (c *failingSubscribeClient) Subscribe(ctx context.Context, opts ...grpc.CallOption) (gnmi.GNMI_SubscribeClient, error) {
+	c.calls <- struct{}{}
+	return nil, status.Error(codes.Unavailable, "connection refused")
+}
+
+func TestSubscribeEndsWhenClientClosed(t *testing.T) {
+	c := &failingSubscribeClient{calls: make(chan struct{}, 16)}
+	g := &GnmiClient{Encoding: "JSON_IETF", Timeout: time.Second, Client: c}
+	s, err := g.Subscribe(context.Background(), &SubscribeInput{
+		Paths:         []string{"/system/ntp"},
+		Mode:          "stream",
+		StreamMode:    "on_change",
+		RetryInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the stream is retried while the client is open
+	for i := 0; i < 2; i++ {
+		rsp := <-s.Responses()
+		if rsp.Err == nil || errors.Is(rsp.Err, ErrClientClosed) {
+			t.Fatalf("unexpected response %+v", rsp)
+		}
+	}
+	g.Close()
+
+	timeout := time.After(time.Second)
+	var closedErr bool
+	for {
+		select {
+		case rsp, ok := <-s.Responses():
+			if !ok {
+				if !closedErr {
+					t.Error("the subscription ended without reporting the closed client")
+				}
+				s.Close()
+				return
+			}
+			closedErr = errors.Is(rsp.Err, ErrClientClosed)
+		case <-timeout:
+			t.Fatal("the subscription kept retrying after the client was closed")
+		}
+	}
+}
+
+func TestSubscribeStopsWhenCanceled(t *testing.T) {
+	c := &failingSubscribeClient{calls: make(chan struct{}, 16)}
+	g := &GnmiClient{Encoding: "JSON_IETF", Timeout: time.Second, Client: c}
+	ctx, cancel := context.WithCancel(context.Background())
+	s, err := g.Subscribe(ctx, &SubscribeInput{
+		Paths:         []string{"/system/ntp"},
+		Mode:          "stream",
+		RetryInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-s.Responses()
+	cancel()
+	s.Close()
+	// drain the calls made before the cancel was seen, no call may follow
+	for len(c.calls) > 0 {
+		<-c.calls
+	}
+	select {
+	case <-c.calls:
+		t.Error("subscribed again after the subscription was canceled")
+	case <-time.After(20 * time.Millisecond):
+	}
+}
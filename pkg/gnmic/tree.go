@@ -0,0 +1,185 @@
+package gnmic
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// StripModulePrefix removes the JSON_IETF module prefix from name
+func StripModulePrefix(name string) string {
+	if i := strings.Index(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return name
+}
+
+// StripModulePrefixes removes the JSON_IETF module prefixes from the member names of v
+func StripModulePrefixes(v interface{}) interface{} {
+	switch x := v.(type) {
+	case map[string]interface{}:
+		nm := make(map[string]interface{}, len(x))
+		for k, v := range x {
+			nm[StripModulePrefix(k)] = StripModulePrefixes(v)
+		}
+		return nm
+	case []interface{}:
+		for i, v := range x {
+			x[i] = StripModulePrefixes(v)
+		}
+	}
+	return v
+}
+
+// SetTreeValue sets value at elems in the generic json document tree, creating the
+// containers and list entries along the way. Objects are merged into the existing
+// tree.
+func SetTreeValue(tree map[string]interface{}, elems []*gnmi.PathElem, value interface{}) {
+	if len(elems) == 0 {
+		if m, ok := value.(map[string]interface{}); ok {
+			mergeTree(tree, m)
+		}
+		return
+	}
+	node := treeNode(tree, elems[0], true)
+	if len(elems) == 1 && len(elems[0].GetKey()) == 0 {
+		if m, ok := value.(map[string]interface{}); ok {
+			mergeTree(node, m)
+			return
+		}
+		tree[StripModulePrefix(elems[0].GetName())] = value
+		return
+	}
+	SetTreeValue(node, elems[1:], value)
+}
+
+// DeleteTreeValue removes the node at elems from the generic json document tree
+func DeleteTreeValue(tree map[string]interface{}, elems []*gnmi.PathElem) {
+	if len(elems) == 0 {
+		for k := range tree {
+			delete(tree, k)
+		}
+		return
+	}
+	name := StripModulePrefix(elems[0].GetName())
+	if len(elems) == 1 {
+		if len(elems[0].GetKey()) == 0 {
+			delete(tree, name)
+			return
+		}
+		list, _ := tree[name].([]interface{})
+		for i, e := range list {
+			if m, ok := e.(map[string]interface{}); ok && matchesKeys(m, elems[0].GetKey()) {
+				tree[name] = append(list[:i], list[i+1:]...)
+				return
+			}
+		}
+		return
+	}
+	if node := treeNode(tree, elems[0], false); node != nil {
+		DeleteTreeValue(node, elems[1:])
+	}
+}
+
+// treeAt returns the node at elems in tree, nil when there is none. A list element
+// with wildcard keys selects all the matching entries, the nodes found below them
+// are returned as a list.
+func treeAt(tree interface{}, elems []*gnmi.PathElem) interface{} {
+	if len(elems) == 0 {
+		return tree
+	}
+	m, ok := tree.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	elem := elems[0]
+	v := m[StripModulePrefix(elem.GetName())]
+	if len(elem.GetKey()) == 0 {
+		return treeAt(v, elems[1:])
+	}
+	list, _ := v.([]interface{})
+	var found []interface{}
+	for _, e := range list {
+		entry, ok := e.(map[string]interface{})
+		if !ok || !matchesKeys(entry, elem.GetKey()) {
+			continue
+		}
+		if !hasWildcard(elem.GetKey()) {
+			return treeAt(entry, elems[1:])
+		}
+		if n := treeAt(entry, elems[1:]); n != nil {
+			found = append(found, n)
+		}
+	}
+	if len(found) == 0 {
+		return nil
+	}
+	return found
+}
+
+// treeNode returns the container or list entry elem refers to in tree, creating
+// it when create is set
+func treeNode(tree map[string]interface{}, elem *gnmi.PathElem, create bool) map[string]interface{} {
+	name := StripModulePrefix(elem.GetName())
+	if len(elem.GetKey()) == 0 {
+		node, ok := tree[name].(map[string]interface{})
+		if !ok && create {
+			node = make(map[string]interface{})
+			tree[name] = node
+		}
+		return node
+	}
+	list, _ := tree[name].([]interface{})
+	for _, e := range list {
+		if m, ok := e.(map[string]interface{}); ok && matchesKeys(m, elem.GetKey()) {
+			return m
+		}
+	}
+	if !create {
+		return nil
+	}
+	entry := make(map[string]interface{}, len(elem.GetKey()))
+	for k, v := range elem.GetKey() {
+		entry[k] = v
+	}
+	tree[name] = append(list, entry)
+	return entry
+}
+
+// mergeTree merges src into dst
+func mergeTree(dst, src map[string]interface{}) {
+	for k, v := range src {
+		if m, ok := v.(map[string]interface{}); ok {
+			if d, ok := dst[k].(map[string]interface{}); ok {
+				mergeTree(d, m)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
+
+// matchesKeys returns true if the list entry has the key values of a path
+// element, a wildcard matches any value
+func matchesKeys(entry map[string]interface{}, keys map[string]string) bool {
+	for k, v := range keys {
+		value, ok := entry[k]
+		if !ok {
+			return false
+		}
+		if v != "*" && fmt.Sprint(value) != v {
+			return false
+		}
+	}
+	return true
+}
+
+func hasWildcard(keys map[string]string) bool {
+	for _, v := range keys {
+		if v == "*" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package gnmic
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// DecodeValue converts a gnmi.TypedValue into a Go value. json and json_ietf
+// values are unmarshaled into their generic representation, decimal values are
+// returned as their exact decimal string, e.g. "-12.50", leaflist values as []interface{} of their decoded elements,
+// proto values as their serialized bytes and any values as *any.Any.
+func DecodeValue(value *gnmi.TypedValue) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch v := value.GetValue().(type) {
+	case *gnmi.TypedValue_StringVal:
+		return v.StringVal, nil
+	case *gnmi.TypedValue_AsciiVal:
+		return v.AsciiVal, nil
+	case *gnmi.TypedValue_IntVal:
+		return v.IntVal, nil
+	case *gnmi.TypedValue_UintVal:
+		return v.UintVal, nil
+	case *gnmi.TypedValue_BoolVal:
+		return v.BoolVal, nil
+	case *gnmi.TypedValue_FloatVal:
+		return v.FloatVal, nil
+	case *gnmi.TypedValue_BytesVal:
+		return v.BytesVal, nil
+	case *gnmi.TypedValue_DecimalVal:
+		return decimalString(v.DecimalVal), nil
+	case *gnmi.TypedValue_LeaflistVal:
+		elems := v.LeaflistVal.GetElement()
+		out := make([]interface{}, 0, len(elems))
+		for _, e := range elems {
+			ev, err := DecodeValue(e)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, ev)
+		}
+		return out, nil
+	case *gnmi.TypedValue_ProtoBytes:
+		return v.ProtoBytes, nil
+	case *gnmi.TypedValue_AnyVal:
+		return v.AnyVal, nil
+	case *gnmi.TypedValue_JsonVal:
+		return decodeJSON(v.JsonVal)
+	case *gnmi.TypedValue_JsonIetfVal:
+		return decodeJSON(v.JsonIetfVal)
+	default:
+		return nil, fmt.Errorf("value type %T not supported", v)
+	}
+}
+
+// decimalString returns d as a decimal number with Precision fraction digits, the
+// way JSON_IETF encodes decimal64 values. parseDecimal64 parses it back into d.
+func decimalString(d *gnmi.Decimal64) string {
+	digits := strconv.FormatInt(d.GetDigits(), 10)
+	sign := ""
+	if strings.HasPrefix(digits, "-") {
+		sign, digits = "-", digits[1:]
+	}
+	precision := int(d.GetPrecision())
+	if precision == 0 {
+		return sign + digits
+	}
+	if len(digits) <= precision {
+		digits = strings.Repeat("0", precision-len(digits)+1) + digits
+	}
+	return sign + digits[:len(digits)-precision] + "." + digits[len(digits)-precision:]
+}
+
+func decodeJSON(b []byte) (interface{}, error) {
+	var out interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
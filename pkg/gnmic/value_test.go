@@ -0,0 +1,255 @@
+package gnmic
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+func TestDecodeValue(t *testing.T) {
+	anyVal := &any.Any{TypeUrl: "type.googleapis.com/gnmi.Path", Value: []byte{0x0a, 0x03}}
+	tests := []struct {
+		name  string
+		value *gnmi.TypedValue
+		want  interface{}
+	}{
+		{name: "nil", value: nil, want: nil},
+		{name: "string", value: &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "enable"}}, want: "enable"},
+		{name: "ascii", value: &gnmi.TypedValue{Value: &gnmi.TypedValue_AsciiVal{AsciiVal: "up"}}, want: "up"},
+		{name: "int", value: &gnmi.TypedValue{Value: &gnmi.TypedValue_IntVal{IntVal: -3}}, want: int64(-3)},
+		{name: "uint", value: &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: math.MaxUint64}}, want: uint64(math.MaxUint64)},
+		{name: "bool", value: &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: true}}, want: true},
+		{name: "float", value: &gnmi.TypedValue{Value: &gnmi.TypedValue_FloatVal{FloatVal: 1.5}}, want: float32(1.5)},
+		{name: "bytes", value: &gnmi.TypedValue{Value: &gnmi.TypedValue_BytesVal{BytesVal: []byte("ab")}}, want: []byte("ab")},
+		{name: "decimal", value: &gnmi.TypedValue{Value: &gnmi.TypedValue_DecimalVal{DecimalVal: &gnmi.Decimal64{Digits: -1250, Precision: 2}}}, want: "-12.50"},
+		{name: "decimal below one", value: &gnmi.TypedValue{Value: &gnmi.TypedValue_DecimalVal{DecimalVal: &gnmi.Decimal64{Digits: 5, Precision: 3}}}, want: "0.005"},
+		{name: "decimal without fraction", value: &gnmi.TypedValue{Value: &gnmi.TypedValue_DecimalVal{DecimalVal: &gnmi.Decimal64{Digits: 42}}}, want: "42"},
+		{
+			name:  "decimal beyond float64 precision",
+			value: &gnmi.TypedValue{Value: &gnmi.TypedValue_DecimalVal{DecimalVal: &gnmi.Decimal64{Digits: math.MinInt64, Precision: 18}}},
+			want:  "-9.223372036854775808",
+		},
+		{
+			name: "leaflist",
+			value: &gnmi.TypedValue{Value: &gnmi.TypedValue_LeaflistVal{LeaflistVal: &gnmi.ScalarArray{Element: []*gnmi.TypedValue{
+				{Value: &gnmi.TypedValue_UintVal{UintVal: 46}},
+				{Value: &gnmi.TypedValue_StringVal{StringVal: "af11"}},
+			}}}},
+			want: []interface{}{uint64(46), "af11"},
+		},
+		{name: "proto", value: &gnmi.TypedValue{Value: &gnmi.TypedValue_ProtoBytes{ProtoBytes: []byte{0x0a, 0x03}}}, want: []byte{0x0a, 0x03}},
+		{name: "any", value: &gnmi.TypedValue{Value: &gnmi.TypedValue_AnyVal{AnyVal: anyVal}}, want: anyVal},
+		{name: "json", value: &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonVal{JsonVal: []byte(`{"mtu": 9000}`)}}, want: map[string]interface{}{"mtu": float64(9000)}},
+		{name: "json_ietf", value: jsonIetf(`["a", "b"]`), want: []interface{}{"a", "b"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DecodeValue(tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if a, ok := tt.want.(*any.Any); ok {
+				if !proto.Equal(got.(*any.Any), a) {
+					t.Errorf("DecodeValue() = %v, want %v", got, tt.want)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("DecodeValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDecimal64(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    *gnmi.Decimal64
+		wantErr bool
+	}{
+		{in: "12.50", want: &gnmi.Decimal64{Digits: 1250, Precision: 2}},
+		{in: "-12.50", want: &gnmi.Decimal64{Digits: -1250, Precision: 2}},
+		{in: " 0.005 ", want: &gnmi.Decimal64{Digits: 5, Precision: 3}},
+		{in: "-0.005", want: &gnmi.Decimal64{Digits: -5, Precision: 3}},
+		{in: "42", want: &gnmi.Decimal64{Digits: 42}},
+		{in: ".5", want: &gnmi.Decimal64{Digits: 5, Precision: 1}},
+		{in: "-9.223372036854775808", want: &gnmi.Decimal64{Digits: math.MinInt64, Precision: 18}},
+		{in: "0.0000000000000000001", wantErr: true},
+		{in: "1.-5", wantErr: true},
+		{in: "1.2.3", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "", wantErr: true},
+		{in: "99999999999999999999", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := parseDecimal64(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseDecimal64() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("parseDecimal64() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalRoundTrip(t *testing.T) {
+	for _, d := range []*gnmi.Decimal64{
+		{Digits: 0},
+		{Digits: 0, Precision: 3},
+		{Digits: 1250, Precision: 2},
+		{Digits: -1, Precision: 18},
+		{Digits: math.MaxInt64, Precision: 7},
+		{Digits: math.MinInt64, Precision: 18},
+	} {
+		s := decimalString(d)
+		got, err := parseDecimal64(s)
+		if err != nil {
+			t.Fatalf("parseDecimal64(%q): %v", s, err)
+		}
+		if !proto.Equal(got, d) {
+			t.Errorf("%v decoded to %q parsed back to %v", d, s, got)
+		}
+	}
+}
+
+func TestLeafListValue(t *testing.T) {
+	str := func(s string) *gnmi.TypedValue {
+		return &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: s}}
+	}
+	tests := []struct {
+		name    string
+		in      string
+		want    []*gnmi.TypedValue
+		wantErr bool
+	}{
+		{name: "comma separated", in: "a, b ,c", want: []*gnmi.TypedValue{str("a"), str("b"), str("c")}},
+		{
+			name: "json array",
+			in:   ` [1, -2, 18446744073709551615, 1.50, "x", true]`,
+			want: []*gnmi.TypedValue{
+				{Value: &gnmi.TypedValue_IntVal{IntVal: 1}},
+				{Value: &gnmi.TypedValue_IntVal{IntVal: -2}},
+				{Value: &gnmi.TypedValue_UintVal{UintVal: math.MaxUint64}},
+				{Value: &gnmi.TypedValue_DecimalVal{DecimalVal: &gnmi.Decimal64{Digits: 150, Precision: 2}}},
+				str("x"),
+				{Value: &gnmi.TypedValue_BoolVal{BoolVal: true}},
+			},
+		},
+		{name: "invalid json", in: "[1,", wantErr: true},
+		{name: "not a scalar", in: `[{"a": 1}]`, wantErr: true},
+		{name: "null", in: `[null]`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := leafListValue(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("leafListValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if !proto.Equal(&gnmi.ScalarArray{Element: got}, &gnmi.ScalarArray{Element: tt.want}) {
+				t.Errorf("leafListValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScalarValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      interface{}
+		want    *gnmi.TypedValue
+		wantErr bool
+	}{
+		{name: "string", in: "a", want: &gnmi.TypedValue{Value: &gnmi.TypedValue_StringVal{StringVal: "a"}}},
+		{name: "bool", in: false, want: &gnmi.TypedValue{Value: &gnmi.TypedValue_BoolVal{BoolVal: false}}},
+		{name: "int", in: json.Number("-7"), want: &gnmi.TypedValue{Value: &gnmi.TypedValue_IntVal{IntVal: -7}}},
+		{name: "uint", in: json.Number("9223372036854775808"), want: &gnmi.TypedValue{Value: &gnmi.TypedValue_UintVal{UintVal: 1 << 63}}},
+		{name: "decimal", in: json.Number("0.125"), want: &gnmi.TypedValue{Value: &gnmi.TypedValue_DecimalVal{DecimalVal: &gnmi.Decimal64{Digits: 125, Precision: 3}}}},
+		{name: "exponent", in: json.Number("1e3"), wantErr: true},
+		{name: "object", in: map[string]interface{}{}, wantErr: true},
+		{name: "float64", in: 1.5, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := scalarValue(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("scalarValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !proto.Equal(got, tt.want) {
+				t.Errorf("scalarValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONValue(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: `{"admin-state": "enable"}`, want: `{"admin-state": "enable"}`},
+		{in: ` 9000 `, want: `9000`},
+		{in: `"enable"`, want: `"enable"`},
+		{in: `enable`, want: `"enable"`},
+		{in: `ethernet-1/1`, want: `"ethernet-1/1"`},
+		{in: `{"a":`, want: `"{\"a\":"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := string(jsonValue(tt.in)); got != tt.want {
+				t.Errorf("jsonValue() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetValueProtoAndAny(t *testing.T) {
+	tests := []struct {
+		name    string
+		typ     string
+		val     string
+		want    *gnmi.TypedValue
+		wantErr bool
+	}{
+		{
+			name: "proto",
+			typ:  "proto",
+			val:  " CgM= ",
+			want: &gnmi.TypedValue{Value: &gnmi.TypedValue_ProtoBytes{ProtoBytes: []byte{0x0a, 0x03}}},
+		},
+		{name: "proto not base64", typ: "proto", val: "not base64!", wantErr: true},
+		{
+			name: "any",
+			typ:  "any",
+			val:  `{"type_url": "type.googleapis.com/gnmi.Path", "value": "CgM="}`,
+			want: &gnmi.TypedValue{Value: &gnmi.TypedValue_AnyVal{AnyVal: &any.Any{TypeUrl: "type.googleapis.com/gnmi.Path", Value: []byte{0x0a, 0x03}}}},
+		},
+		{name: "any without type_url", typ: "any", val: `{"value": "CgM="}`, wantErr: true},
+		{name: "any not json", typ: "any", val: `CgM=`, wantErr: true},
+		{name: "unknown type", typ: "yaml", val: `a: b`, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &gnmi.TypedValue{}
+			err := setValue(got, tt.typ, tt.val)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("setValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !proto.Equal(got, tt.want) {
+				t.Errorf("setValue() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
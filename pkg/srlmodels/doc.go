@@ -0,0 +1,8 @@
+// Package srlmodels holds the Go bindings of the SR Linux YANG models used by the
+// operator. The bindings are generated with ygot from the subset of the SR Linux
+// models in the yang directory, extend those models and run go generate to add
+// support for more of the device configuration.
+package srlmodels
+
+//go:generate go run github.com/openconfig/ygot/generator -path=yang -output_file=srlmodels.go -package_name=srlmodels -generate_fakeroot -fakeroot_name=device -generate_getters -generate_delete -generate_append -include_model_data yang/srl_nokia-common.yang yang/srl_nokia-system.yang yang/srl_nokia-ntp.yang yang/srl_nokia-interfaces.yang yang/srl_nokia-interfaces-vlans.yang yang/srl_nokia-network-instance.yang yang/srl_nokia-bgp.yang yang/srl_nokia-next-hop-groups.yang yang/srl_nokia-static-routes.yang yang/srl_nokia-ip-route-tables.yang yang/srl_nokia-acl.yang
+//go:generate gofmt -w srlmodels.go
@@ -0,0 +1,169 @@
+package srlmodels
+
+import (
+	"strconv"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// SystemPath returns the path of /system
+func SystemPath() *gnmi.Path {
+	return &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "system"}}}
+}
+
+// NtpPath returns the path of /system/ntp
+func NtpPath() *gnmi.Path {
+	p := SystemPath()
+	p.Elem = append(p.Elem, &gnmi.PathElem{Name: "ntp"})
+	return p
+}
+
+// NtpServerPath returns the path of /system/ntp/server[address=address]
+func NtpServerPath(address string) *gnmi.Path {
+	p := NtpPath()
+	p.Elem = append(p.Elem, &gnmi.PathElem{Name: "server", Key: map[string]string{"address": address}})
+	return p
+}
+
+// InterfacePath returns the path of /interface[name=name], followed by the
+// elements in elems
+func InterfacePath(name string, elems ...string) *gnmi.Path {
+	p := &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "interface", Key: map[string]string{"name": name}}}}
+	for _, e := range elems {
+		p.Elem = append(p.Elem, &gnmi.PathElem{Name: e})
+	}
+	return p
+}
+
+// SubinterfacePath returns the path of /interface[name=name]/subinterface[index=index],
+// followed by the elements in elems
+func SubinterfacePath(name string, index uint32, elems ...string) *gnmi.Path {
+	p := InterfacePath(name)
+	p.Elem = append(p.Elem, &gnmi.PathElem{Name: "subinterface", Key: map[string]string{"index": strconv.FormatUint(uint64(index), 10)}})
+	for _, e := range elems {
+		p.Elem = append(p.Elem, &gnmi.PathElem{Name: e})
+	}
+	return p
+}
+
+// SubinterfaceAddressPath returns the path of the address prefix of the ipv4 or
+// ipv6 container of a subinterface, e.g.
+// /interface[name=name]/subinterface[index=index]/ipv4/address[ip-prefix=prefix]
+func SubinterfaceAddressPath(name string, index uint32, afi, prefix string) *gnmi.Path {
+	p := SubinterfacePath(name, index, afi)
+	p.Elem = append(p.Elem, &gnmi.PathElem{Name: "address", Key: map[string]string{"ip-prefix": prefix}})
+	return p
+}
+
+// NetworkInstancePath returns the path of /network-instance[name=name], followed by
+// the elements in elems
+func NetworkInstancePath(name string, elems ...string) *gnmi.Path {
+	p := &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "network-instance", Key: map[string]string{"name": name}}}}
+	for _, e := range elems {
+		p.Elem = append(p.Elem, &gnmi.PathElem{Name: e})
+	}
+	return p
+}
+
+// NetworkInstanceInterfacePath returns the path of the subinterface named iface in
+// the network instance named name
+func NetworkInstanceInterfacePath(name, iface string) *gnmi.Path {
+	p := NetworkInstancePath(name)
+	p.Elem = append(p.Elem, &gnmi.PathElem{Name: "interface", Key: map[string]string{"name": iface}})
+	return p
+}
+
+// BgpPath returns the path of the bgp container of the network instance named
+// name, followed by the elements in elems
+func BgpPath(name string, elems ...string) *gnmi.Path {
+	p := NetworkInstancePath(name, "protocols", "bgp")
+	for _, e := range elems {
+		p.Elem = append(p.Elem, &gnmi.PathElem{Name: e})
+	}
+	return p
+}
+
+// BgpGroupPath returns the path of the bgp peer group named group in the network
+// instance named name
+func BgpGroupPath(name, group string) *gnmi.Path {
+	p := BgpPath(name)
+	p.Elem = append(p.Elem, &gnmi.PathElem{Name: "group", Key: map[string]string{"group-name": group}})
+	return p
+}
+
+// BgpNeighborPath returns the path of the bgp neighbor with address peer in the
+// network instance named name
+func BgpNeighborPath(name, peer string) *gnmi.Path {
+	p := BgpPath(name)
+	p.Elem = append(p.Elem, &gnmi.PathElem{Name: "neighbor", Key: map[string]string{"peer-address": peer}})
+	return p
+}
+
+// NextHopGroupPath returns the path of the next-hop group named group in the
+// network instance named name
+func NextHopGroupPath(name, group string) *gnmi.Path {
+	p := NetworkInstancePath(name, "next-hop-groups")
+	p.Elem = append(p.Elem, &gnmi.PathElem{Name: "group", Key: map[string]string{"name": group}})
+	return p
+}
+
+// NextHopPath returns the path of the next-hop with index index of the next-hop
+// group named group in the network instance named name
+func NextHopPath(name, group string, index uint16) *gnmi.Path {
+	p := NextHopGroupPath(name, group)
+	p.Elem = append(p.Elem, &gnmi.PathElem{Name: "nexthop", Key: map[string]string{"index": strconv.FormatUint(uint64(index), 10)}})
+	return p
+}
+
+// StaticRoutePath returns the path of the static route to prefix in the network
+// instance named name, followed by the elements in elems
+func StaticRoutePath(name, prefix string, elems ...string) *gnmi.Path {
+	p := NetworkInstancePath(name, "static-routes")
+	p.Elem = append(p.Elem, &gnmi.PathElem{Name: "route", Key: map[string]string{"prefix": prefix}})
+	for _, e := range elems {
+		p.Elem = append(p.Elem, &gnmi.PathElem{Name: e})
+	}
+	return p
+}
+
+// RouteTablePath returns the path of the entries for prefix in the ipv4 or ipv6
+// route table of the network instance named name, e.g.
+// /network-instance[name=name]/route-table/ipv4-unicast/route[ipv4-prefix=prefix][id=*][route-type=*][route-owner=*]
+func RouteTablePath(name, afi, prefix string) *gnmi.Path {
+	p := NetworkInstancePath(name, "route-table", afi+"-unicast")
+	p.Elem = append(p.Elem, &gnmi.PathElem{Name: "route", Key: map[string]string{
+		afi + "-prefix": prefix,
+		"id":            "*",
+		"route-type":    "*",
+		"route-owner":   "*",
+	}})
+	return p
+}
+
+// AclFilterPath returns the path of the ipv4, ipv6 or mac filter named name, e.g.
+// /acl/ipv4-filter[name=name], followed by the elements in elems
+func AclFilterPath(afi, name string, elems ...string) *gnmi.Path {
+	p := &gnmi.Path{Elem: []*gnmi.PathElem{{Name: "acl"}, {Name: afi + "-filter", Key: map[string]string{"name": name}}}}
+	for _, e := range elems {
+		p.Elem = append(p.Elem, &gnmi.PathElem{Name: e})
+	}
+	return p
+}
+
+// AclEntryPath returns the path of the entry with sequence id seq of the ipv4, ipv6
+// or mac filter named name, followed by the elements in elems
+func AclEntryPath(afi, name string, seq uint32, elems ...string) *gnmi.Path {
+	p := AclFilterPath(afi, name)
+	p.Elem = append(p.Elem, &gnmi.PathElem{Name: "entry", Key: map[string]string{"sequence-id": strconv.FormatUint(uint64(seq), 10)}})
+	for _, e := range elems {
+		p.Elem = append(p.Elem, &gnmi.PathElem{Name: e})
+	}
+	return p
+}
+
+// SubinterfaceAclPath returns the path of the ipv4, ipv6 or mac filter bound to a
+// subinterface in the input or output direction, e.g.
+// /interface[name=name]/subinterface[index=index]/acl/input/ipv4-filter
+func SubinterfaceAclPath(name string, index uint32, direction, afi string) *gnmi.Path {
+	return SubinterfacePath(name, index, "acl", direction, afi+"-filter")
+}
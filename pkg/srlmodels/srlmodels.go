@@ -0,0 +1,7499 @@
+/*
+Package srlmodels is a generated package which contains definitions
+of structs which represent a YANG schema. The generated schema can be
+compressed by a series of transformations (compression was false
+in this case).
+
+This package was generated by github.com/openconfig/ygot/generator
+using the following YANG input files:
+  - yang/srl_nokia-common.yang
+  - yang/srl_nokia-system.yang
+  - yang/srl_nokia-ntp.yang
+  - yang/srl_nokia-interfaces.yang
+  - yang/srl_nokia-interfaces-vlans.yang
+  - yang/srl_nokia-network-instance.yang
+  - yang/srl_nokia-bgp.yang
+  - yang/srl_nokia-next-hop-groups.yang
+  - yang/srl_nokia-static-routes.yang
+  - yang/srl_nokia-ip-route-tables.yang
+  - yang/srl_nokia-acl.yang
+
+Imported modules were sourced from:
+  - yang/...
+*/
+package srlmodels
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+)
+
+// Binary is a type that is used for fields that have a YANG type of
+// binary. It is used such that binary fields can be distinguished from
+// leaf-lists of uint8s (which are mapped to []uint8, equivalent to
+// []byte in reflection).
+type Binary []byte
+
+// YANGEmpty is a type that is used for fields that have a YANG type of
+// empty. It is used such that empty fields can be distinguished from boolean fields
+// in the generated code.
+type YANGEmpty bool
+
+var (
+	SchemaTree map[string]*yang.Entry
+)
+
+func init() {
+	var err error
+	if SchemaTree, err = UnzipSchema(); err != nil {
+		panic("schema error: " + err.Error())
+	}
+}
+
+// Schema returns the details of the generated schema.
+func Schema() (*ytypes.Schema, error) {
+	uzp, err := UnzipSchema()
+	if err != nil {
+		return nil, fmt.Errorf("cannot unzip schema, %v", err)
+	}
+
+	return &ytypes.Schema{
+		Root:       &Device{},
+		SchemaTree: uzp,
+		Unmarshal:  Unmarshal,
+	}, nil
+}
+
+// UnzipSchema unzips the zipped schema and returns a map of yang.Entry nodes,
+// keyed by the name of the struct that the yang.Entry describes the schema for.
+func UnzipSchema() (map[string]*yang.Entry, error) {
+	var schemaTree map[string]*yang.Entry
+	var err error
+	if schemaTree, err = ygot.GzipToSchema(ySchema); err != nil {
+		return nil, fmt.Errorf("could not unzip the schema; %v", err)
+	}
+	return schemaTree, nil
+}
+
+// Unmarshal unmarshals data, which must be RFC7951 JSON format, into
+// destStruct, which must be non-nil and the correct GoStruct type. It returns
+// an error if the destStruct is not found in the schema or the data cannot be
+// unmarshaled. The supplied options (opts) are used to control the behaviour
+// of the unmarshal function - for example, determining whether errors are
+// thrown for unknown fields in the input JSON.
+func Unmarshal(data []byte, destStruct ygot.GoStruct, opts ...ytypes.UnmarshalOpt) error {
+	tn := reflect.TypeOf(destStruct).Elem().Name()
+	schema, ok := SchemaTree[tn]
+	if !ok {
+		return fmt.Errorf("could not find schema for type %s", tn)
+	}
+	var jsonTree interface{}
+	if err := json.Unmarshal([]byte(data), &jsonTree); err != nil {
+		return err
+	}
+	return ytypes.Unmarshal(schema, destStruct, jsonTree, opts...)
+}
+
+// ΓModelData contains the catalogue information corresponding to the modules for
+// which Go code was generated.
+var ΓModelData = []*gpb.ModelData{
+	{
+		Name: "srl_nokia-acl",
+	},
+	{
+		Name: "srl_nokia-bgp",
+	},
+	{
+		Name: "srl_nokia-common",
+	},
+	{
+		Name: "srl_nokia-interfaces",
+	},
+	{
+		Name: "srl_nokia-interfaces-vlans",
+	},
+	{
+		Name: "srl_nokia-ip-route-tables",
+	},
+	{
+		Name: "srl_nokia-network-instance",
+	},
+	{
+		Name: "srl_nokia-next-hop-groups",
+	},
+	{
+		Name: "srl_nokia-ntp",
+	},
+	{
+		Name: "srl_nokia-static-routes",
+	},
+	{
+		Name: "srl_nokia-system",
+	},
+}
+
+// Device represents the /device YANG schema element.
+type Device struct {
+	Acl             *SrlNokiaAcl_Acl                                    `path:"acl" module:"srl_nokia-acl"`
+	Interface       map[string]*SrlNokiaInterfaces_Interface            `path:"interface" module:"srl_nokia-interfaces"`
+	NetworkInstance map[string]*SrlNokiaNetworkInstance_NetworkInstance `path:"network-instance" module:"srl_nokia-network-instance"`
+	System          *SrlNokiaSystem_System                              `path:"system" module:"srl_nokia-system"`
+}
+
+// IsYANGGoStruct ensures that Device implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Device) IsYANGGoStruct() {}
+
+// NewInterface creates a new entry in the Interface list of the
+// Device struct. The keys of the list are populated from the input
+// arguments.
+func (t *Device) NewInterface(Name string) (*SrlNokiaInterfaces_Interface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*SrlNokiaInterfaces_Interface)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Interface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Interface", key)
+	}
+
+	t.Interface[key] = &SrlNokiaInterfaces_Interface{
+		Name: &Name,
+	}
+
+	return t.Interface[key], nil
+}
+
+// GetOrCreateInterface retrieves the value with the specified keys from
+// the receiver Device. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Device) GetOrCreateInterface(Name string) *SrlNokiaInterfaces_Interface {
+
+	key := Name
+
+	if v, ok := t.Interface[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewInterface(Name)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateInterface got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetInterface retrieves the value with the specified key from
+// the Interface map field of Device. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Device) GetInterface(Name string) *SrlNokiaInterfaces_Interface {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Name
+
+	if lm, ok := t.Interface[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteInterface deletes the value with the specified keys from
+// the receiver Device. If there is no such element, the function
+// is a no-op.
+func (t *Device) DeleteInterface(Name string) {
+	key := Name
+
+	delete(t.Interface, key)
+}
+
+// AppendInterface appends the supplied SrlNokiaInterfaces_Interface struct to the
+// list Interface of Device. If the key value(s) specified in
+// the supplied SrlNokiaInterfaces_Interface already exist in the list, an error is
+// returned.
+func (t *Device) AppendInterface(v *SrlNokiaInterfaces_Interface) error {
+	if v.Name == nil {
+		return fmt.Errorf("invalid nil key received for Name")
+	}
+
+	key := *v.Name
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*SrlNokiaInterfaces_Interface)
+	}
+
+	if _, ok := t.Interface[key]; ok {
+		return fmt.Errorf("duplicate key for list Interface %v", key)
+	}
+
+	t.Interface[key] = v
+	return nil
+}
+
+// NewNetworkInstance creates a new entry in the NetworkInstance list of the
+// Device struct. The keys of the list are populated from the input
+// arguments.
+func (t *Device) NewNetworkInstance(Name string) (*SrlNokiaNetworkInstance_NetworkInstance, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.NetworkInstance == nil {
+		t.NetworkInstance = make(map[string]*SrlNokiaNetworkInstance_NetworkInstance)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.NetworkInstance[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list NetworkInstance", key)
+	}
+
+	t.NetworkInstance[key] = &SrlNokiaNetworkInstance_NetworkInstance{
+		Name: &Name,
+	}
+
+	return t.NetworkInstance[key], nil
+}
+
+// GetOrCreateNetworkInstance retrieves the value with the specified keys from
+// the receiver Device. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *Device) GetOrCreateNetworkInstance(Name string) *SrlNokiaNetworkInstance_NetworkInstance {
+
+	key := Name
+
+	if v, ok := t.NetworkInstance[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewNetworkInstance(Name)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateNetworkInstance got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetNetworkInstance retrieves the value with the specified key from
+// the NetworkInstance map field of Device. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *Device) GetNetworkInstance(Name string) *SrlNokiaNetworkInstance_NetworkInstance {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Name
+
+	if lm, ok := t.NetworkInstance[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteNetworkInstance deletes the value with the specified keys from
+// the receiver Device. If there is no such element, the function
+// is a no-op.
+func (t *Device) DeleteNetworkInstance(Name string) {
+	key := Name
+
+	delete(t.NetworkInstance, key)
+}
+
+// AppendNetworkInstance appends the supplied SrlNokiaNetworkInstance_NetworkInstance struct to the
+// list NetworkInstance of Device. If the key value(s) specified in
+// the supplied SrlNokiaNetworkInstance_NetworkInstance already exist in the list, an error is
+// returned.
+func (t *Device) AppendNetworkInstance(v *SrlNokiaNetworkInstance_NetworkInstance) error {
+	if v.Name == nil {
+		return fmt.Errorf("invalid nil key received for Name")
+	}
+
+	key := *v.Name
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.NetworkInstance == nil {
+		t.NetworkInstance = make(map[string]*SrlNokiaNetworkInstance_NetworkInstance)
+	}
+
+	if _, ok := t.NetworkInstance[key]; ok {
+		return fmt.Errorf("duplicate key for list NetworkInstance %v", key)
+	}
+
+	t.NetworkInstance[key] = v
+	return nil
+}
+
+// GetOrCreateAcl retrieves the value of the Acl field
+// or returns the existing field if it already exists.
+func (t *Device) GetOrCreateAcl() *SrlNokiaAcl_Acl {
+	if t.Acl != nil {
+		return t.Acl
+	}
+	t.Acl = &SrlNokiaAcl_Acl{}
+	return t.Acl
+}
+
+// GetOrCreateSystem retrieves the value of the System field
+// or returns the existing field if it already exists.
+func (t *Device) GetOrCreateSystem() *SrlNokiaSystem_System {
+	if t.System != nil {
+		return t.System
+	}
+	t.System = &SrlNokiaSystem_System{}
+	return t.System
+}
+
+// GetAcl returns the value of the Acl struct pointer
+// from Device. If the receiver or the field Acl is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Device) GetAcl() *SrlNokiaAcl_Acl {
+	if t != nil && t.Acl != nil {
+		return t.Acl
+	}
+	return nil
+}
+
+// GetSystem returns the value of the System struct pointer
+// from Device. If the receiver or the field System is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *Device) GetSystem() *SrlNokiaSystem_System {
+	if t != nil && t.System != nil {
+		return t.System
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Device) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Device"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Device) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// SrlNokiaAcl_Acl represents the /srl_nokia-acl/acl YANG schema element.
+type SrlNokiaAcl_Acl struct {
+	Ipv4Filter map[string]*SrlNokiaAcl_Acl_Ipv4Filter `path:"ipv4-filter" module:"srl_nokia-acl"`
+	Ipv6Filter map[string]*SrlNokiaAcl_Acl_Ipv6Filter `path:"ipv6-filter" module:"srl_nokia-acl"`
+	MacFilter  map[string]*SrlNokiaAcl_Acl_MacFilter  `path:"mac-filter" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl) IsYANGGoStruct() {}
+
+// NewIpv4Filter creates a new entry in the Ipv4Filter list of the
+// SrlNokiaAcl_Acl struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaAcl_Acl) NewIpv4Filter(Name string) (*SrlNokiaAcl_Acl_Ipv4Filter, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Ipv4Filter == nil {
+		t.Ipv4Filter = make(map[string]*SrlNokiaAcl_Acl_Ipv4Filter)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Ipv4Filter[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Ipv4Filter", key)
+	}
+
+	t.Ipv4Filter[key] = &SrlNokiaAcl_Acl_Ipv4Filter{
+		Name: &Name,
+	}
+
+	return t.Ipv4Filter[key], nil
+}
+
+// GetOrCreateIpv4Filter retrieves the value with the specified keys from
+// the receiver SrlNokiaAcl_Acl. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaAcl_Acl) GetOrCreateIpv4Filter(Name string) *SrlNokiaAcl_Acl_Ipv4Filter {
+
+	key := Name
+
+	if v, ok := t.Ipv4Filter[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewIpv4Filter(Name)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateIpv4Filter got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetIpv4Filter retrieves the value with the specified key from
+// the Ipv4Filter map field of SrlNokiaAcl_Acl. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaAcl_Acl) GetIpv4Filter(Name string) *SrlNokiaAcl_Acl_Ipv4Filter {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Name
+
+	if lm, ok := t.Ipv4Filter[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteIpv4Filter deletes the value with the specified keys from
+// the receiver SrlNokiaAcl_Acl. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaAcl_Acl) DeleteIpv4Filter(Name string) {
+	key := Name
+
+	delete(t.Ipv4Filter, key)
+}
+
+// AppendIpv4Filter appends the supplied SrlNokiaAcl_Acl_Ipv4Filter struct to the
+// list Ipv4Filter of SrlNokiaAcl_Acl. If the key value(s) specified in
+// the supplied SrlNokiaAcl_Acl_Ipv4Filter already exist in the list, an error is
+// returned.
+func (t *SrlNokiaAcl_Acl) AppendIpv4Filter(v *SrlNokiaAcl_Acl_Ipv4Filter) error {
+	if v.Name == nil {
+		return fmt.Errorf("invalid nil key received for Name")
+	}
+
+	key := *v.Name
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Ipv4Filter == nil {
+		t.Ipv4Filter = make(map[string]*SrlNokiaAcl_Acl_Ipv4Filter)
+	}
+
+	if _, ok := t.Ipv4Filter[key]; ok {
+		return fmt.Errorf("duplicate key for list Ipv4Filter %v", key)
+	}
+
+	t.Ipv4Filter[key] = v
+	return nil
+}
+
+// NewIpv6Filter creates a new entry in the Ipv6Filter list of the
+// SrlNokiaAcl_Acl struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaAcl_Acl) NewIpv6Filter(Name string) (*SrlNokiaAcl_Acl_Ipv6Filter, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Ipv6Filter == nil {
+		t.Ipv6Filter = make(map[string]*SrlNokiaAcl_Acl_Ipv6Filter)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Ipv6Filter[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Ipv6Filter", key)
+	}
+
+	t.Ipv6Filter[key] = &SrlNokiaAcl_Acl_Ipv6Filter{
+		Name: &Name,
+	}
+
+	return t.Ipv6Filter[key], nil
+}
+
+// GetOrCreateIpv6Filter retrieves the value with the specified keys from
+// the receiver SrlNokiaAcl_Acl. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaAcl_Acl) GetOrCreateIpv6Filter(Name string) *SrlNokiaAcl_Acl_Ipv6Filter {
+
+	key := Name
+
+	if v, ok := t.Ipv6Filter[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewIpv6Filter(Name)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateIpv6Filter got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetIpv6Filter retrieves the value with the specified key from
+// the Ipv6Filter map field of SrlNokiaAcl_Acl. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaAcl_Acl) GetIpv6Filter(Name string) *SrlNokiaAcl_Acl_Ipv6Filter {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Name
+
+	if lm, ok := t.Ipv6Filter[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteIpv6Filter deletes the value with the specified keys from
+// the receiver SrlNokiaAcl_Acl. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaAcl_Acl) DeleteIpv6Filter(Name string) {
+	key := Name
+
+	delete(t.Ipv6Filter, key)
+}
+
+// AppendIpv6Filter appends the supplied SrlNokiaAcl_Acl_Ipv6Filter struct to the
+// list Ipv6Filter of SrlNokiaAcl_Acl. If the key value(s) specified in
+// the supplied SrlNokiaAcl_Acl_Ipv6Filter already exist in the list, an error is
+// returned.
+func (t *SrlNokiaAcl_Acl) AppendIpv6Filter(v *SrlNokiaAcl_Acl_Ipv6Filter) error {
+	if v.Name == nil {
+		return fmt.Errorf("invalid nil key received for Name")
+	}
+
+	key := *v.Name
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Ipv6Filter == nil {
+		t.Ipv6Filter = make(map[string]*SrlNokiaAcl_Acl_Ipv6Filter)
+	}
+
+	if _, ok := t.Ipv6Filter[key]; ok {
+		return fmt.Errorf("duplicate key for list Ipv6Filter %v", key)
+	}
+
+	t.Ipv6Filter[key] = v
+	return nil
+}
+
+// NewMacFilter creates a new entry in the MacFilter list of the
+// SrlNokiaAcl_Acl struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaAcl_Acl) NewMacFilter(Name string) (*SrlNokiaAcl_Acl_MacFilter, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.MacFilter == nil {
+		t.MacFilter = make(map[string]*SrlNokiaAcl_Acl_MacFilter)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.MacFilter[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list MacFilter", key)
+	}
+
+	t.MacFilter[key] = &SrlNokiaAcl_Acl_MacFilter{
+		Name: &Name,
+	}
+
+	return t.MacFilter[key], nil
+}
+
+// GetOrCreateMacFilter retrieves the value with the specified keys from
+// the receiver SrlNokiaAcl_Acl. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaAcl_Acl) GetOrCreateMacFilter(Name string) *SrlNokiaAcl_Acl_MacFilter {
+
+	key := Name
+
+	if v, ok := t.MacFilter[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewMacFilter(Name)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateMacFilter got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetMacFilter retrieves the value with the specified key from
+// the MacFilter map field of SrlNokiaAcl_Acl. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaAcl_Acl) GetMacFilter(Name string) *SrlNokiaAcl_Acl_MacFilter {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Name
+
+	if lm, ok := t.MacFilter[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteMacFilter deletes the value with the specified keys from
+// the receiver SrlNokiaAcl_Acl. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaAcl_Acl) DeleteMacFilter(Name string) {
+	key := Name
+
+	delete(t.MacFilter, key)
+}
+
+// AppendMacFilter appends the supplied SrlNokiaAcl_Acl_MacFilter struct to the
+// list MacFilter of SrlNokiaAcl_Acl. If the key value(s) specified in
+// the supplied SrlNokiaAcl_Acl_MacFilter already exist in the list, an error is
+// returned.
+func (t *SrlNokiaAcl_Acl) AppendMacFilter(v *SrlNokiaAcl_Acl_MacFilter) error {
+	if v.Name == nil {
+		return fmt.Errorf("invalid nil key received for Name")
+	}
+
+	key := *v.Name
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.MacFilter == nil {
+		t.MacFilter = make(map[string]*SrlNokiaAcl_Acl_MacFilter)
+	}
+
+	if _, ok := t.MacFilter[key]; ok {
+		return fmt.Errorf("duplicate key for list MacFilter %v", key)
+	}
+
+	t.MacFilter[key] = v
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// SrlNokiaAcl_Acl_Ipv4Filter represents the /srl_nokia-acl/acl/ipv4-filter YANG schema element.
+type SrlNokiaAcl_Acl_Ipv4Filter struct {
+	Description *string                                      `path:"description" module:"srl_nokia-acl"`
+	Entry       map[uint32]*SrlNokiaAcl_Acl_Ipv4Filter_Entry `path:"entry" module:"srl_nokia-acl"`
+	Name        *string                                      `path:"name" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv4Filter implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv4Filter) IsYANGGoStruct() {}
+
+// NewEntry creates a new entry in the Entry list of the
+// SrlNokiaAcl_Acl_Ipv4Filter struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter) NewEntry(SequenceId uint32) (*SrlNokiaAcl_Acl_Ipv4Filter_Entry, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Entry == nil {
+		t.Entry = make(map[uint32]*SrlNokiaAcl_Acl_Ipv4Filter_Entry)
+	}
+
+	key := SequenceId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Entry[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Entry", key)
+	}
+
+	t.Entry[key] = &SrlNokiaAcl_Acl_Ipv4Filter_Entry{
+		SequenceId: &SequenceId,
+	}
+
+	return t.Entry[key], nil
+}
+
+// GetOrCreateEntry retrieves the value with the specified keys from
+// the receiver SrlNokiaAcl_Acl_Ipv4Filter. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter) GetOrCreateEntry(SequenceId uint32) *SrlNokiaAcl_Acl_Ipv4Filter_Entry {
+
+	key := SequenceId
+
+	if v, ok := t.Entry[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewEntry(SequenceId)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateEntry got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetEntry retrieves the value with the specified key from
+// the Entry map field of SrlNokiaAcl_Acl_Ipv4Filter. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter) GetEntry(SequenceId uint32) *SrlNokiaAcl_Acl_Ipv4Filter_Entry {
+
+	if t == nil {
+		return nil
+	}
+
+	key := SequenceId
+
+	if lm, ok := t.Entry[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteEntry deletes the value with the specified keys from
+// the receiver SrlNokiaAcl_Acl_Ipv4Filter. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter) DeleteEntry(SequenceId uint32) {
+	key := SequenceId
+
+	delete(t.Entry, key)
+}
+
+// AppendEntry appends the supplied SrlNokiaAcl_Acl_Ipv4Filter_Entry struct to the
+// list Entry of SrlNokiaAcl_Acl_Ipv4Filter. If the key value(s) specified in
+// the supplied SrlNokiaAcl_Acl_Ipv4Filter_Entry already exist in the list, an error is
+// returned.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter) AppendEntry(v *SrlNokiaAcl_Acl_Ipv4Filter_Entry) error {
+	if v.SequenceId == nil {
+		return fmt.Errorf("invalid nil key received for SequenceId")
+	}
+
+	key := *v.SequenceId
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Entry == nil {
+		t.Entry = make(map[uint32]*SrlNokiaAcl_Acl_Ipv4Filter_Entry)
+	}
+
+	if _, ok := t.Entry[key]; ok {
+		return fmt.Errorf("duplicate key for list Entry %v", key)
+	}
+
+	t.Entry[key] = v
+	return nil
+}
+
+// ΛListKeyMap returns the keys of the SrlNokiaAcl_Acl_Ipv4Filter struct, which is a YANG list entry.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv4Filter"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry represents the /srl_nokia-acl/acl/ipv4-filter/entry YANG schema element.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry struct {
+	Action      *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action     `path:"action" module:"srl_nokia-acl"`
+	Description *string                                      `path:"description" module:"srl_nokia-acl"`
+	Match       *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match      `path:"match" module:"srl_nokia-acl"`
+	SequenceId  *uint32                                      `path:"sequence-id" module:"srl_nokia-acl"`
+	Statistics  *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Statistics `path:"statistics" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry) IsYANGGoStruct() {}
+
+// GetOrCreateAction retrieves the value of the Action field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry) GetOrCreateAction() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action {
+	if t.Action != nil {
+		return t.Action
+	}
+	t.Action = &SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action{}
+	return t.Action
+}
+
+// GetOrCreateMatch retrieves the value of the Match field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry) GetOrCreateMatch() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match {
+	if t.Match != nil {
+		return t.Match
+	}
+	t.Match = &SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match{}
+	return t.Match
+}
+
+// GetOrCreateStatistics retrieves the value of the Statistics field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry) GetOrCreateStatistics() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Statistics {
+	if t.Statistics != nil {
+		return t.Statistics
+	}
+	t.Statistics = &SrlNokiaAcl_Acl_Ipv4Filter_Entry_Statistics{}
+	return t.Statistics
+}
+
+// GetAction returns the value of the Action struct pointer
+// from SrlNokiaAcl_Acl_Ipv4Filter_Entry. If the receiver or the field Action is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry) GetAction() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action {
+	if t != nil && t.Action != nil {
+		return t.Action
+	}
+	return nil
+}
+
+// GetMatch returns the value of the Match struct pointer
+// from SrlNokiaAcl_Acl_Ipv4Filter_Entry. If the receiver or the field Match is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry) GetMatch() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match {
+	if t != nil && t.Match != nil {
+		return t.Match
+	}
+	return nil
+}
+
+// GetStatistics returns the value of the Statistics struct pointer
+// from SrlNokiaAcl_Acl_Ipv4Filter_Entry. If the receiver or the field Statistics is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry) GetStatistics() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Statistics {
+	if t != nil && t.Statistics != nil {
+		return t.Statistics
+	}
+	return nil
+}
+
+// ΛListKeyMap returns the keys of the SrlNokiaAcl_Acl_Ipv4Filter_Entry struct, which is a YANG list entry.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.SequenceId == nil {
+		return nil, fmt.Errorf("nil value for key SequenceId")
+	}
+
+	return map[string]interface{}{
+		"sequence-id": *t.SequenceId,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv4Filter_Entry"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action represents the /srl_nokia-acl/acl/ipv4-filter/entry/action YANG schema element.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action struct {
+	Accept *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Accept `path:"accept" module:"srl_nokia-acl"`
+	Drop   *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Drop   `path:"drop" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action) IsYANGGoStruct() {}
+
+// GetOrCreateAccept retrieves the value of the Accept field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action) GetOrCreateAccept() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Accept {
+	if t.Accept != nil {
+		return t.Accept
+	}
+	t.Accept = &SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Accept{}
+	return t.Accept
+}
+
+// GetOrCreateDrop retrieves the value of the Drop field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action) GetOrCreateDrop() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Drop {
+	if t.Drop != nil {
+		return t.Drop
+	}
+	t.Drop = &SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Drop{}
+	return t.Drop
+}
+
+// GetAccept returns the value of the Accept struct pointer
+// from SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action. If the receiver or the field Accept is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action) GetAccept() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Accept {
+	if t != nil && t.Accept != nil {
+		return t.Accept
+	}
+	return nil
+}
+
+// GetDrop returns the value of the Drop struct pointer
+// from SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action. If the receiver or the field Drop is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action) GetDrop() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Drop {
+	if t != nil && t.Drop != nil {
+		return t.Drop
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Accept represents the /srl_nokia-acl/acl/ipv4-filter/entry/action/accept YANG schema element.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Accept struct {
+	Log *bool `path:"log" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Accept implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Accept) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Accept) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Accept"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Accept) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Drop represents the /srl_nokia-acl/acl/ipv4-filter/entry/action/drop YANG schema element.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Drop struct {
+	Log *bool `path:"log" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Drop implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Drop) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Drop) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Drop"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Action_Drop) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match represents the /srl_nokia-acl/acl/ipv4-filter/entry/match YANG schema element.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match struct {
+	DestinationIp   *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationIp   `path:"destination-ip" module:"srl_nokia-acl"`
+	DestinationPort *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort `path:"destination-port" module:"srl_nokia-acl"`
+	DscpSet         []uint8                                                 `path:"dscp-set" module:"srl_nokia-acl"`
+	Protocol        SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union   `path:"protocol" module:"srl_nokia-acl"`
+	SourceIp        *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourceIp        `path:"source-ip" module:"srl_nokia-acl"`
+	SourcePort      *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort      `path:"source-port" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match) IsYANGGoStruct() {}
+
+// GetOrCreateDestinationIp retrieves the value of the DestinationIp field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match) GetOrCreateDestinationIp() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationIp {
+	if t.DestinationIp != nil {
+		return t.DestinationIp
+	}
+	t.DestinationIp = &SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationIp{}
+	return t.DestinationIp
+}
+
+// GetOrCreateDestinationPort retrieves the value of the DestinationPort field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match) GetOrCreateDestinationPort() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort {
+	if t.DestinationPort != nil {
+		return t.DestinationPort
+	}
+	t.DestinationPort = &SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort{}
+	return t.DestinationPort
+}
+
+// GetOrCreateSourceIp retrieves the value of the SourceIp field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match) GetOrCreateSourceIp() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourceIp {
+	if t.SourceIp != nil {
+		return t.SourceIp
+	}
+	t.SourceIp = &SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourceIp{}
+	return t.SourceIp
+}
+
+// GetOrCreateSourcePort retrieves the value of the SourcePort field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match) GetOrCreateSourcePort() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort {
+	if t.SourcePort != nil {
+		return t.SourcePort
+	}
+	t.SourcePort = &SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort{}
+	return t.SourcePort
+}
+
+// GetDestinationIp returns the value of the DestinationIp struct pointer
+// from SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match. If the receiver or the field DestinationIp is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match) GetDestinationIp() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationIp {
+	if t != nil && t.DestinationIp != nil {
+		return t.DestinationIp
+	}
+	return nil
+}
+
+// GetDestinationPort returns the value of the DestinationPort struct pointer
+// from SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match. If the receiver or the field DestinationPort is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match) GetDestinationPort() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort {
+	if t != nil && t.DestinationPort != nil {
+		return t.DestinationPort
+	}
+	return nil
+}
+
+// GetSourceIp returns the value of the SourceIp struct pointer
+// from SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match. If the receiver or the field SourceIp is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match) GetSourceIp() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourceIp {
+	if t != nil && t.SourceIp != nil {
+		return t.SourceIp
+	}
+	return nil
+}
+
+// GetSourcePort returns the value of the SourcePort struct pointer
+// from SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match. If the receiver or the field SourcePort is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match) GetSourcePort() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort {
+	if t != nil && t.SourcePort != nil {
+		return t.SourcePort
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union is an interface that is implemented by valid types for the union
+// for the leaf /srl_nokia-acl/acl/ipv4-filter/entry/match/protocol within the YANG schema.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union interface {
+	Is_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union()
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union_E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol is used when /srl_nokia-acl/acl/ipv4-filter/entry/match/protocol
+// is to be set to a E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol value.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union_E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol struct {
+	E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+}
+
+// Is_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union_E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+// implements the SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union interface.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union_E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol) Is_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union() {
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union_Uint8 is used when /srl_nokia-acl/acl/ipv4-filter/entry/match/protocol
+// is to be set to a uint8 value.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union_Uint8 struct {
+	Uint8 uint8
+}
+
+// Is_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union_Uint8
+// implements the SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union interface.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union_Uint8) Is_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union() {
+}
+
+// To_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match) To_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union(i interface{}) (SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union, error) {
+	switch v := i.(type) {
+	case E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol:
+		return &SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union_E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol{v}, nil
+	case uint8:
+		return &SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union_Uint8{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union, unknown union type, got: %T, want any of [E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol, uint8]", i, i)
+	}
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationIp represents the /srl_nokia-acl/acl/ipv4-filter/entry/match/destination-ip YANG schema element.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationIp struct {
+	Prefix *string `path:"prefix" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationIp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationIp) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationIp) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationIp"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationIp) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort represents the /srl_nokia-acl/acl/ipv4-filter/entry/match/destination-port YANG schema element.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort struct {
+	Operator E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator `path:"operator" module:"srl_nokia-acl"`
+	Range    *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Range     `path:"range" module:"srl_nokia-acl"`
+	Value    *uint16                                                           `path:"value" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort) IsYANGGoStruct() {}
+
+// GetOrCreateRange retrieves the value of the Range field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort) GetOrCreateRange() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Range {
+	if t.Range != nil {
+		return t.Range
+	}
+	t.Range = &SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Range{}
+	return t.Range
+}
+
+// GetRange returns the value of the Range struct pointer
+// from SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort. If the receiver or the field Range is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort) GetRange() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Range {
+	if t != nil && t.Range != nil {
+		return t.Range
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Range represents the /srl_nokia-acl/acl/ipv4-filter/entry/match/destination-port/range YANG schema element.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Range struct {
+	End   *uint16 `path:"end" module:"srl_nokia-acl"`
+	Start *uint16 `path:"start" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Range implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Range) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Range) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Range"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Range) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourceIp represents the /srl_nokia-acl/acl/ipv4-filter/entry/match/source-ip YANG schema element.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourceIp struct {
+	Prefix *string `path:"prefix" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourceIp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourceIp) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourceIp) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourceIp"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourceIp) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort represents the /srl_nokia-acl/acl/ipv4-filter/entry/match/source-port YANG schema element.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort struct {
+	Operator E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator `path:"operator" module:"srl_nokia-acl"`
+	Range    *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort_Range          `path:"range" module:"srl_nokia-acl"`
+	Value    *uint16                                                           `path:"value" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort) IsYANGGoStruct() {}
+
+// GetOrCreateRange retrieves the value of the Range field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort) GetOrCreateRange() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort_Range {
+	if t.Range != nil {
+		return t.Range
+	}
+	t.Range = &SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort_Range{}
+	return t.Range
+}
+
+// GetRange returns the value of the Range struct pointer
+// from SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort. If the receiver or the field Range is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort) GetRange() *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort_Range {
+	if t != nil && t.Range != nil {
+		return t.Range
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort_Range represents the /srl_nokia-acl/acl/ipv4-filter/entry/match/source-port/range YANG schema element.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort_Range struct {
+	End   *uint16 `path:"end" module:"srl_nokia-acl"`
+	Start *uint16 `path:"start" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort_Range implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort_Range) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort_Range) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort_Range"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort_Range) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Statistics represents the /srl_nokia-acl/acl/ipv4-filter/entry/statistics YANG schema element.
+type SrlNokiaAcl_Acl_Ipv4Filter_Entry_Statistics struct {
+	LastMatch      *string `path:"last-match" module:"srl_nokia-acl"`
+	MatchedPackets *uint64 `path:"matched-packets" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Statistics implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv4Filter_Entry_Statistics) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Statistics) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv4Filter_Entry_Statistics"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv4Filter_Entry_Statistics) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter represents the /srl_nokia-acl/acl/ipv6-filter YANG schema element.
+type SrlNokiaAcl_Acl_Ipv6Filter struct {
+	Description *string                                      `path:"description" module:"srl_nokia-acl"`
+	Entry       map[uint32]*SrlNokiaAcl_Acl_Ipv6Filter_Entry `path:"entry" module:"srl_nokia-acl"`
+	Name        *string                                      `path:"name" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv6Filter implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv6Filter) IsYANGGoStruct() {}
+
+// NewEntry creates a new entry in the Entry list of the
+// SrlNokiaAcl_Acl_Ipv6Filter struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter) NewEntry(SequenceId uint32) (*SrlNokiaAcl_Acl_Ipv6Filter_Entry, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Entry == nil {
+		t.Entry = make(map[uint32]*SrlNokiaAcl_Acl_Ipv6Filter_Entry)
+	}
+
+	key := SequenceId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Entry[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Entry", key)
+	}
+
+	t.Entry[key] = &SrlNokiaAcl_Acl_Ipv6Filter_Entry{
+		SequenceId: &SequenceId,
+	}
+
+	return t.Entry[key], nil
+}
+
+// GetOrCreateEntry retrieves the value with the specified keys from
+// the receiver SrlNokiaAcl_Acl_Ipv6Filter. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter) GetOrCreateEntry(SequenceId uint32) *SrlNokiaAcl_Acl_Ipv6Filter_Entry {
+
+	key := SequenceId
+
+	if v, ok := t.Entry[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewEntry(SequenceId)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateEntry got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetEntry retrieves the value with the specified key from
+// the Entry map field of SrlNokiaAcl_Acl_Ipv6Filter. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter) GetEntry(SequenceId uint32) *SrlNokiaAcl_Acl_Ipv6Filter_Entry {
+
+	if t == nil {
+		return nil
+	}
+
+	key := SequenceId
+
+	if lm, ok := t.Entry[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteEntry deletes the value with the specified keys from
+// the receiver SrlNokiaAcl_Acl_Ipv6Filter. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter) DeleteEntry(SequenceId uint32) {
+	key := SequenceId
+
+	delete(t.Entry, key)
+}
+
+// AppendEntry appends the supplied SrlNokiaAcl_Acl_Ipv6Filter_Entry struct to the
+// list Entry of SrlNokiaAcl_Acl_Ipv6Filter. If the key value(s) specified in
+// the supplied SrlNokiaAcl_Acl_Ipv6Filter_Entry already exist in the list, an error is
+// returned.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter) AppendEntry(v *SrlNokiaAcl_Acl_Ipv6Filter_Entry) error {
+	if v.SequenceId == nil {
+		return fmt.Errorf("invalid nil key received for SequenceId")
+	}
+
+	key := *v.SequenceId
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Entry == nil {
+		t.Entry = make(map[uint32]*SrlNokiaAcl_Acl_Ipv6Filter_Entry)
+	}
+
+	if _, ok := t.Entry[key]; ok {
+		return fmt.Errorf("duplicate key for list Entry %v", key)
+	}
+
+	t.Entry[key] = v
+	return nil
+}
+
+// ΛListKeyMap returns the keys of the SrlNokiaAcl_Acl_Ipv6Filter struct, which is a YANG list entry.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv6Filter"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry represents the /srl_nokia-acl/acl/ipv6-filter/entry YANG schema element.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry struct {
+	Action      *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action     `path:"action" module:"srl_nokia-acl"`
+	Description *string                                      `path:"description" module:"srl_nokia-acl"`
+	Match       *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match      `path:"match" module:"srl_nokia-acl"`
+	SequenceId  *uint32                                      `path:"sequence-id" module:"srl_nokia-acl"`
+	Statistics  *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Statistics `path:"statistics" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry) IsYANGGoStruct() {}
+
+// GetOrCreateAction retrieves the value of the Action field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry) GetOrCreateAction() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action {
+	if t.Action != nil {
+		return t.Action
+	}
+	t.Action = &SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action{}
+	return t.Action
+}
+
+// GetOrCreateMatch retrieves the value of the Match field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry) GetOrCreateMatch() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match {
+	if t.Match != nil {
+		return t.Match
+	}
+	t.Match = &SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match{}
+	return t.Match
+}
+
+// GetOrCreateStatistics retrieves the value of the Statistics field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry) GetOrCreateStatistics() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Statistics {
+	if t.Statistics != nil {
+		return t.Statistics
+	}
+	t.Statistics = &SrlNokiaAcl_Acl_Ipv6Filter_Entry_Statistics{}
+	return t.Statistics
+}
+
+// GetAction returns the value of the Action struct pointer
+// from SrlNokiaAcl_Acl_Ipv6Filter_Entry. If the receiver or the field Action is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry) GetAction() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action {
+	if t != nil && t.Action != nil {
+		return t.Action
+	}
+	return nil
+}
+
+// GetMatch returns the value of the Match struct pointer
+// from SrlNokiaAcl_Acl_Ipv6Filter_Entry. If the receiver or the field Match is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry) GetMatch() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match {
+	if t != nil && t.Match != nil {
+		return t.Match
+	}
+	return nil
+}
+
+// GetStatistics returns the value of the Statistics struct pointer
+// from SrlNokiaAcl_Acl_Ipv6Filter_Entry. If the receiver or the field Statistics is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry) GetStatistics() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Statistics {
+	if t != nil && t.Statistics != nil {
+		return t.Statistics
+	}
+	return nil
+}
+
+// ΛListKeyMap returns the keys of the SrlNokiaAcl_Acl_Ipv6Filter_Entry struct, which is a YANG list entry.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.SequenceId == nil {
+		return nil, fmt.Errorf("nil value for key SequenceId")
+	}
+
+	return map[string]interface{}{
+		"sequence-id": *t.SequenceId,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv6Filter_Entry"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action represents the /srl_nokia-acl/acl/ipv6-filter/entry/action YANG schema element.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action struct {
+	Accept *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Accept `path:"accept" module:"srl_nokia-acl"`
+	Drop   *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Drop   `path:"drop" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action) IsYANGGoStruct() {}
+
+// GetOrCreateAccept retrieves the value of the Accept field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action) GetOrCreateAccept() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Accept {
+	if t.Accept != nil {
+		return t.Accept
+	}
+	t.Accept = &SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Accept{}
+	return t.Accept
+}
+
+// GetOrCreateDrop retrieves the value of the Drop field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action) GetOrCreateDrop() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Drop {
+	if t.Drop != nil {
+		return t.Drop
+	}
+	t.Drop = &SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Drop{}
+	return t.Drop
+}
+
+// GetAccept returns the value of the Accept struct pointer
+// from SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action. If the receiver or the field Accept is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action) GetAccept() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Accept {
+	if t != nil && t.Accept != nil {
+		return t.Accept
+	}
+	return nil
+}
+
+// GetDrop returns the value of the Drop struct pointer
+// from SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action. If the receiver or the field Drop is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action) GetDrop() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Drop {
+	if t != nil && t.Drop != nil {
+		return t.Drop
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Accept represents the /srl_nokia-acl/acl/ipv6-filter/entry/action/accept YANG schema element.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Accept struct {
+	Log *bool `path:"log" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Accept implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Accept) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Accept) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Accept"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Accept) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Drop represents the /srl_nokia-acl/acl/ipv6-filter/entry/action/drop YANG schema element.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Drop struct {
+	Log *bool `path:"log" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Drop implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Drop) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Drop) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Drop"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Action_Drop) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match represents the /srl_nokia-acl/acl/ipv6-filter/entry/match YANG schema element.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match struct {
+	DestinationIp   *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationIp   `path:"destination-ip" module:"srl_nokia-acl"`
+	DestinationPort *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort `path:"destination-port" module:"srl_nokia-acl"`
+	DscpSet         []uint8                                                 `path:"dscp-set" module:"srl_nokia-acl"`
+	NextHeader      SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union `path:"next-header" module:"srl_nokia-acl"`
+	SourceIp        *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourceIp        `path:"source-ip" module:"srl_nokia-acl"`
+	SourcePort      *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort      `path:"source-port" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match) IsYANGGoStruct() {}
+
+// GetOrCreateDestinationIp retrieves the value of the DestinationIp field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match) GetOrCreateDestinationIp() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationIp {
+	if t.DestinationIp != nil {
+		return t.DestinationIp
+	}
+	t.DestinationIp = &SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationIp{}
+	return t.DestinationIp
+}
+
+// GetOrCreateDestinationPort retrieves the value of the DestinationPort field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match) GetOrCreateDestinationPort() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort {
+	if t.DestinationPort != nil {
+		return t.DestinationPort
+	}
+	t.DestinationPort = &SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort{}
+	return t.DestinationPort
+}
+
+// GetOrCreateSourceIp retrieves the value of the SourceIp field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match) GetOrCreateSourceIp() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourceIp {
+	if t.SourceIp != nil {
+		return t.SourceIp
+	}
+	t.SourceIp = &SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourceIp{}
+	return t.SourceIp
+}
+
+// GetOrCreateSourcePort retrieves the value of the SourcePort field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match) GetOrCreateSourcePort() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort {
+	if t.SourcePort != nil {
+		return t.SourcePort
+	}
+	t.SourcePort = &SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort{}
+	return t.SourcePort
+}
+
+// GetDestinationIp returns the value of the DestinationIp struct pointer
+// from SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match. If the receiver or the field DestinationIp is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match) GetDestinationIp() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationIp {
+	if t != nil && t.DestinationIp != nil {
+		return t.DestinationIp
+	}
+	return nil
+}
+
+// GetDestinationPort returns the value of the DestinationPort struct pointer
+// from SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match. If the receiver or the field DestinationPort is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match) GetDestinationPort() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort {
+	if t != nil && t.DestinationPort != nil {
+		return t.DestinationPort
+	}
+	return nil
+}
+
+// GetSourceIp returns the value of the SourceIp struct pointer
+// from SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match. If the receiver or the field SourceIp is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match) GetSourceIp() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourceIp {
+	if t != nil && t.SourceIp != nil {
+		return t.SourceIp
+	}
+	return nil
+}
+
+// GetSourcePort returns the value of the SourcePort struct pointer
+// from SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match. If the receiver or the field SourcePort is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match) GetSourcePort() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort {
+	if t != nil && t.SourcePort != nil {
+		return t.SourcePort
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union is an interface that is implemented by valid types for the union
+// for the leaf /srl_nokia-acl/acl/ipv6-filter/entry/match/next-header within the YANG schema.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union interface {
+	Is_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union()
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union_E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader is used when /srl_nokia-acl/acl/ipv6-filter/entry/match/next-header
+// is to be set to a E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader value.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union_E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader struct {
+	E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+}
+
+// Is_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union_E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+// implements the SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union interface.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union_E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader) Is_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union() {
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union_Uint8 is used when /srl_nokia-acl/acl/ipv6-filter/entry/match/next-header
+// is to be set to a uint8 value.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union_Uint8 struct {
+	Uint8 uint8
+}
+
+// Is_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union_Uint8
+// implements the SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union interface.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union_Uint8) Is_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union() {
+}
+
+// To_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match) To_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union(i interface{}) (SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union, error) {
+	switch v := i.(type) {
+	case E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader:
+		return &SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union_E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader{v}, nil
+	case uint8:
+		return &SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union_Uint8{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union, unknown union type, got: %T, want any of [E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader, uint8]", i, i)
+	}
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationIp represents the /srl_nokia-acl/acl/ipv6-filter/entry/match/destination-ip YANG schema element.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationIp struct {
+	Prefix *string `path:"prefix" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationIp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationIp) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationIp) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationIp"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationIp) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort represents the /srl_nokia-acl/acl/ipv6-filter/entry/match/destination-port YANG schema element.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort struct {
+	Operator E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator `path:"operator" module:"srl_nokia-acl"`
+	Range    *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort_Range     `path:"range" module:"srl_nokia-acl"`
+	Value    *uint16                                                           `path:"value" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort) IsYANGGoStruct() {}
+
+// GetOrCreateRange retrieves the value of the Range field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort) GetOrCreateRange() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort_Range {
+	if t.Range != nil {
+		return t.Range
+	}
+	t.Range = &SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort_Range{}
+	return t.Range
+}
+
+// GetRange returns the value of the Range struct pointer
+// from SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort. If the receiver or the field Range is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort) GetRange() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort_Range {
+	if t != nil && t.Range != nil {
+		return t.Range
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort_Range represents the /srl_nokia-acl/acl/ipv6-filter/entry/match/destination-port/range YANG schema element.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort_Range struct {
+	End   *uint16 `path:"end" module:"srl_nokia-acl"`
+	Start *uint16 `path:"start" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort_Range implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort_Range) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort_Range) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort_Range"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort_Range) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourceIp represents the /srl_nokia-acl/acl/ipv6-filter/entry/match/source-ip YANG schema element.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourceIp struct {
+	Prefix *string `path:"prefix" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourceIp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourceIp) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourceIp) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourceIp"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourceIp) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort represents the /srl_nokia-acl/acl/ipv6-filter/entry/match/source-port YANG schema element.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort struct {
+	Operator E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator `path:"operator" module:"srl_nokia-acl"`
+	Range    *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort_Range          `path:"range" module:"srl_nokia-acl"`
+	Value    *uint16                                                           `path:"value" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort) IsYANGGoStruct() {}
+
+// GetOrCreateRange retrieves the value of the Range field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort) GetOrCreateRange() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort_Range {
+	if t.Range != nil {
+		return t.Range
+	}
+	t.Range = &SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort_Range{}
+	return t.Range
+}
+
+// GetRange returns the value of the Range struct pointer
+// from SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort. If the receiver or the field Range is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort) GetRange() *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort_Range {
+	if t != nil && t.Range != nil {
+		return t.Range
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort_Range represents the /srl_nokia-acl/acl/ipv6-filter/entry/match/source-port/range YANG schema element.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort_Range struct {
+	End   *uint16 `path:"end" module:"srl_nokia-acl"`
+	Start *uint16 `path:"start" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort_Range implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort_Range) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort_Range) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort_Range"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort_Range) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Statistics represents the /srl_nokia-acl/acl/ipv6-filter/entry/statistics YANG schema element.
+type SrlNokiaAcl_Acl_Ipv6Filter_Entry_Statistics struct {
+	LastMatch      *string `path:"last-match" module:"srl_nokia-acl"`
+	MatchedPackets *uint64 `path:"matched-packets" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Statistics implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_Ipv6Filter_Entry_Statistics) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Statistics) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_Ipv6Filter_Entry_Statistics"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_Ipv6Filter_Entry_Statistics) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_MacFilter represents the /srl_nokia-acl/acl/mac-filter YANG schema element.
+type SrlNokiaAcl_Acl_MacFilter struct {
+	Description *string                                     `path:"description" module:"srl_nokia-acl"`
+	Entry       map[uint32]*SrlNokiaAcl_Acl_MacFilter_Entry `path:"entry" module:"srl_nokia-acl"`
+	Name        *string                                     `path:"name" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_MacFilter implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_MacFilter) IsYANGGoStruct() {}
+
+// NewEntry creates a new entry in the Entry list of the
+// SrlNokiaAcl_Acl_MacFilter struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaAcl_Acl_MacFilter) NewEntry(SequenceId uint32) (*SrlNokiaAcl_Acl_MacFilter_Entry, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Entry == nil {
+		t.Entry = make(map[uint32]*SrlNokiaAcl_Acl_MacFilter_Entry)
+	}
+
+	key := SequenceId
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Entry[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Entry", key)
+	}
+
+	t.Entry[key] = &SrlNokiaAcl_Acl_MacFilter_Entry{
+		SequenceId: &SequenceId,
+	}
+
+	return t.Entry[key], nil
+}
+
+// GetOrCreateEntry retrieves the value with the specified keys from
+// the receiver SrlNokiaAcl_Acl_MacFilter. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaAcl_Acl_MacFilter) GetOrCreateEntry(SequenceId uint32) *SrlNokiaAcl_Acl_MacFilter_Entry {
+
+	key := SequenceId
+
+	if v, ok := t.Entry[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewEntry(SequenceId)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateEntry got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetEntry retrieves the value with the specified key from
+// the Entry map field of SrlNokiaAcl_Acl_MacFilter. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaAcl_Acl_MacFilter) GetEntry(SequenceId uint32) *SrlNokiaAcl_Acl_MacFilter_Entry {
+
+	if t == nil {
+		return nil
+	}
+
+	key := SequenceId
+
+	if lm, ok := t.Entry[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteEntry deletes the value with the specified keys from
+// the receiver SrlNokiaAcl_Acl_MacFilter. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaAcl_Acl_MacFilter) DeleteEntry(SequenceId uint32) {
+	key := SequenceId
+
+	delete(t.Entry, key)
+}
+
+// AppendEntry appends the supplied SrlNokiaAcl_Acl_MacFilter_Entry struct to the
+// list Entry of SrlNokiaAcl_Acl_MacFilter. If the key value(s) specified in
+// the supplied SrlNokiaAcl_Acl_MacFilter_Entry already exist in the list, an error is
+// returned.
+func (t *SrlNokiaAcl_Acl_MacFilter) AppendEntry(v *SrlNokiaAcl_Acl_MacFilter_Entry) error {
+	if v.SequenceId == nil {
+		return fmt.Errorf("invalid nil key received for SequenceId")
+	}
+
+	key := *v.SequenceId
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Entry == nil {
+		t.Entry = make(map[uint32]*SrlNokiaAcl_Acl_MacFilter_Entry)
+	}
+
+	if _, ok := t.Entry[key]; ok {
+		return fmt.Errorf("duplicate key for list Entry %v", key)
+	}
+
+	t.Entry[key] = v
+	return nil
+}
+
+// ΛListKeyMap returns the keys of the SrlNokiaAcl_Acl_MacFilter struct, which is a YANG list entry.
+func (t *SrlNokiaAcl_Acl_MacFilter) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_MacFilter) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_MacFilter"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_MacFilter) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// SrlNokiaAcl_Acl_MacFilter_Entry represents the /srl_nokia-acl/acl/mac-filter/entry YANG schema element.
+type SrlNokiaAcl_Acl_MacFilter_Entry struct {
+	Action      *SrlNokiaAcl_Acl_MacFilter_Entry_Action     `path:"action" module:"srl_nokia-acl"`
+	Description *string                                     `path:"description" module:"srl_nokia-acl"`
+	Match       *SrlNokiaAcl_Acl_MacFilter_Entry_Match      `path:"match" module:"srl_nokia-acl"`
+	SequenceId  *uint32                                     `path:"sequence-id" module:"srl_nokia-acl"`
+	Statistics  *SrlNokiaAcl_Acl_MacFilter_Entry_Statistics `path:"statistics" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_MacFilter_Entry implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_MacFilter_Entry) IsYANGGoStruct() {}
+
+// GetOrCreateAction retrieves the value of the Action field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry) GetOrCreateAction() *SrlNokiaAcl_Acl_MacFilter_Entry_Action {
+	if t.Action != nil {
+		return t.Action
+	}
+	t.Action = &SrlNokiaAcl_Acl_MacFilter_Entry_Action{}
+	return t.Action
+}
+
+// GetOrCreateMatch retrieves the value of the Match field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry) GetOrCreateMatch() *SrlNokiaAcl_Acl_MacFilter_Entry_Match {
+	if t.Match != nil {
+		return t.Match
+	}
+	t.Match = &SrlNokiaAcl_Acl_MacFilter_Entry_Match{}
+	return t.Match
+}
+
+// GetOrCreateStatistics retrieves the value of the Statistics field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry) GetOrCreateStatistics() *SrlNokiaAcl_Acl_MacFilter_Entry_Statistics {
+	if t.Statistics != nil {
+		return t.Statistics
+	}
+	t.Statistics = &SrlNokiaAcl_Acl_MacFilter_Entry_Statistics{}
+	return t.Statistics
+}
+
+// GetAction returns the value of the Action struct pointer
+// from SrlNokiaAcl_Acl_MacFilter_Entry. If the receiver or the field Action is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry) GetAction() *SrlNokiaAcl_Acl_MacFilter_Entry_Action {
+	if t != nil && t.Action != nil {
+		return t.Action
+	}
+	return nil
+}
+
+// GetMatch returns the value of the Match struct pointer
+// from SrlNokiaAcl_Acl_MacFilter_Entry. If the receiver or the field Match is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry) GetMatch() *SrlNokiaAcl_Acl_MacFilter_Entry_Match {
+	if t != nil && t.Match != nil {
+		return t.Match
+	}
+	return nil
+}
+
+// GetStatistics returns the value of the Statistics struct pointer
+// from SrlNokiaAcl_Acl_MacFilter_Entry. If the receiver or the field Statistics is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry) GetStatistics() *SrlNokiaAcl_Acl_MacFilter_Entry_Statistics {
+	if t != nil && t.Statistics != nil {
+		return t.Statistics
+	}
+	return nil
+}
+
+// ΛListKeyMap returns the keys of the SrlNokiaAcl_Acl_MacFilter_Entry struct, which is a YANG list entry.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.SequenceId == nil {
+		return nil, fmt.Errorf("nil value for key SequenceId")
+	}
+
+	return map[string]interface{}{
+		"sequence-id": *t.SequenceId,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_MacFilter_Entry"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_MacFilter_Entry_Action represents the /srl_nokia-acl/acl/mac-filter/entry/action YANG schema element.
+type SrlNokiaAcl_Acl_MacFilter_Entry_Action struct {
+	Accept *SrlNokiaAcl_Acl_MacFilter_Entry_Action_Accept `path:"accept" module:"srl_nokia-acl"`
+	Drop   *SrlNokiaAcl_Acl_MacFilter_Entry_Action_Drop   `path:"drop" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_MacFilter_Entry_Action implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_MacFilter_Entry_Action) IsYANGGoStruct() {}
+
+// GetOrCreateAccept retrieves the value of the Accept field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Action) GetOrCreateAccept() *SrlNokiaAcl_Acl_MacFilter_Entry_Action_Accept {
+	if t.Accept != nil {
+		return t.Accept
+	}
+	t.Accept = &SrlNokiaAcl_Acl_MacFilter_Entry_Action_Accept{}
+	return t.Accept
+}
+
+// GetOrCreateDrop retrieves the value of the Drop field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Action) GetOrCreateDrop() *SrlNokiaAcl_Acl_MacFilter_Entry_Action_Drop {
+	if t.Drop != nil {
+		return t.Drop
+	}
+	t.Drop = &SrlNokiaAcl_Acl_MacFilter_Entry_Action_Drop{}
+	return t.Drop
+}
+
+// GetAccept returns the value of the Accept struct pointer
+// from SrlNokiaAcl_Acl_MacFilter_Entry_Action. If the receiver or the field Accept is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Action) GetAccept() *SrlNokiaAcl_Acl_MacFilter_Entry_Action_Accept {
+	if t != nil && t.Accept != nil {
+		return t.Accept
+	}
+	return nil
+}
+
+// GetDrop returns the value of the Drop struct pointer
+// from SrlNokiaAcl_Acl_MacFilter_Entry_Action. If the receiver or the field Drop is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Action) GetDrop() *SrlNokiaAcl_Acl_MacFilter_Entry_Action_Drop {
+	if t != nil && t.Drop != nil {
+		return t.Drop
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Action) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_MacFilter_Entry_Action"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Action) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_MacFilter_Entry_Action_Accept represents the /srl_nokia-acl/acl/mac-filter/entry/action/accept YANG schema element.
+type SrlNokiaAcl_Acl_MacFilter_Entry_Action_Accept struct {
+	Log *bool `path:"log" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_MacFilter_Entry_Action_Accept implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_MacFilter_Entry_Action_Accept) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Action_Accept) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_MacFilter_Entry_Action_Accept"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Action_Accept) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_MacFilter_Entry_Action_Drop represents the /srl_nokia-acl/acl/mac-filter/entry/action/drop YANG schema element.
+type SrlNokiaAcl_Acl_MacFilter_Entry_Action_Drop struct {
+	Log *bool `path:"log" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_MacFilter_Entry_Action_Drop implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_MacFilter_Entry_Action_Drop) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Action_Drop) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_MacFilter_Entry_Action_Drop"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Action_Drop) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_MacFilter_Entry_Match represents the /srl_nokia-acl/acl/mac-filter/entry/match YANG schema element.
+type SrlNokiaAcl_Acl_MacFilter_Entry_Match struct {
+	DestinationMac *SrlNokiaAcl_Acl_MacFilter_Entry_Match_DestinationMac `path:"destination-mac" module:"srl_nokia-acl"`
+	Ethertype      SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union `path:"ethertype" module:"srl_nokia-acl"`
+	SourceMac      *SrlNokiaAcl_Acl_MacFilter_Entry_Match_SourceMac      `path:"source-mac" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_MacFilter_Entry_Match implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_MacFilter_Entry_Match) IsYANGGoStruct() {}
+
+// GetOrCreateDestinationMac retrieves the value of the DestinationMac field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Match) GetOrCreateDestinationMac() *SrlNokiaAcl_Acl_MacFilter_Entry_Match_DestinationMac {
+	if t.DestinationMac != nil {
+		return t.DestinationMac
+	}
+	t.DestinationMac = &SrlNokiaAcl_Acl_MacFilter_Entry_Match_DestinationMac{}
+	return t.DestinationMac
+}
+
+// GetOrCreateSourceMac retrieves the value of the SourceMac field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Match) GetOrCreateSourceMac() *SrlNokiaAcl_Acl_MacFilter_Entry_Match_SourceMac {
+	if t.SourceMac != nil {
+		return t.SourceMac
+	}
+	t.SourceMac = &SrlNokiaAcl_Acl_MacFilter_Entry_Match_SourceMac{}
+	return t.SourceMac
+}
+
+// GetDestinationMac returns the value of the DestinationMac struct pointer
+// from SrlNokiaAcl_Acl_MacFilter_Entry_Match. If the receiver or the field DestinationMac is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Match) GetDestinationMac() *SrlNokiaAcl_Acl_MacFilter_Entry_Match_DestinationMac {
+	if t != nil && t.DestinationMac != nil {
+		return t.DestinationMac
+	}
+	return nil
+}
+
+// GetSourceMac returns the value of the SourceMac struct pointer
+// from SrlNokiaAcl_Acl_MacFilter_Entry_Match. If the receiver or the field SourceMac is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Match) GetSourceMac() *SrlNokiaAcl_Acl_MacFilter_Entry_Match_SourceMac {
+	if t != nil && t.SourceMac != nil {
+		return t.SourceMac
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Match) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_MacFilter_Entry_Match"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Match) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union is an interface that is implemented by valid types for the union
+// for the leaf /srl_nokia-acl/acl/mac-filter/entry/match/ethertype within the YANG schema.
+type SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union interface {
+	Is_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union()
+}
+
+// SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union_E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype is used when /srl_nokia-acl/acl/mac-filter/entry/match/ethertype
+// is to be set to a E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype value.
+type SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union_E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype struct {
+	E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype
+}
+
+// Is_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union ensures that SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union_E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype
+// implements the SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union interface.
+func (*SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union_E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype) Is_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union() {
+}
+
+// SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union_Uint16 is used when /srl_nokia-acl/acl/mac-filter/entry/match/ethertype
+// is to be set to a uint16 value.
+type SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union_Uint16 struct {
+	Uint16 uint16
+}
+
+// Is_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union ensures that SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union_Uint16
+// implements the SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union interface.
+func (*SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union_Uint16) Is_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union() {
+}
+
+// To_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Match) To_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union(i interface{}) (SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union, error) {
+	switch v := i.(type) {
+	case E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype:
+		return &SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union_E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype{v}, nil
+	case uint16:
+		return &SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union_Uint16{v}, nil
+	default:
+		return nil, fmt.Errorf("cannot convert %v to SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union, unknown union type, got: %T, want any of [E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype, uint16]", i, i)
+	}
+}
+
+// SrlNokiaAcl_Acl_MacFilter_Entry_Match_DestinationMac represents the /srl_nokia-acl/acl/mac-filter/entry/match/destination-mac YANG schema element.
+type SrlNokiaAcl_Acl_MacFilter_Entry_Match_DestinationMac struct {
+	Address *string `path:"address" module:"srl_nokia-acl"`
+	Mask    *string `path:"mask" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_MacFilter_Entry_Match_DestinationMac implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_MacFilter_Entry_Match_DestinationMac) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Match_DestinationMac) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_MacFilter_Entry_Match_DestinationMac"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Match_DestinationMac) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_MacFilter_Entry_Match_SourceMac represents the /srl_nokia-acl/acl/mac-filter/entry/match/source-mac YANG schema element.
+type SrlNokiaAcl_Acl_MacFilter_Entry_Match_SourceMac struct {
+	Address *string `path:"address" module:"srl_nokia-acl"`
+	Mask    *string `path:"mask" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_MacFilter_Entry_Match_SourceMac implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_MacFilter_Entry_Match_SourceMac) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Match_SourceMac) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_MacFilter_Entry_Match_SourceMac"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Match_SourceMac) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaAcl_Acl_MacFilter_Entry_Statistics represents the /srl_nokia-acl/acl/mac-filter/entry/statistics YANG schema element.
+type SrlNokiaAcl_Acl_MacFilter_Entry_Statistics struct {
+	LastMatch      *string `path:"last-match" module:"srl_nokia-acl"`
+	MatchedPackets *uint64 `path:"matched-packets" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaAcl_Acl_MacFilter_Entry_Statistics implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaAcl_Acl_MacFilter_Entry_Statistics) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Statistics) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaAcl_Acl_MacFilter_Entry_Statistics"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaAcl_Acl_MacFilter_Entry_Statistics) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface represents the /srl_nokia-interfaces/interface YANG schema element.
+type SrlNokiaInterfaces_Interface struct {
+	AdminState     E_SrlNokiaInterfaces_AdminState                       `path:"admin-state" module:"srl_nokia-interfaces"`
+	Description    *string                                               `path:"description" module:"srl_nokia-interfaces"`
+	Ethernet       *SrlNokiaInterfaces_Interface_Ethernet                `path:"ethernet" module:"srl_nokia-interfaces"`
+	LastChange     *string                                               `path:"last-change" module:"srl_nokia-interfaces"`
+	Mtu            *uint16                                               `path:"mtu" module:"srl_nokia-interfaces"`
+	Name           *string                                               `path:"name" module:"srl_nokia-interfaces"`
+	OperDownReason E_SrlNokiaInterfaces_Interface_OperDownReason         `path:"oper-down-reason" module:"srl_nokia-interfaces"`
+	OperState      E_SrlNokiaInterfaces_OperState                        `path:"oper-state" module:"srl_nokia-interfaces"`
+	Statistics     *SrlNokiaInterfaces_Interface_Statistics              `path:"statistics" module:"srl_nokia-interfaces"`
+	Subinterface   map[uint32]*SrlNokiaInterfaces_Interface_Subinterface `path:"subinterface" module:"srl_nokia-interfaces"`
+	VlanTagging    *bool                                                 `path:"vlan-tagging" module:"srl_nokia-interfaces"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface) IsYANGGoStruct() {}
+
+// NewSubinterface creates a new entry in the Subinterface list of the
+// SrlNokiaInterfaces_Interface struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaInterfaces_Interface) NewSubinterface(Index uint32) (*SrlNokiaInterfaces_Interface_Subinterface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Subinterface == nil {
+		t.Subinterface = make(map[uint32]*SrlNokiaInterfaces_Interface_Subinterface)
+	}
+
+	key := Index
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Subinterface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Subinterface", key)
+	}
+
+	t.Subinterface[key] = &SrlNokiaInterfaces_Interface_Subinterface{
+		Index: &Index,
+	}
+
+	return t.Subinterface[key], nil
+}
+
+// GetOrCreateSubinterface retrieves the value with the specified keys from
+// the receiver SrlNokiaInterfaces_Interface. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaInterfaces_Interface) GetOrCreateSubinterface(Index uint32) *SrlNokiaInterfaces_Interface_Subinterface {
+
+	key := Index
+
+	if v, ok := t.Subinterface[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewSubinterface(Index)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateSubinterface got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetSubinterface retrieves the value with the specified key from
+// the Subinterface map field of SrlNokiaInterfaces_Interface. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaInterfaces_Interface) GetSubinterface(Index uint32) *SrlNokiaInterfaces_Interface_Subinterface {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Index
+
+	if lm, ok := t.Subinterface[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteSubinterface deletes the value with the specified keys from
+// the receiver SrlNokiaInterfaces_Interface. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaInterfaces_Interface) DeleteSubinterface(Index uint32) {
+	key := Index
+
+	delete(t.Subinterface, key)
+}
+
+// AppendSubinterface appends the supplied SrlNokiaInterfaces_Interface_Subinterface struct to the
+// list Subinterface of SrlNokiaInterfaces_Interface. If the key value(s) specified in
+// the supplied SrlNokiaInterfaces_Interface_Subinterface already exist in the list, an error is
+// returned.
+func (t *SrlNokiaInterfaces_Interface) AppendSubinterface(v *SrlNokiaInterfaces_Interface_Subinterface) error {
+	if v.Index == nil {
+		return fmt.Errorf("invalid nil key received for Index")
+	}
+
+	key := *v.Index
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Subinterface == nil {
+		t.Subinterface = make(map[uint32]*SrlNokiaInterfaces_Interface_Subinterface)
+	}
+
+	if _, ok := t.Subinterface[key]; ok {
+		return fmt.Errorf("duplicate key for list Subinterface %v", key)
+	}
+
+	t.Subinterface[key] = v
+	return nil
+}
+
+// GetOrCreateEthernet retrieves the value of the Ethernet field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaInterfaces_Interface) GetOrCreateEthernet() *SrlNokiaInterfaces_Interface_Ethernet {
+	if t.Ethernet != nil {
+		return t.Ethernet
+	}
+	t.Ethernet = &SrlNokiaInterfaces_Interface_Ethernet{}
+	return t.Ethernet
+}
+
+// GetOrCreateStatistics retrieves the value of the Statistics field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaInterfaces_Interface) GetOrCreateStatistics() *SrlNokiaInterfaces_Interface_Statistics {
+	if t.Statistics != nil {
+		return t.Statistics
+	}
+	t.Statistics = &SrlNokiaInterfaces_Interface_Statistics{}
+	return t.Statistics
+}
+
+// GetEthernet returns the value of the Ethernet struct pointer
+// from SrlNokiaInterfaces_Interface. If the receiver or the field Ethernet is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaInterfaces_Interface) GetEthernet() *SrlNokiaInterfaces_Interface_Ethernet {
+	if t != nil && t.Ethernet != nil {
+		return t.Ethernet
+	}
+	return nil
+}
+
+// GetStatistics returns the value of the Statistics struct pointer
+// from SrlNokiaInterfaces_Interface. If the receiver or the field Statistics is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaInterfaces_Interface) GetStatistics() *SrlNokiaInterfaces_Interface_Statistics {
+	if t != nil && t.Statistics != nil {
+		return t.Statistics
+	}
+	return nil
+}
+
+// ΛListKeyMap returns the keys of the SrlNokiaInterfaces_Interface struct, which is a YANG list entry.
+func (t *SrlNokiaInterfaces_Interface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// SrlNokiaInterfaces_Interface_Ethernet represents the /srl_nokia-interfaces/interface/ethernet YANG schema element.
+type SrlNokiaInterfaces_Interface_Ethernet struct {
+	PortSpeed E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed `path:"port-speed" module:"srl_nokia-interfaces"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Ethernet implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Ethernet) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Ethernet) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Ethernet"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Ethernet) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface_Statistics represents the /srl_nokia-interfaces/interface/statistics YANG schema element.
+type SrlNokiaInterfaces_Interface_Statistics struct {
+	InDiscardedPackets  *uint64 `path:"in-discarded-packets" module:"srl_nokia-interfaces"`
+	InErrorPackets      *uint64 `path:"in-error-packets" module:"srl_nokia-interfaces"`
+	InOctets            *uint64 `path:"in-octets" module:"srl_nokia-interfaces"`
+	InUnicastPackets    *uint64 `path:"in-unicast-packets" module:"srl_nokia-interfaces"`
+	OutDiscardedPackets *uint64 `path:"out-discarded-packets" module:"srl_nokia-interfaces"`
+	OutErrorPackets     *uint64 `path:"out-error-packets" module:"srl_nokia-interfaces"`
+	OutOctets           *uint64 `path:"out-octets" module:"srl_nokia-interfaces"`
+	OutUnicastPackets   *uint64 `path:"out-unicast-packets" module:"srl_nokia-interfaces"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Statistics implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Statistics) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Statistics) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Statistics"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Statistics) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface_Subinterface represents the /srl_nokia-interfaces/interface/subinterface YANG schema element.
+type SrlNokiaInterfaces_Interface_Subinterface struct {
+	Acl         *SrlNokiaInterfaces_Interface_Subinterface_Acl        `path:"acl" module:"srl_nokia-acl"`
+	AdminState  E_SrlNokiaInterfaces_AdminState                       `path:"admin-state" module:"srl_nokia-interfaces"`
+	Description *string                                               `path:"description" module:"srl_nokia-interfaces"`
+	Index       *uint32                                               `path:"index" module:"srl_nokia-interfaces"`
+	Ipv4        *SrlNokiaInterfaces_Interface_Subinterface_Ipv4       `path:"ipv4" module:"srl_nokia-interfaces"`
+	Ipv6        *SrlNokiaInterfaces_Interface_Subinterface_Ipv6       `path:"ipv6" module:"srl_nokia-interfaces"`
+	LastChange  *string                                               `path:"last-change" module:"srl_nokia-interfaces"`
+	OperState   E_SrlNokiaInterfaces_OperState                        `path:"oper-state" module:"srl_nokia-interfaces"`
+	Statistics  *SrlNokiaInterfaces_Interface_Subinterface_Statistics `path:"statistics" module:"srl_nokia-interfaces"`
+	Vlan        *SrlNokiaInterfaces_Interface_Subinterface_Vlan       `path:"vlan" module:"srl_nokia-interfaces-vlans"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Subinterface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Subinterface) IsYANGGoStruct() {}
+
+// GetOrCreateAcl retrieves the value of the Acl field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaInterfaces_Interface_Subinterface) GetOrCreateAcl() *SrlNokiaInterfaces_Interface_Subinterface_Acl {
+	if t.Acl != nil {
+		return t.Acl
+	}
+	t.Acl = &SrlNokiaInterfaces_Interface_Subinterface_Acl{}
+	return t.Acl
+}
+
+// GetOrCreateIpv4 retrieves the value of the Ipv4 field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaInterfaces_Interface_Subinterface) GetOrCreateIpv4() *SrlNokiaInterfaces_Interface_Subinterface_Ipv4 {
+	if t.Ipv4 != nil {
+		return t.Ipv4
+	}
+	t.Ipv4 = &SrlNokiaInterfaces_Interface_Subinterface_Ipv4{}
+	return t.Ipv4
+}
+
+// GetOrCreateIpv6 retrieves the value of the Ipv6 field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaInterfaces_Interface_Subinterface) GetOrCreateIpv6() *SrlNokiaInterfaces_Interface_Subinterface_Ipv6 {
+	if t.Ipv6 != nil {
+		return t.Ipv6
+	}
+	t.Ipv6 = &SrlNokiaInterfaces_Interface_Subinterface_Ipv6{}
+	return t.Ipv6
+}
+
+// GetOrCreateStatistics retrieves the value of the Statistics field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaInterfaces_Interface_Subinterface) GetOrCreateStatistics() *SrlNokiaInterfaces_Interface_Subinterface_Statistics {
+	if t.Statistics != nil {
+		return t.Statistics
+	}
+	t.Statistics = &SrlNokiaInterfaces_Interface_Subinterface_Statistics{}
+	return t.Statistics
+}
+
+// GetOrCreateVlan retrieves the value of the Vlan field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaInterfaces_Interface_Subinterface) GetOrCreateVlan() *SrlNokiaInterfaces_Interface_Subinterface_Vlan {
+	if t.Vlan != nil {
+		return t.Vlan
+	}
+	t.Vlan = &SrlNokiaInterfaces_Interface_Subinterface_Vlan{}
+	return t.Vlan
+}
+
+// GetAcl returns the value of the Acl struct pointer
+// from SrlNokiaInterfaces_Interface_Subinterface. If the receiver or the field Acl is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaInterfaces_Interface_Subinterface) GetAcl() *SrlNokiaInterfaces_Interface_Subinterface_Acl {
+	if t != nil && t.Acl != nil {
+		return t.Acl
+	}
+	return nil
+}
+
+// GetIpv4 returns the value of the Ipv4 struct pointer
+// from SrlNokiaInterfaces_Interface_Subinterface. If the receiver or the field Ipv4 is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaInterfaces_Interface_Subinterface) GetIpv4() *SrlNokiaInterfaces_Interface_Subinterface_Ipv4 {
+	if t != nil && t.Ipv4 != nil {
+		return t.Ipv4
+	}
+	return nil
+}
+
+// GetIpv6 returns the value of the Ipv6 struct pointer
+// from SrlNokiaInterfaces_Interface_Subinterface. If the receiver or the field Ipv6 is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaInterfaces_Interface_Subinterface) GetIpv6() *SrlNokiaInterfaces_Interface_Subinterface_Ipv6 {
+	if t != nil && t.Ipv6 != nil {
+		return t.Ipv6
+	}
+	return nil
+}
+
+// GetStatistics returns the value of the Statistics struct pointer
+// from SrlNokiaInterfaces_Interface_Subinterface. If the receiver or the field Statistics is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaInterfaces_Interface_Subinterface) GetStatistics() *SrlNokiaInterfaces_Interface_Subinterface_Statistics {
+	if t != nil && t.Statistics != nil {
+		return t.Statistics
+	}
+	return nil
+}
+
+// GetVlan returns the value of the Vlan struct pointer
+// from SrlNokiaInterfaces_Interface_Subinterface. If the receiver or the field Vlan is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaInterfaces_Interface_Subinterface) GetVlan() *SrlNokiaInterfaces_Interface_Subinterface_Vlan {
+	if t != nil && t.Vlan != nil {
+		return t.Vlan
+	}
+	return nil
+}
+
+// ΛListKeyMap returns the keys of the SrlNokiaInterfaces_Interface_Subinterface struct, which is a YANG list entry.
+func (t *SrlNokiaInterfaces_Interface_Subinterface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Index == nil {
+		return nil, fmt.Errorf("nil value for key Index")
+	}
+
+	return map[string]interface{}{
+		"index": *t.Index,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Subinterface) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Subinterface"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Subinterface) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface_Subinterface_Acl represents the /srl_nokia-interfaces/interface/subinterface/acl YANG schema element.
+type SrlNokiaInterfaces_Interface_Subinterface_Acl struct {
+	Input  *SrlNokiaInterfaces_Interface_Subinterface_Acl_Input  `path:"input" module:"srl_nokia-acl"`
+	Output *SrlNokiaInterfaces_Interface_Subinterface_Acl_Output `path:"output" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Subinterface_Acl implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Subinterface_Acl) IsYANGGoStruct() {}
+
+// GetOrCreateInput retrieves the value of the Input field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Acl) GetOrCreateInput() *SrlNokiaInterfaces_Interface_Subinterface_Acl_Input {
+	if t.Input != nil {
+		return t.Input
+	}
+	t.Input = &SrlNokiaInterfaces_Interface_Subinterface_Acl_Input{}
+	return t.Input
+}
+
+// GetOrCreateOutput retrieves the value of the Output field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Acl) GetOrCreateOutput() *SrlNokiaInterfaces_Interface_Subinterface_Acl_Output {
+	if t.Output != nil {
+		return t.Output
+	}
+	t.Output = &SrlNokiaInterfaces_Interface_Subinterface_Acl_Output{}
+	return t.Output
+}
+
+// GetInput returns the value of the Input struct pointer
+// from SrlNokiaInterfaces_Interface_Subinterface_Acl. If the receiver or the field Input is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Acl) GetInput() *SrlNokiaInterfaces_Interface_Subinterface_Acl_Input {
+	if t != nil && t.Input != nil {
+		return t.Input
+	}
+	return nil
+}
+
+// GetOutput returns the value of the Output struct pointer
+// from SrlNokiaInterfaces_Interface_Subinterface_Acl. If the receiver or the field Output is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Acl) GetOutput() *SrlNokiaInterfaces_Interface_Subinterface_Acl_Output {
+	if t != nil && t.Output != nil {
+		return t.Output
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Acl) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Subinterface_Acl"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Acl) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface_Subinterface_Acl_Input represents the /srl_nokia-interfaces/interface/subinterface/acl/input YANG schema element.
+type SrlNokiaInterfaces_Interface_Subinterface_Acl_Input struct {
+	Ipv4Filter *string `path:"ipv4-filter" module:"srl_nokia-acl"`
+	Ipv6Filter *string `path:"ipv6-filter" module:"srl_nokia-acl"`
+	MacFilter  *string `path:"mac-filter" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Subinterface_Acl_Input implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Subinterface_Acl_Input) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Acl_Input) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Subinterface_Acl_Input"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Acl_Input) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface_Subinterface_Acl_Output represents the /srl_nokia-interfaces/interface/subinterface/acl/output YANG schema element.
+type SrlNokiaInterfaces_Interface_Subinterface_Acl_Output struct {
+	Ipv4Filter *string `path:"ipv4-filter" module:"srl_nokia-acl"`
+	Ipv6Filter *string `path:"ipv6-filter" module:"srl_nokia-acl"`
+	MacFilter  *string `path:"mac-filter" module:"srl_nokia-acl"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Subinterface_Acl_Output implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Subinterface_Acl_Output) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Acl_Output) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Subinterface_Acl_Output"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Acl_Output) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface_Subinterface_Ipv4 represents the /srl_nokia-interfaces/interface/subinterface/ipv4 YANG schema element.
+type SrlNokiaInterfaces_Interface_Subinterface_Ipv4 struct {
+	Address map[string]*SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address `path:"address" module:"srl_nokia-interfaces"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Subinterface_Ipv4 implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Subinterface_Ipv4) IsYANGGoStruct() {}
+
+// NewAddress creates a new entry in the Address list of the
+// SrlNokiaInterfaces_Interface_Subinterface_Ipv4 struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv4) NewAddress(IpPrefix string) (*SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Address == nil {
+		t.Address = make(map[string]*SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address)
+	}
+
+	key := IpPrefix
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Address[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Address", key)
+	}
+
+	t.Address[key] = &SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address{
+		IpPrefix: &IpPrefix,
+	}
+
+	return t.Address[key], nil
+}
+
+// GetOrCreateAddress retrieves the value with the specified keys from
+// the receiver SrlNokiaInterfaces_Interface_Subinterface_Ipv4. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv4) GetOrCreateAddress(IpPrefix string) *SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address {
+
+	key := IpPrefix
+
+	if v, ok := t.Address[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewAddress(IpPrefix)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateAddress got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetAddress retrieves the value with the specified key from
+// the Address map field of SrlNokiaInterfaces_Interface_Subinterface_Ipv4. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv4) GetAddress(IpPrefix string) *SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address {
+
+	if t == nil {
+		return nil
+	}
+
+	key := IpPrefix
+
+	if lm, ok := t.Address[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteAddress deletes the value with the specified keys from
+// the receiver SrlNokiaInterfaces_Interface_Subinterface_Ipv4. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv4) DeleteAddress(IpPrefix string) {
+	key := IpPrefix
+
+	delete(t.Address, key)
+}
+
+// AppendAddress appends the supplied SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address struct to the
+// list Address of SrlNokiaInterfaces_Interface_Subinterface_Ipv4. If the key value(s) specified in
+// the supplied SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address already exist in the list, an error is
+// returned.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv4) AppendAddress(v *SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address) error {
+	if v.IpPrefix == nil {
+		return fmt.Errorf("invalid nil key received for IpPrefix")
+	}
+
+	key := *v.IpPrefix
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Address == nil {
+		t.Address = make(map[string]*SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address)
+	}
+
+	if _, ok := t.Address[key]; ok {
+		return fmt.Errorf("duplicate key for list Address %v", key)
+	}
+
+	t.Address[key] = v
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv4) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Subinterface_Ipv4"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv4) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address represents the /srl_nokia-interfaces/interface/subinterface/ipv4/address YANG schema element.
+type SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address struct {
+	IpPrefix *string `path:"ip-prefix" module:"srl_nokia-interfaces"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address struct, which is a YANG list entry.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.IpPrefix == nil {
+		return nil, fmt.Errorf("nil value for key IpPrefix")
+	}
+
+	return map[string]interface{}{
+		"ip-prefix": *t.IpPrefix,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv4_Address) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface_Subinterface_Ipv6 represents the /srl_nokia-interfaces/interface/subinterface/ipv6 YANG schema element.
+type SrlNokiaInterfaces_Interface_Subinterface_Ipv6 struct {
+	Address map[string]*SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address `path:"address" module:"srl_nokia-interfaces"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Subinterface_Ipv6 implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Subinterface_Ipv6) IsYANGGoStruct() {}
+
+// NewAddress creates a new entry in the Address list of the
+// SrlNokiaInterfaces_Interface_Subinterface_Ipv6 struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv6) NewAddress(IpPrefix string) (*SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Address == nil {
+		t.Address = make(map[string]*SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address)
+	}
+
+	key := IpPrefix
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Address[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Address", key)
+	}
+
+	t.Address[key] = &SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address{
+		IpPrefix: &IpPrefix,
+	}
+
+	return t.Address[key], nil
+}
+
+// GetOrCreateAddress retrieves the value with the specified keys from
+// the receiver SrlNokiaInterfaces_Interface_Subinterface_Ipv6. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv6) GetOrCreateAddress(IpPrefix string) *SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address {
+
+	key := IpPrefix
+
+	if v, ok := t.Address[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewAddress(IpPrefix)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateAddress got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetAddress retrieves the value with the specified key from
+// the Address map field of SrlNokiaInterfaces_Interface_Subinterface_Ipv6. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv6) GetAddress(IpPrefix string) *SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address {
+
+	if t == nil {
+		return nil
+	}
+
+	key := IpPrefix
+
+	if lm, ok := t.Address[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteAddress deletes the value with the specified keys from
+// the receiver SrlNokiaInterfaces_Interface_Subinterface_Ipv6. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv6) DeleteAddress(IpPrefix string) {
+	key := IpPrefix
+
+	delete(t.Address, key)
+}
+
+// AppendAddress appends the supplied SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address struct to the
+// list Address of SrlNokiaInterfaces_Interface_Subinterface_Ipv6. If the key value(s) specified in
+// the supplied SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address already exist in the list, an error is
+// returned.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv6) AppendAddress(v *SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address) error {
+	if v.IpPrefix == nil {
+		return fmt.Errorf("invalid nil key received for IpPrefix")
+	}
+
+	key := *v.IpPrefix
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Address == nil {
+		t.Address = make(map[string]*SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address)
+	}
+
+	if _, ok := t.Address[key]; ok {
+		return fmt.Errorf("duplicate key for list Address %v", key)
+	}
+
+	t.Address[key] = v
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv6) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Subinterface_Ipv6"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv6) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address represents the /srl_nokia-interfaces/interface/subinterface/ipv6/address YANG schema element.
+type SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address struct {
+	IpPrefix *string `path:"ip-prefix" module:"srl_nokia-interfaces"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address struct, which is a YANG list entry.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.IpPrefix == nil {
+		return nil, fmt.Errorf("nil value for key IpPrefix")
+	}
+
+	return map[string]interface{}{
+		"ip-prefix": *t.IpPrefix,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Ipv6_Address) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface_Subinterface_Statistics represents the /srl_nokia-interfaces/interface/subinterface/statistics YANG schema element.
+type SrlNokiaInterfaces_Interface_Subinterface_Statistics struct {
+	InDiscardedPackets  *uint64 `path:"in-discarded-packets" module:"srl_nokia-interfaces"`
+	InErrorPackets      *uint64 `path:"in-error-packets" module:"srl_nokia-interfaces"`
+	InOctets            *uint64 `path:"in-octets" module:"srl_nokia-interfaces"`
+	InUnicastPackets    *uint64 `path:"in-unicast-packets" module:"srl_nokia-interfaces"`
+	OutDiscardedPackets *uint64 `path:"out-discarded-packets" module:"srl_nokia-interfaces"`
+	OutErrorPackets     *uint64 `path:"out-error-packets" module:"srl_nokia-interfaces"`
+	OutOctets           *uint64 `path:"out-octets" module:"srl_nokia-interfaces"`
+	OutUnicastPackets   *uint64 `path:"out-unicast-packets" module:"srl_nokia-interfaces"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Subinterface_Statistics implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Subinterface_Statistics) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Statistics) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Subinterface_Statistics"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Statistics) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface_Subinterface_Vlan represents the /srl_nokia-interfaces/interface/subinterface/vlan YANG schema element.
+type SrlNokiaInterfaces_Interface_Subinterface_Vlan struct {
+	Encap *SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap `path:"encap" module:"srl_nokia-interfaces-vlans"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Subinterface_Vlan implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Subinterface_Vlan) IsYANGGoStruct() {}
+
+// GetOrCreateEncap retrieves the value of the Encap field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Vlan) GetOrCreateEncap() *SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap {
+	if t.Encap != nil {
+		return t.Encap
+	}
+	t.Encap = &SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap{}
+	return t.Encap
+}
+
+// GetEncap returns the value of the Encap struct pointer
+// from SrlNokiaInterfaces_Interface_Subinterface_Vlan. If the receiver or the field Encap is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Vlan) GetEncap() *SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap {
+	if t != nil && t.Encap != nil {
+		return t.Encap
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Vlan) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Subinterface_Vlan"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Vlan) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap represents the /srl_nokia-interfaces/interface/subinterface/vlan/encap YANG schema element.
+type SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap struct {
+	SingleTagged *SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap_SingleTagged `path:"single-tagged" module:"srl_nokia-interfaces-vlans"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap) IsYANGGoStruct() {}
+
+// GetOrCreateSingleTagged retrieves the value of the SingleTagged field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap) GetOrCreateSingleTagged() *SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap_SingleTagged {
+	if t.SingleTagged != nil {
+		return t.SingleTagged
+	}
+	t.SingleTagged = &SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap_SingleTagged{}
+	return t.SingleTagged
+}
+
+// GetSingleTagged returns the value of the SingleTagged struct pointer
+// from SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap. If the receiver or the field SingleTagged is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap) GetSingleTagged() *SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap_SingleTagged {
+	if t != nil && t.SingleTagged != nil {
+		return t.SingleTagged
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap_SingleTagged represents the /srl_nokia-interfaces/interface/subinterface/vlan/encap/single-tagged YANG schema element.
+type SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap_SingleTagged struct {
+	VlanId *uint16 `path:"vlan-id" module:"srl_nokia-interfaces-vlans"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap_SingleTagged implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap_SingleTagged) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap_SingleTagged) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap_SingleTagged"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaInterfaces_Interface_Subinterface_Vlan_Encap_SingleTagged) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance represents the /srl_nokia-network-instance/network-instance YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance struct {
+	AdminState    E_SrlNokiaNetworkInstance_AdminState                          `path:"admin-state" module:"srl_nokia-network-instance"`
+	Description   *string                                                       `path:"description" module:"srl_nokia-network-instance"`
+	Interface     map[string]*SrlNokiaNetworkInstance_NetworkInstance_Interface `path:"interface" module:"srl_nokia-network-instance"`
+	Name          *string                                                       `path:"name" module:"srl_nokia-network-instance"`
+	NextHopGroups *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups        `path:"next-hop-groups" module:"srl_nokia-next-hop-groups"`
+	OperState     E_SrlNokiaNetworkInstance_OperState                           `path:"oper-state" module:"srl_nokia-network-instance"`
+	Protocols     *SrlNokiaNetworkInstance_NetworkInstance_Protocols            `path:"protocols" module:"srl_nokia-network-instance"`
+	RouteTable    *SrlNokiaNetworkInstance_NetworkInstance_RouteTable           `path:"route-table" module:"srl_nokia-ip-route-tables"`
+	RouterId      *string                                                       `path:"router-id" module:"srl_nokia-network-instance"`
+	StaticRoutes  *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes         `path:"static-routes" module:"srl_nokia-static-routes"`
+	Type          E_SrlNokiaNetworkInstance_NiType                              `path:"type" module:"srl_nokia-network-instance"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance) IsYANGGoStruct() {}
+
+// NewInterface creates a new entry in the Interface list of the
+// SrlNokiaNetworkInstance_NetworkInstance struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) NewInterface(Name string) (*SrlNokiaNetworkInstance_NetworkInstance_Interface, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*SrlNokiaNetworkInstance_NetworkInstance_Interface)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Interface[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Interface", key)
+	}
+
+	t.Interface[key] = &SrlNokiaNetworkInstance_NetworkInstance_Interface{
+		Name: &Name,
+	}
+
+	return t.Interface[key], nil
+}
+
+// GetOrCreateInterface retrieves the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) GetOrCreateInterface(Name string) *SrlNokiaNetworkInstance_NetworkInstance_Interface {
+
+	key := Name
+
+	if v, ok := t.Interface[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewInterface(Name)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateInterface got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetInterface retrieves the value with the specified key from
+// the Interface map field of SrlNokiaNetworkInstance_NetworkInstance. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) GetInterface(Name string) *SrlNokiaNetworkInstance_NetworkInstance_Interface {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Name
+
+	if lm, ok := t.Interface[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteInterface deletes the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) DeleteInterface(Name string) {
+	key := Name
+
+	delete(t.Interface, key)
+}
+
+// AppendInterface appends the supplied SrlNokiaNetworkInstance_NetworkInstance_Interface struct to the
+// list Interface of SrlNokiaNetworkInstance_NetworkInstance. If the key value(s) specified in
+// the supplied SrlNokiaNetworkInstance_NetworkInstance_Interface already exist in the list, an error is
+// returned.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) AppendInterface(v *SrlNokiaNetworkInstance_NetworkInstance_Interface) error {
+	if v.Name == nil {
+		return fmt.Errorf("invalid nil key received for Name")
+	}
+
+	key := *v.Name
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Interface == nil {
+		t.Interface = make(map[string]*SrlNokiaNetworkInstance_NetworkInstance_Interface)
+	}
+
+	if _, ok := t.Interface[key]; ok {
+		return fmt.Errorf("duplicate key for list Interface %v", key)
+	}
+
+	t.Interface[key] = v
+	return nil
+}
+
+// GetOrCreateNextHopGroups retrieves the value of the NextHopGroups field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) GetOrCreateNextHopGroups() *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups {
+	if t.NextHopGroups != nil {
+		return t.NextHopGroups
+	}
+	t.NextHopGroups = &SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups{}
+	return t.NextHopGroups
+}
+
+// GetOrCreateProtocols retrieves the value of the Protocols field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) GetOrCreateProtocols() *SrlNokiaNetworkInstance_NetworkInstance_Protocols {
+	if t.Protocols != nil {
+		return t.Protocols
+	}
+	t.Protocols = &SrlNokiaNetworkInstance_NetworkInstance_Protocols{}
+	return t.Protocols
+}
+
+// GetOrCreateRouteTable retrieves the value of the RouteTable field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) GetOrCreateRouteTable() *SrlNokiaNetworkInstance_NetworkInstance_RouteTable {
+	if t.RouteTable != nil {
+		return t.RouteTable
+	}
+	t.RouteTable = &SrlNokiaNetworkInstance_NetworkInstance_RouteTable{}
+	return t.RouteTable
+}
+
+// GetOrCreateStaticRoutes retrieves the value of the StaticRoutes field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) GetOrCreateStaticRoutes() *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes {
+	if t.StaticRoutes != nil {
+		return t.StaticRoutes
+	}
+	t.StaticRoutes = &SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes{}
+	return t.StaticRoutes
+}
+
+// GetNextHopGroups returns the value of the NextHopGroups struct pointer
+// from SrlNokiaNetworkInstance_NetworkInstance. If the receiver or the field NextHopGroups is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) GetNextHopGroups() *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups {
+	if t != nil && t.NextHopGroups != nil {
+		return t.NextHopGroups
+	}
+	return nil
+}
+
+// GetProtocols returns the value of the Protocols struct pointer
+// from SrlNokiaNetworkInstance_NetworkInstance. If the receiver or the field Protocols is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) GetProtocols() *SrlNokiaNetworkInstance_NetworkInstance_Protocols {
+	if t != nil && t.Protocols != nil {
+		return t.Protocols
+	}
+	return nil
+}
+
+// GetRouteTable returns the value of the RouteTable struct pointer
+// from SrlNokiaNetworkInstance_NetworkInstance. If the receiver or the field RouteTable is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) GetRouteTable() *SrlNokiaNetworkInstance_NetworkInstance_RouteTable {
+	if t != nil && t.RouteTable != nil {
+		return t.RouteTable
+	}
+	return nil
+}
+
+// GetStaticRoutes returns the value of the StaticRoutes struct pointer
+// from SrlNokiaNetworkInstance_NetworkInstance. If the receiver or the field StaticRoutes is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) GetStaticRoutes() *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes {
+	if t != nil && t.StaticRoutes != nil {
+		return t.StaticRoutes
+	}
+	return nil
+}
+
+// ΛListKeyMap returns the keys of the SrlNokiaNetworkInstance_NetworkInstance struct, which is a YANG list entry.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_Interface represents the /srl_nokia-network-instance/network-instance/interface YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_Interface struct {
+	Name      *string                             `path:"name" module:"srl_nokia-network-instance"`
+	OperState E_SrlNokiaNetworkInstance_OperState `path:"oper-state" module:"srl_nokia-network-instance"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_Interface implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_Interface) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the SrlNokiaNetworkInstance_NetworkInstance_Interface struct, which is a YANG list entry.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Interface) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Interface) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_Interface"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Interface) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups represents the /srl_nokia-network-instance/network-instance/next-hop-groups YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups struct {
+	Group map[string]*SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group `path:"group" module:"srl_nokia-next-hop-groups"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups) IsYANGGoStruct() {}
+
+// NewGroup creates a new entry in the Group list of the
+// SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups) NewGroup(Name string) (*SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Group == nil {
+		t.Group = make(map[string]*SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Group[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Group", key)
+	}
+
+	t.Group[key] = &SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group{
+		Name: &Name,
+	}
+
+	return t.Group[key], nil
+}
+
+// GetOrCreateGroup retrieves the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups) GetOrCreateGroup(Name string) *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group {
+
+	key := Name
+
+	if v, ok := t.Group[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewGroup(Name)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateGroup got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetGroup retrieves the value with the specified key from
+// the Group map field of SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups) GetGroup(Name string) *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Name
+
+	if lm, ok := t.Group[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteGroup deletes the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups) DeleteGroup(Name string) {
+	key := Name
+
+	delete(t.Group, key)
+}
+
+// AppendGroup appends the supplied SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group struct to the
+// list Group of SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups. If the key value(s) specified in
+// the supplied SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group already exist in the list, an error is
+// returned.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups) AppendGroup(v *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group) error {
+	if v.Name == nil {
+		return fmt.Errorf("invalid nil key received for Name")
+	}
+
+	key := *v.Name
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Group == nil {
+		t.Group = make(map[string]*SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group)
+	}
+
+	if _, ok := t.Group[key]; ok {
+		return fmt.Errorf("duplicate key for list Group %v", key)
+	}
+
+	t.Group[key] = v
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group represents the /srl_nokia-network-instance/network-instance/next-hop-groups/group YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group struct {
+	AdminState E_SrlNokiaNextHopGroups_AdminState                                              `path:"admin-state" module:"srl_nokia-next-hop-groups"`
+	Name       *string                                                                         `path:"name" module:"srl_nokia-next-hop-groups"`
+	Nexthop    map[uint16]*SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop `path:"nexthop" module:"srl_nokia-next-hop-groups"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group) IsYANGGoStruct() {}
+
+// NewNexthop creates a new entry in the Nexthop list of the
+// SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group) NewNexthop(Index uint16) (*SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Nexthop == nil {
+		t.Nexthop = make(map[uint16]*SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop)
+	}
+
+	key := Index
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Nexthop[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Nexthop", key)
+	}
+
+	t.Nexthop[key] = &SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop{
+		Index: &Index,
+	}
+
+	return t.Nexthop[key], nil
+}
+
+// GetOrCreateNexthop retrieves the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group) GetOrCreateNexthop(Index uint16) *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop {
+
+	key := Index
+
+	if v, ok := t.Nexthop[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewNexthop(Index)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateNexthop got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetNexthop retrieves the value with the specified key from
+// the Nexthop map field of SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group) GetNexthop(Index uint16) *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Index
+
+	if lm, ok := t.Nexthop[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteNexthop deletes the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group) DeleteNexthop(Index uint16) {
+	key := Index
+
+	delete(t.Nexthop, key)
+}
+
+// AppendNexthop appends the supplied SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop struct to the
+// list Nexthop of SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group. If the key value(s) specified in
+// the supplied SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop already exist in the list, an error is
+// returned.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group) AppendNexthop(v *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop) error {
+	if v.Index == nil {
+		return fmt.Errorf("invalid nil key received for Index")
+	}
+
+	key := *v.Index
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Nexthop == nil {
+		t.Nexthop = make(map[uint16]*SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop)
+	}
+
+	if _, ok := t.Nexthop[key]; ok {
+		return fmt.Errorf("duplicate key for list Nexthop %v", key)
+	}
+
+	t.Nexthop[key] = v
+	return nil
+}
+
+// ΛListKeyMap returns the keys of the SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group struct, which is a YANG list entry.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop represents the /srl_nokia-network-instance/network-instance/next-hop-groups/group/nexthop YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop struct {
+	AdminState E_SrlNokiaNextHopGroups_AdminState `path:"admin-state" module:"srl_nokia-next-hop-groups"`
+	Index      *uint16                            `path:"index" module:"srl_nokia-next-hop-groups"`
+	IpAddress  *string                            `path:"ip-address" module:"srl_nokia-next-hop-groups"`
+	Resolve    *bool                              `path:"resolve" module:"srl_nokia-next-hop-groups"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop struct, which is a YANG list entry.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Index == nil {
+		return nil, fmt.Errorf("nil value for key Index")
+	}
+
+	return map[string]interface{}{
+		"index": *t.Index,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_NextHopGroups_Group_Nexthop) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_Protocols represents the /srl_nokia-network-instance/network-instance/protocols YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_Protocols struct {
+	Bgp *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp `path:"bgp" module:"srl_nokia-bgp"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_Protocols implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_Protocols) IsYANGGoStruct() {}
+
+// GetOrCreateBgp retrieves the value of the Bgp field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols) GetOrCreateBgp() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp {
+	if t.Bgp != nil {
+		return t.Bgp
+	}
+	t.Bgp = &SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp{}
+	return t.Bgp
+}
+
+// GetBgp returns the value of the Bgp struct pointer
+// from SrlNokiaNetworkInstance_NetworkInstance_Protocols. If the receiver or the field Bgp is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols) GetBgp() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp {
+	if t != nil && t.Bgp != nil {
+		return t.Bgp
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_Protocols"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp represents the /srl_nokia-network-instance/network-instance/protocols/bgp YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp struct {
+	AdminState       E_SrlNokiaBgp_AdminState                                                   `path:"admin-state" module:"srl_nokia-bgp"`
+	AutonomousSystem *uint32                                                                    `path:"autonomous-system" module:"srl_nokia-bgp"`
+	ExportPolicy     *string                                                                    `path:"export-policy" module:"srl_nokia-bgp"`
+	Group            map[string]*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group    `path:"group" module:"srl_nokia-bgp"`
+	ImportPolicy     *string                                                                    `path:"import-policy" module:"srl_nokia-bgp"`
+	Ipv4Unicast      *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv4Unicast         `path:"ipv4-unicast" module:"srl_nokia-bgp"`
+	Ipv6Unicast      *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv6Unicast         `path:"ipv6-unicast" module:"srl_nokia-bgp"`
+	Neighbor         map[string]*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor `path:"neighbor" module:"srl_nokia-bgp"`
+	OperState        E_SrlNokiaBgp_OperState                                                    `path:"oper-state" module:"srl_nokia-bgp"`
+	RouterId         *string                                                                    `path:"router-id" module:"srl_nokia-bgp"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) IsYANGGoStruct() {}
+
+// NewGroup creates a new entry in the Group list of the
+// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) NewGroup(GroupName string) (*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Group == nil {
+		t.Group = make(map[string]*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group)
+	}
+
+	key := GroupName
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Group[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Group", key)
+	}
+
+	t.Group[key] = &SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group{
+		GroupName: &GroupName,
+	}
+
+	return t.Group[key], nil
+}
+
+// GetOrCreateGroup retrieves the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) GetOrCreateGroup(GroupName string) *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group {
+
+	key := GroupName
+
+	if v, ok := t.Group[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewGroup(GroupName)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateGroup got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetGroup retrieves the value with the specified key from
+// the Group map field of SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) GetGroup(GroupName string) *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group {
+
+	if t == nil {
+		return nil
+	}
+
+	key := GroupName
+
+	if lm, ok := t.Group[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteGroup deletes the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) DeleteGroup(GroupName string) {
+	key := GroupName
+
+	delete(t.Group, key)
+}
+
+// AppendGroup appends the supplied SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group struct to the
+// list Group of SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp. If the key value(s) specified in
+// the supplied SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group already exist in the list, an error is
+// returned.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) AppendGroup(v *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group) error {
+	if v.GroupName == nil {
+		return fmt.Errorf("invalid nil key received for GroupName")
+	}
+
+	key := *v.GroupName
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Group == nil {
+		t.Group = make(map[string]*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group)
+	}
+
+	if _, ok := t.Group[key]; ok {
+		return fmt.Errorf("duplicate key for list Group %v", key)
+	}
+
+	t.Group[key] = v
+	return nil
+}
+
+// NewNeighbor creates a new entry in the Neighbor list of the
+// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) NewNeighbor(PeerAddress string) (*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Neighbor == nil {
+		t.Neighbor = make(map[string]*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor)
+	}
+
+	key := PeerAddress
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Neighbor[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Neighbor", key)
+	}
+
+	t.Neighbor[key] = &SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor{
+		PeerAddress: &PeerAddress,
+	}
+
+	return t.Neighbor[key], nil
+}
+
+// GetOrCreateNeighbor retrieves the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) GetOrCreateNeighbor(PeerAddress string) *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor {
+
+	key := PeerAddress
+
+	if v, ok := t.Neighbor[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewNeighbor(PeerAddress)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateNeighbor got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetNeighbor retrieves the value with the specified key from
+// the Neighbor map field of SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) GetNeighbor(PeerAddress string) *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor {
+
+	if t == nil {
+		return nil
+	}
+
+	key := PeerAddress
+
+	if lm, ok := t.Neighbor[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteNeighbor deletes the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) DeleteNeighbor(PeerAddress string) {
+	key := PeerAddress
+
+	delete(t.Neighbor, key)
+}
+
+// AppendNeighbor appends the supplied SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor struct to the
+// list Neighbor of SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp. If the key value(s) specified in
+// the supplied SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor already exist in the list, an error is
+// returned.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) AppendNeighbor(v *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor) error {
+	if v.PeerAddress == nil {
+		return fmt.Errorf("invalid nil key received for PeerAddress")
+	}
+
+	key := *v.PeerAddress
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Neighbor == nil {
+		t.Neighbor = make(map[string]*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor)
+	}
+
+	if _, ok := t.Neighbor[key]; ok {
+		return fmt.Errorf("duplicate key for list Neighbor %v", key)
+	}
+
+	t.Neighbor[key] = v
+	return nil
+}
+
+// GetOrCreateIpv4Unicast retrieves the value of the Ipv4Unicast field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) GetOrCreateIpv4Unicast() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv4Unicast {
+	if t.Ipv4Unicast != nil {
+		return t.Ipv4Unicast
+	}
+	t.Ipv4Unicast = &SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv4Unicast{}
+	return t.Ipv4Unicast
+}
+
+// GetOrCreateIpv6Unicast retrieves the value of the Ipv6Unicast field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) GetOrCreateIpv6Unicast() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv6Unicast {
+	if t.Ipv6Unicast != nil {
+		return t.Ipv6Unicast
+	}
+	t.Ipv6Unicast = &SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv6Unicast{}
+	return t.Ipv6Unicast
+}
+
+// GetIpv4Unicast returns the value of the Ipv4Unicast struct pointer
+// from SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp. If the receiver or the field Ipv4Unicast is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) GetIpv4Unicast() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv4Unicast {
+	if t != nil && t.Ipv4Unicast != nil {
+		return t.Ipv4Unicast
+	}
+	return nil
+}
+
+// GetIpv6Unicast returns the value of the Ipv6Unicast struct pointer
+// from SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp. If the receiver or the field Ipv6Unicast is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) GetIpv6Unicast() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv6Unicast {
+	if t != nil && t.Ipv6Unicast != nil {
+		return t.Ipv6Unicast
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group represents the /srl_nokia-network-instance/network-instance/protocols/bgp/group YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group struct {
+	AdminState   E_SrlNokiaBgp_AdminState                                                 `path:"admin-state" module:"srl_nokia-bgp"`
+	Description  *string                                                                  `path:"description" module:"srl_nokia-bgp"`
+	ExportPolicy *string                                                                  `path:"export-policy" module:"srl_nokia-bgp"`
+	GroupName    *string                                                                  `path:"group-name" module:"srl_nokia-bgp"`
+	ImportPolicy *string                                                                  `path:"import-policy" module:"srl_nokia-bgp"`
+	Ipv4Unicast  *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv4Unicast `path:"ipv4-unicast" module:"srl_nokia-bgp"`
+	Ipv6Unicast  *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv6Unicast `path:"ipv6-unicast" module:"srl_nokia-bgp"`
+	PeerAs       *uint32                                                                  `path:"peer-as" module:"srl_nokia-bgp"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group) IsYANGGoStruct() {}
+
+// GetOrCreateIpv4Unicast retrieves the value of the Ipv4Unicast field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group) GetOrCreateIpv4Unicast() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv4Unicast {
+	if t.Ipv4Unicast != nil {
+		return t.Ipv4Unicast
+	}
+	t.Ipv4Unicast = &SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv4Unicast{}
+	return t.Ipv4Unicast
+}
+
+// GetOrCreateIpv6Unicast retrieves the value of the Ipv6Unicast field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group) GetOrCreateIpv6Unicast() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv6Unicast {
+	if t.Ipv6Unicast != nil {
+		return t.Ipv6Unicast
+	}
+	t.Ipv6Unicast = &SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv6Unicast{}
+	return t.Ipv6Unicast
+}
+
+// GetIpv4Unicast returns the value of the Ipv4Unicast struct pointer
+// from SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group. If the receiver or the field Ipv4Unicast is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group) GetIpv4Unicast() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv4Unicast {
+	if t != nil && t.Ipv4Unicast != nil {
+		return t.Ipv4Unicast
+	}
+	return nil
+}
+
+// GetIpv6Unicast returns the value of the Ipv6Unicast struct pointer
+// from SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group. If the receiver or the field Ipv6Unicast is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group) GetIpv6Unicast() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv6Unicast {
+	if t != nil && t.Ipv6Unicast != nil {
+		return t.Ipv6Unicast
+	}
+	return nil
+}
+
+// ΛListKeyMap returns the keys of the SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group struct, which is a YANG list entry.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.GroupName == nil {
+		return nil, fmt.Errorf("nil value for key GroupName")
+	}
+
+	return map[string]interface{}{
+		"group-name": *t.GroupName,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv4Unicast represents the /srl_nokia-network-instance/network-instance/protocols/bgp/group/ipv4-unicast YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv4Unicast struct {
+	ActiveRoutes   *uint32                  `path:"active-routes" module:"srl_nokia-bgp"`
+	AdminState     E_SrlNokiaBgp_AdminState `path:"admin-state" module:"srl_nokia-bgp"`
+	ReceivedRoutes *uint32                  `path:"received-routes" module:"srl_nokia-bgp"`
+	SentRoutes     *uint32                  `path:"sent-routes" module:"srl_nokia-bgp"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv4Unicast implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv4Unicast) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv4Unicast) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv4Unicast"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv4Unicast) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv6Unicast represents the /srl_nokia-network-instance/network-instance/protocols/bgp/group/ipv6-unicast YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv6Unicast struct {
+	ActiveRoutes   *uint32                  `path:"active-routes" module:"srl_nokia-bgp"`
+	AdminState     E_SrlNokiaBgp_AdminState `path:"admin-state" module:"srl_nokia-bgp"`
+	ReceivedRoutes *uint32                  `path:"received-routes" module:"srl_nokia-bgp"`
+	SentRoutes     *uint32                  `path:"sent-routes" module:"srl_nokia-bgp"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv6Unicast implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv6Unicast) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv6Unicast) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv6Unicast"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Group_Ipv6Unicast) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv4Unicast represents the /srl_nokia-network-instance/network-instance/protocols/bgp/ipv4-unicast YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv4Unicast struct {
+	ActiveRoutes   *uint32                  `path:"active-routes" module:"srl_nokia-bgp"`
+	AdminState     E_SrlNokiaBgp_AdminState `path:"admin-state" module:"srl_nokia-bgp"`
+	ReceivedRoutes *uint32                  `path:"received-routes" module:"srl_nokia-bgp"`
+	SentRoutes     *uint32                  `path:"sent-routes" module:"srl_nokia-bgp"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv4Unicast implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv4Unicast) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv4Unicast) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv4Unicast"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv4Unicast) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv6Unicast represents the /srl_nokia-network-instance/network-instance/protocols/bgp/ipv6-unicast YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv6Unicast struct {
+	ActiveRoutes   *uint32                  `path:"active-routes" module:"srl_nokia-bgp"`
+	AdminState     E_SrlNokiaBgp_AdminState `path:"admin-state" module:"srl_nokia-bgp"`
+	ReceivedRoutes *uint32                  `path:"received-routes" module:"srl_nokia-bgp"`
+	SentRoutes     *uint32                  `path:"sent-routes" module:"srl_nokia-bgp"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv6Unicast implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv6Unicast) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv6Unicast) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv6Unicast"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Ipv6Unicast) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor represents the /srl_nokia-network-instance/network-instance/protocols/bgp/neighbor YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor struct {
+	AdminState      E_SrlNokiaBgp_AdminState                                                      `path:"admin-state" module:"srl_nokia-bgp"`
+	Description     *string                                                                       `path:"description" module:"srl_nokia-bgp"`
+	ExportPolicy    *string                                                                       `path:"export-policy" module:"srl_nokia-bgp"`
+	ImportPolicy    *string                                                                       `path:"import-policy" module:"srl_nokia-bgp"`
+	Ipv4Unicast     *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv4Unicast   `path:"ipv4-unicast" module:"srl_nokia-bgp"`
+	Ipv6Unicast     *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv6Unicast   `path:"ipv6-unicast" module:"srl_nokia-bgp"`
+	LastEstablished *string                                                                       `path:"last-established" module:"srl_nokia-bgp"`
+	LastState       *string                                                                       `path:"last-state" module:"srl_nokia-bgp"`
+	PeerAddress     *string                                                                       `path:"peer-address" module:"srl_nokia-bgp"`
+	PeerAs          *uint32                                                                       `path:"peer-as" module:"srl_nokia-bgp"`
+	PeerGroup       *string                                                                       `path:"peer-group" module:"srl_nokia-bgp"`
+	SessionState    E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState `path:"session-state" module:"srl_nokia-bgp"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor) IsYANGGoStruct() {}
+
+// GetOrCreateIpv4Unicast retrieves the value of the Ipv4Unicast field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor) GetOrCreateIpv4Unicast() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv4Unicast {
+	if t.Ipv4Unicast != nil {
+		return t.Ipv4Unicast
+	}
+	t.Ipv4Unicast = &SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv4Unicast{}
+	return t.Ipv4Unicast
+}
+
+// GetOrCreateIpv6Unicast retrieves the value of the Ipv6Unicast field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor) GetOrCreateIpv6Unicast() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv6Unicast {
+	if t.Ipv6Unicast != nil {
+		return t.Ipv6Unicast
+	}
+	t.Ipv6Unicast = &SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv6Unicast{}
+	return t.Ipv6Unicast
+}
+
+// GetIpv4Unicast returns the value of the Ipv4Unicast struct pointer
+// from SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor. If the receiver or the field Ipv4Unicast is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor) GetIpv4Unicast() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv4Unicast {
+	if t != nil && t.Ipv4Unicast != nil {
+		return t.Ipv4Unicast
+	}
+	return nil
+}
+
+// GetIpv6Unicast returns the value of the Ipv6Unicast struct pointer
+// from SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor. If the receiver or the field Ipv6Unicast is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor) GetIpv6Unicast() *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv6Unicast {
+	if t != nil && t.Ipv6Unicast != nil {
+		return t.Ipv6Unicast
+	}
+	return nil
+}
+
+// ΛListKeyMap returns the keys of the SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor struct, which is a YANG list entry.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.PeerAddress == nil {
+		return nil, fmt.Errorf("nil value for key PeerAddress")
+	}
+
+	return map[string]interface{}{
+		"peer-address": *t.PeerAddress,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv4Unicast represents the /srl_nokia-network-instance/network-instance/protocols/bgp/neighbor/ipv4-unicast YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv4Unicast struct {
+	ActiveRoutes   *uint32                  `path:"active-routes" module:"srl_nokia-bgp"`
+	AdminState     E_SrlNokiaBgp_AdminState `path:"admin-state" module:"srl_nokia-bgp"`
+	ReceivedRoutes *uint32                  `path:"received-routes" module:"srl_nokia-bgp"`
+	SentRoutes     *uint32                  `path:"sent-routes" module:"srl_nokia-bgp"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv4Unicast implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv4Unicast) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv4Unicast) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv4Unicast"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv4Unicast) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv6Unicast represents the /srl_nokia-network-instance/network-instance/protocols/bgp/neighbor/ipv6-unicast YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv6Unicast struct {
+	ActiveRoutes   *uint32                  `path:"active-routes" module:"srl_nokia-bgp"`
+	AdminState     E_SrlNokiaBgp_AdminState `path:"admin-state" module:"srl_nokia-bgp"`
+	ReceivedRoutes *uint32                  `path:"received-routes" module:"srl_nokia-bgp"`
+	SentRoutes     *uint32                  `path:"sent-routes" module:"srl_nokia-bgp"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv6Unicast implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv6Unicast) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv6Unicast) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv6Unicast"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_Ipv6Unicast) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_RouteTable represents the /srl_nokia-network-instance/network-instance/route-table YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_RouteTable struct {
+	Ipv4Unicast *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast `path:"ipv4-unicast" module:"srl_nokia-ip-route-tables"`
+	Ipv6Unicast *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast `path:"ipv6-unicast" module:"srl_nokia-ip-route-tables"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_RouteTable implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_RouteTable) IsYANGGoStruct() {}
+
+// GetOrCreateIpv4Unicast retrieves the value of the Ipv4Unicast field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable) GetOrCreateIpv4Unicast() *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast {
+	if t.Ipv4Unicast != nil {
+		return t.Ipv4Unicast
+	}
+	t.Ipv4Unicast = &SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast{}
+	return t.Ipv4Unicast
+}
+
+// GetOrCreateIpv6Unicast retrieves the value of the Ipv6Unicast field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable) GetOrCreateIpv6Unicast() *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast {
+	if t.Ipv6Unicast != nil {
+		return t.Ipv6Unicast
+	}
+	t.Ipv6Unicast = &SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast{}
+	return t.Ipv6Unicast
+}
+
+// GetIpv4Unicast returns the value of the Ipv4Unicast struct pointer
+// from SrlNokiaNetworkInstance_NetworkInstance_RouteTable. If the receiver or the field Ipv4Unicast is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable) GetIpv4Unicast() *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast {
+	if t != nil && t.Ipv4Unicast != nil {
+		return t.Ipv4Unicast
+	}
+	return nil
+}
+
+// GetIpv6Unicast returns the value of the Ipv6Unicast struct pointer
+// from SrlNokiaNetworkInstance_NetworkInstance_RouteTable. If the receiver or the field Ipv6Unicast is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable) GetIpv6Unicast() *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast {
+	if t != nil && t.Ipv6Unicast != nil {
+		return t.Ipv6Unicast
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_RouteTable"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast represents the /srl_nokia-network-instance/network-instance/route-table/ipv4-unicast YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast struct {
+	Route map[SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route_Key]*SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route `path:"route" module:"srl_nokia-ip-route-tables"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast) IsYANGGoStruct() {}
+
+// SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route_Key represents the key for list Route of element /srl_nokia-network-instance/network-instance/route-table/ipv4-unicast.
+type SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route_Key struct {
+	Ipv4Prefix string                       `path:"ipv4-prefix"`
+	Id         uint16                       `path:"id"`
+	RouteType  E_SrlNokiaCommon_IpRouteType `path:"route-type"`
+	RouteOwner string                       `path:"route-owner"`
+}
+
+// NewRoute creates a new entry in the Route list of the
+// SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast) NewRoute(Ipv4Prefix string, Id uint16, RouteType E_SrlNokiaCommon_IpRouteType, RouteOwner string) (*SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Route == nil {
+		t.Route = make(map[SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route_Key]*SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route)
+	}
+
+	key := SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route_Key{
+		Ipv4Prefix: Ipv4Prefix,
+		Id:         Id,
+		RouteType:  RouteType,
+		RouteOwner: RouteOwner,
+	}
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Route[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Route", key)
+	}
+
+	t.Route[key] = &SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route{
+		Ipv4Prefix: &Ipv4Prefix,
+		Id:         &Id,
+		RouteType:  RouteType,
+		RouteOwner: &RouteOwner,
+	}
+
+	return t.Route[key], nil
+}
+
+// GetOrCreateRoute retrieves the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast) GetOrCreateRoute(Ipv4Prefix string, Id uint16, RouteType E_SrlNokiaCommon_IpRouteType, RouteOwner string) *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route {
+
+	key := SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route_Key{
+		Ipv4Prefix: Ipv4Prefix,
+		Id:         Id,
+		RouteType:  RouteType,
+		RouteOwner: RouteOwner,
+	}
+
+	if v, ok := t.Route[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewRoute(Ipv4Prefix, Id, RouteType, RouteOwner)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateRoute got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetRoute retrieves the value with the specified key from
+// the Route map field of SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast) GetRoute(Ipv4Prefix string, Id uint16, RouteType E_SrlNokiaCommon_IpRouteType, RouteOwner string) *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route {
+
+	if t == nil {
+		return nil
+	}
+
+	key := SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route_Key{
+		Ipv4Prefix: Ipv4Prefix,
+		Id:         Id,
+		RouteType:  RouteType,
+		RouteOwner: RouteOwner,
+	}
+
+	if lm, ok := t.Route[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteRoute deletes the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast) DeleteRoute(Ipv4Prefix string, Id uint16, RouteType E_SrlNokiaCommon_IpRouteType, RouteOwner string) {
+	key := SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route_Key{
+		Ipv4Prefix: Ipv4Prefix,
+		Id:         Id,
+		RouteType:  RouteType,
+		RouteOwner: RouteOwner,
+	}
+
+	delete(t.Route, key)
+}
+
+// AppendRoute appends the supplied SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route struct to the
+// list Route of SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast. If the key value(s) specified in
+// the supplied SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route already exist in the list, an error is
+// returned.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast) AppendRoute(v *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route) error {
+	if v.Ipv4Prefix == nil {
+		return fmt.Errorf("invalid nil key for Ipv4Prefix")
+	}
+
+	if v.Id == nil {
+		return fmt.Errorf("invalid nil key for Id")
+	}
+
+	if v.RouteOwner == nil {
+		return fmt.Errorf("invalid nil key for RouteOwner")
+	}
+
+	key := SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route_Key{
+		Ipv4Prefix: *v.Ipv4Prefix,
+		Id:         *v.Id,
+		RouteType:  v.RouteType,
+		RouteOwner: *v.RouteOwner,
+	}
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Route == nil {
+		t.Route = make(map[SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route_Key]*SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route)
+	}
+
+	if _, ok := t.Route[key]; ok {
+		return fmt.Errorf("duplicate key for list Route %v", key)
+	}
+
+	t.Route[key] = v
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route represents the /srl_nokia-network-instance/network-instance/route-table/ipv4-unicast/route YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route struct {
+	Active       *bool                        `path:"active" module:"srl_nokia-ip-route-tables"`
+	Id           *uint16                      `path:"id" module:"srl_nokia-ip-route-tables"`
+	Ipv4Prefix   *string                      `path:"ipv4-prefix" module:"srl_nokia-ip-route-tables"`
+	Metric       *uint32                      `path:"metric" module:"srl_nokia-ip-route-tables"`
+	NextHopGroup *uint64                      `path:"next-hop-group" module:"srl_nokia-ip-route-tables"`
+	Preference   *uint8                       `path:"preference" module:"srl_nokia-ip-route-tables"`
+	RouteOwner   *string                      `path:"route-owner" module:"srl_nokia-ip-route-tables"`
+	RouteType    E_SrlNokiaCommon_IpRouteType `path:"route-type" module:"srl_nokia-ip-route-tables"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route struct, which is a YANG list entry.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Id == nil {
+		return nil, fmt.Errorf("nil value for key Id")
+	}
+
+	if t.Ipv4Prefix == nil {
+		return nil, fmt.Errorf("nil value for key Ipv4Prefix")
+	}
+
+	if t.RouteOwner == nil {
+		return nil, fmt.Errorf("nil value for key RouteOwner")
+	}
+
+	return map[string]interface{}{
+		"id":          *t.Id,
+		"ipv4-prefix": *t.Ipv4Prefix,
+		"route-owner": *t.RouteOwner,
+		"route-type":  t.RouteType,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv4Unicast_Route) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast represents the /srl_nokia-network-instance/network-instance/route-table/ipv6-unicast YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast struct {
+	Route map[SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route_Key]*SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route `path:"route" module:"srl_nokia-ip-route-tables"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast) IsYANGGoStruct() {}
+
+// SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route_Key represents the key for list Route of element /srl_nokia-network-instance/network-instance/route-table/ipv6-unicast.
+type SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route_Key struct {
+	Ipv6Prefix string                       `path:"ipv6-prefix"`
+	Id         uint16                       `path:"id"`
+	RouteType  E_SrlNokiaCommon_IpRouteType `path:"route-type"`
+	RouteOwner string                       `path:"route-owner"`
+}
+
+// NewRoute creates a new entry in the Route list of the
+// SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast) NewRoute(Ipv6Prefix string, Id uint16, RouteType E_SrlNokiaCommon_IpRouteType, RouteOwner string) (*SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Route == nil {
+		t.Route = make(map[SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route_Key]*SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route)
+	}
+
+	key := SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route_Key{
+		Ipv6Prefix: Ipv6Prefix,
+		Id:         Id,
+		RouteType:  RouteType,
+		RouteOwner: RouteOwner,
+	}
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Route[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Route", key)
+	}
+
+	t.Route[key] = &SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route{
+		Ipv6Prefix: &Ipv6Prefix,
+		Id:         &Id,
+		RouteType:  RouteType,
+		RouteOwner: &RouteOwner,
+	}
+
+	return t.Route[key], nil
+}
+
+// GetOrCreateRoute retrieves the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast) GetOrCreateRoute(Ipv6Prefix string, Id uint16, RouteType E_SrlNokiaCommon_IpRouteType, RouteOwner string) *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route {
+
+	key := SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route_Key{
+		Ipv6Prefix: Ipv6Prefix,
+		Id:         Id,
+		RouteType:  RouteType,
+		RouteOwner: RouteOwner,
+	}
+
+	if v, ok := t.Route[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewRoute(Ipv6Prefix, Id, RouteType, RouteOwner)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateRoute got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetRoute retrieves the value with the specified key from
+// the Route map field of SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast) GetRoute(Ipv6Prefix string, Id uint16, RouteType E_SrlNokiaCommon_IpRouteType, RouteOwner string) *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route {
+
+	if t == nil {
+		return nil
+	}
+
+	key := SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route_Key{
+		Ipv6Prefix: Ipv6Prefix,
+		Id:         Id,
+		RouteType:  RouteType,
+		RouteOwner: RouteOwner,
+	}
+
+	if lm, ok := t.Route[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteRoute deletes the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast) DeleteRoute(Ipv6Prefix string, Id uint16, RouteType E_SrlNokiaCommon_IpRouteType, RouteOwner string) {
+	key := SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route_Key{
+		Ipv6Prefix: Ipv6Prefix,
+		Id:         Id,
+		RouteType:  RouteType,
+		RouteOwner: RouteOwner,
+	}
+
+	delete(t.Route, key)
+}
+
+// AppendRoute appends the supplied SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route struct to the
+// list Route of SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast. If the key value(s) specified in
+// the supplied SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route already exist in the list, an error is
+// returned.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast) AppendRoute(v *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route) error {
+	if v.Ipv6Prefix == nil {
+		return fmt.Errorf("invalid nil key for Ipv6Prefix")
+	}
+
+	if v.Id == nil {
+		return fmt.Errorf("invalid nil key for Id")
+	}
+
+	if v.RouteOwner == nil {
+		return fmt.Errorf("invalid nil key for RouteOwner")
+	}
+
+	key := SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route_Key{
+		Ipv6Prefix: *v.Ipv6Prefix,
+		Id:         *v.Id,
+		RouteType:  v.RouteType,
+		RouteOwner: *v.RouteOwner,
+	}
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Route == nil {
+		t.Route = make(map[SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route_Key]*SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route)
+	}
+
+	if _, ok := t.Route[key]; ok {
+		return fmt.Errorf("duplicate key for list Route %v", key)
+	}
+
+	t.Route[key] = v
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route represents the /srl_nokia-network-instance/network-instance/route-table/ipv6-unicast/route YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route struct {
+	Active       *bool                        `path:"active" module:"srl_nokia-ip-route-tables"`
+	Id           *uint16                      `path:"id" module:"srl_nokia-ip-route-tables"`
+	Ipv6Prefix   *string                      `path:"ipv6-prefix" module:"srl_nokia-ip-route-tables"`
+	Metric       *uint32                      `path:"metric" module:"srl_nokia-ip-route-tables"`
+	NextHopGroup *uint64                      `path:"next-hop-group" module:"srl_nokia-ip-route-tables"`
+	Preference   *uint8                       `path:"preference" module:"srl_nokia-ip-route-tables"`
+	RouteOwner   *string                      `path:"route-owner" module:"srl_nokia-ip-route-tables"`
+	RouteType    E_SrlNokiaCommon_IpRouteType `path:"route-type" module:"srl_nokia-ip-route-tables"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route struct, which is a YANG list entry.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Id == nil {
+		return nil, fmt.Errorf("nil value for key Id")
+	}
+
+	if t.Ipv6Prefix == nil {
+		return nil, fmt.Errorf("nil value for key Ipv6Prefix")
+	}
+
+	if t.RouteOwner == nil {
+		return nil, fmt.Errorf("nil value for key RouteOwner")
+	}
+
+	return map[string]interface{}{
+		"id":          *t.Id,
+		"ipv6-prefix": *t.Ipv6Prefix,
+		"route-owner": *t.RouteOwner,
+		"route-type":  t.RouteType,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_RouteTable_Ipv6Unicast_Route) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes represents the /srl_nokia-network-instance/network-instance/static-routes YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes struct {
+	Route map[string]*SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route `path:"route" module:"srl_nokia-static-routes"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes) IsYANGGoStruct() {}
+
+// NewRoute creates a new entry in the Route list of the
+// SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes) NewRoute(Prefix string) (*SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Route == nil {
+		t.Route = make(map[string]*SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route)
+	}
+
+	key := Prefix
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Route[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Route", key)
+	}
+
+	t.Route[key] = &SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route{
+		Prefix: &Prefix,
+	}
+
+	return t.Route[key], nil
+}
+
+// GetOrCreateRoute retrieves the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes) GetOrCreateRoute(Prefix string) *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route {
+
+	key := Prefix
+
+	if v, ok := t.Route[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewRoute(Prefix)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateRoute got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetRoute retrieves the value with the specified key from
+// the Route map field of SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes) GetRoute(Prefix string) *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Prefix
+
+	if lm, ok := t.Route[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteRoute deletes the value with the specified keys from
+// the receiver SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes) DeleteRoute(Prefix string) {
+	key := Prefix
+
+	delete(t.Route, key)
+}
+
+// AppendRoute appends the supplied SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route struct to the
+// list Route of SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes. If the key value(s) specified in
+// the supplied SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route already exist in the list, an error is
+// returned.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes) AppendRoute(v *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route) error {
+	if v.Prefix == nil {
+		return fmt.Errorf("invalid nil key received for Prefix")
+	}
+
+	key := *v.Prefix
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Route == nil {
+		t.Route = make(map[string]*SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route)
+	}
+
+	if _, ok := t.Route[key]; ok {
+		return fmt.Errorf("duplicate key for list Route %v", key)
+	}
+
+	t.Route[key] = v
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route represents the /srl_nokia-network-instance/network-instance/static-routes/route YANG schema element.
+type SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route struct {
+	AdminState   E_SrlNokiaStaticRoutes_AdminState `path:"admin-state" module:"srl_nokia-static-routes"`
+	Metric       *uint32                           `path:"metric" module:"srl_nokia-static-routes"`
+	NextHopGroup *string                           `path:"next-hop-group" module:"srl_nokia-static-routes"`
+	Preference   *uint8                            `path:"preference" module:"srl_nokia-static-routes"`
+	Prefix       *string                           `path:"prefix" module:"srl_nokia-static-routes"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route struct, which is a YANG list entry.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Prefix == nil {
+		return nil, fmt.Errorf("nil value for key Prefix")
+	}
+
+	return map[string]interface{}{
+		"prefix": *t.Prefix,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaNetworkInstance_NetworkInstance_StaticRoutes_Route) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// SrlNokiaSystem_System represents the /srl_nokia-system/system YANG schema element.
+type SrlNokiaSystem_System struct {
+	Ntp *SrlNokiaSystem_System_Ntp `path:"ntp" module:"srl_nokia-ntp"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaSystem_System implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaSystem_System) IsYANGGoStruct() {}
+
+// GetOrCreateNtp retrieves the value of the Ntp field
+// or returns the existing field if it already exists.
+func (t *SrlNokiaSystem_System) GetOrCreateNtp() *SrlNokiaSystem_System_Ntp {
+	if t.Ntp != nil {
+		return t.Ntp
+	}
+	t.Ntp = &SrlNokiaSystem_System_Ntp{}
+	return t.Ntp
+}
+
+// GetNtp returns the value of the Ntp struct pointer
+// from SrlNokiaSystem_System. If the receiver or the field Ntp is nil, nil
+// is returned such that the Get* methods can be safely chained.
+func (t *SrlNokiaSystem_System) GetNtp() *SrlNokiaSystem_System_Ntp {
+	if t != nil && t.Ntp != nil {
+		return t.Ntp
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaSystem_System) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaSystem_System"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaSystem_System) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// SrlNokiaSystem_System_Ntp represents the /srl_nokia-system/system/ntp YANG schema element.
+type SrlNokiaSystem_System_Ntp struct {
+	AdminState      E_SrlNokiaNtp_AdminState                     `path:"admin-state" module:"srl_nokia-ntp"`
+	NetworkInstance *string                                      `path:"network-instance" module:"srl_nokia-ntp"`
+	OperState       E_SrlNokiaNtp_OperState                      `path:"oper-state" module:"srl_nokia-ntp"`
+	Server          map[string]*SrlNokiaSystem_System_Ntp_Server `path:"server" module:"srl_nokia-ntp"`
+	Synchronized    *string                                      `path:"synchronized" module:"srl_nokia-ntp"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaSystem_System_Ntp implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaSystem_System_Ntp) IsYANGGoStruct() {}
+
+// NewServer creates a new entry in the Server list of the
+// SrlNokiaSystem_System_Ntp struct. The keys of the list are populated from the input
+// arguments.
+func (t *SrlNokiaSystem_System_Ntp) NewServer(Address string) (*SrlNokiaSystem_System_Ntp_Server, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Server == nil {
+		t.Server = make(map[string]*SrlNokiaSystem_System_Ntp_Server)
+	}
+
+	key := Address
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.Server[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list Server", key)
+	}
+
+	t.Server[key] = &SrlNokiaSystem_System_Ntp_Server{
+		Address: &Address,
+	}
+
+	return t.Server[key], nil
+}
+
+// GetOrCreateServer retrieves the value with the specified keys from
+// the receiver SrlNokiaSystem_System_Ntp. If the entry does not exist, then it is created.
+// It returns the existing or new list member.
+func (t *SrlNokiaSystem_System_Ntp) GetOrCreateServer(Address string) *SrlNokiaSystem_System_Ntp_Server {
+
+	key := Address
+
+	if v, ok := t.Server[key]; ok {
+		return v
+	}
+	// Panic if we receive an error, since we should have retrieved an existing
+	// list member. This allows chaining of GetOrCreate methods.
+	v, err := t.NewServer(Address)
+	if err != nil {
+		panic(fmt.Sprintf("GetOrCreateServer got unexpected error: %v", err))
+	}
+	return v
+}
+
+// GetServer retrieves the value with the specified key from
+// the Server map field of SrlNokiaSystem_System_Ntp. If the receiver is nil, or
+// the specified key is not present in the list, nil is returned such that Get*
+// methods may be safely chained.
+func (t *SrlNokiaSystem_System_Ntp) GetServer(Address string) *SrlNokiaSystem_System_Ntp_Server {
+
+	if t == nil {
+		return nil
+	}
+
+	key := Address
+
+	if lm, ok := t.Server[key]; ok {
+		return lm
+	}
+	return nil
+}
+
+// DeleteServer deletes the value with the specified keys from
+// the receiver SrlNokiaSystem_System_Ntp. If there is no such element, the function
+// is a no-op.
+func (t *SrlNokiaSystem_System_Ntp) DeleteServer(Address string) {
+	key := Address
+
+	delete(t.Server, key)
+}
+
+// AppendServer appends the supplied SrlNokiaSystem_System_Ntp_Server struct to the
+// list Server of SrlNokiaSystem_System_Ntp. If the key value(s) specified in
+// the supplied SrlNokiaSystem_System_Ntp_Server already exist in the list, an error is
+// returned.
+func (t *SrlNokiaSystem_System_Ntp) AppendServer(v *SrlNokiaSystem_System_Ntp_Server) error {
+	if v.Address == nil {
+		return fmt.Errorf("invalid nil key received for Address")
+	}
+
+	key := *v.Address
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.Server == nil {
+		t.Server = make(map[string]*SrlNokiaSystem_System_Ntp_Server)
+	}
+
+	if _, ok := t.Server[key]; ok {
+		return fmt.Errorf("duplicate key for list Server %v", key)
+	}
+
+	t.Server[key] = v
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaSystem_System_Ntp) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaSystem_System_Ntp"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaSystem_System_Ntp) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// SrlNokiaSystem_System_Ntp_Server represents the /srl_nokia-system/system/ntp/server YANG schema element.
+type SrlNokiaSystem_System_Ntp_Server struct {
+	Address      *string  `path:"address" module:"srl_nokia-ntp"`
+	Iburst       *bool    `path:"iburst" module:"srl_nokia-ntp"`
+	Jitter       *float64 `path:"jitter" module:"srl_nokia-ntp"`
+	Offset       *float64 `path:"offset" module:"srl_nokia-ntp"`
+	PollInterval *uint16  `path:"poll-interval" module:"srl_nokia-ntp"`
+	Prefer       *bool    `path:"prefer" module:"srl_nokia-ntp"`
+	Stratum      *uint8   `path:"stratum" module:"srl_nokia-ntp"`
+}
+
+// IsYANGGoStruct ensures that SrlNokiaSystem_System_Ntp_Server implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*SrlNokiaSystem_System_Ntp_Server) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the SrlNokiaSystem_System_Ntp_Server struct, which is a YANG list entry.
+func (t *SrlNokiaSystem_System_Ntp_Server) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Address == nil {
+		return nil, fmt.Errorf("nil value for key Address")
+	}
+
+	return map[string]interface{}{
+		"address": *t.Address,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *SrlNokiaSystem_System_Ntp_Server) Validate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["SrlNokiaSystem_System_Ntp_Server"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *SrlNokiaSystem_System_Ntp_Server) ΛEnumTypeMap() map[string][]reflect.Type {
+	return ΛEnumTypes
+}
+
+// E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator. An additional value named
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator int64
+
+// IsYANGGoEnum ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator implements the yang.GoEnum
+// interface. This ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator.
+func (E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator.
+func (e E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator")
+}
+
+const (
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator_UNSET corresponds to the value UNSET of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator_UNSET E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator = 0
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator_eq corresponds to the value eq of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator_eq E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator = 1
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator_ge corresponds to the value ge of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator_ge E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator = 2
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator_le corresponds to the value le of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator_le E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator = 3
+)
+
+// E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol. An additional value named
+// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol int64
+
+// IsYANGGoEnum ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol implements the yang.GoEnum
+// interface. This ensures that SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol.
+func (E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol.
+func (e E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol")
+}
+
+const (
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_UNSET corresponds to the value UNSET of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_UNSET E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol = 0
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_icmp corresponds to the value icmp of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_icmp E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol = 1
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_igmp corresponds to the value igmp of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_igmp E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol = 2
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_tcp corresponds to the value tcp of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_tcp E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol = 3
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_udp corresponds to the value udp of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_udp E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol = 4
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_gre corresponds to the value gre of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_gre E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol = 5
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_esp corresponds to the value esp of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_esp E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol = 6
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_ah corresponds to the value ah of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_ah E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol = 7
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_icmp6 corresponds to the value icmp6 of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_icmp6 E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol = 8
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_ospf corresponds to the value ospf of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_ospf E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol = 9
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_pim corresponds to the value pim of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_pim E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol = 10
+	// SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_vrrp corresponds to the value vrrp of SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol
+	SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_vrrp E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol = 11
+)
+
+// E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader. An additional value named
+// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader int64
+
+// IsYANGGoEnum ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader implements the yang.GoEnum
+// interface. This ensures that SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader.
+func (E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader.
+func (e E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader")
+}
+
+const (
+	// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_UNSET corresponds to the value UNSET of SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+	SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_UNSET E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader = 0
+	// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_icmp corresponds to the value icmp of SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+	SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_icmp E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader = 1
+	// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_igmp corresponds to the value igmp of SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+	SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_igmp E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader = 2
+	// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_tcp corresponds to the value tcp of SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+	SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_tcp E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader = 3
+	// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_udp corresponds to the value udp of SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+	SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_udp E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader = 4
+	// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_gre corresponds to the value gre of SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+	SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_gre E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader = 5
+	// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_esp corresponds to the value esp of SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+	SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_esp E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader = 6
+	// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_ah corresponds to the value ah of SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+	SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_ah E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader = 7
+	// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_icmp6 corresponds to the value icmp6 of SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+	SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_icmp6 E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader = 8
+	// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_ospf corresponds to the value ospf of SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+	SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_ospf E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader = 9
+	// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_pim corresponds to the value pim of SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+	SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_pim E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader = 10
+	// SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_vrrp corresponds to the value vrrp of SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader
+	SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_vrrp E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader = 11
+)
+
+// E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype. An additional value named
+// SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype int64
+
+// IsYANGGoEnum ensures that SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype implements the yang.GoEnum
+// interface. This ensures that SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype.
+func (E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype.
+func (e E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype")
+}
+
+const (
+	// SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_UNSET corresponds to the value UNSET of SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype
+	SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_UNSET E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype = 0
+	// SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_arp corresponds to the value arp of SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype
+	SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_arp E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype = 1
+	// SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_ipv4 corresponds to the value ipv4 of SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype
+	SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_ipv4 E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype = 2
+	// SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_ipv6 corresponds to the value ipv6 of SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype
+	SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_ipv6 E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype = 3
+	// SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_lldp corresponds to the value lldp of SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype
+	SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_lldp E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype = 4
+	// SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_mpls_unicast corresponds to the value mpls_unicast of SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype
+	SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_mpls_unicast E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype = 5
+	// SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_mpls_multicast corresponds to the value mpls_multicast of SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype
+	SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_mpls_multicast E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype = 6
+	// SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_pbb corresponds to the value pbb of SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype
+	SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_pbb E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype = 7
+	// SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_slow_protocols corresponds to the value slow_protocols of SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype
+	SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_slow_protocols E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype = 8
+)
+
+// E_SrlNokiaBgp_AdminState is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaBgp_AdminState. An additional value named
+// SrlNokiaBgp_AdminState_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaBgp_AdminState int64
+
+// IsYANGGoEnum ensures that SrlNokiaBgp_AdminState implements the yang.GoEnum
+// interface. This ensures that SrlNokiaBgp_AdminState can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaBgp_AdminState) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaBgp_AdminState.
+func (E_SrlNokiaBgp_AdminState) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_SrlNokiaBgp_AdminState.
+func (e E_SrlNokiaBgp_AdminState) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaBgp_AdminState")
+}
+
+const (
+	// SrlNokiaBgp_AdminState_UNSET corresponds to the value UNSET of SrlNokiaBgp_AdminState
+	SrlNokiaBgp_AdminState_UNSET E_SrlNokiaBgp_AdminState = 0
+	// SrlNokiaBgp_AdminState_enable corresponds to the value enable of SrlNokiaBgp_AdminState
+	SrlNokiaBgp_AdminState_enable E_SrlNokiaBgp_AdminState = 1
+	// SrlNokiaBgp_AdminState_disable corresponds to the value disable of SrlNokiaBgp_AdminState
+	SrlNokiaBgp_AdminState_disable E_SrlNokiaBgp_AdminState = 2
+)
+
+// E_SrlNokiaBgp_OperState is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaBgp_OperState. An additional value named
+// SrlNokiaBgp_OperState_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaBgp_OperState int64
+
+// IsYANGGoEnum ensures that SrlNokiaBgp_OperState implements the yang.GoEnum
+// interface. This ensures that SrlNokiaBgp_OperState can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaBgp_OperState) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaBgp_OperState.
+func (E_SrlNokiaBgp_OperState) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_SrlNokiaBgp_OperState.
+func (e E_SrlNokiaBgp_OperState) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaBgp_OperState")
+}
+
+const (
+	// SrlNokiaBgp_OperState_UNSET corresponds to the value UNSET of SrlNokiaBgp_OperState
+	SrlNokiaBgp_OperState_UNSET E_SrlNokiaBgp_OperState = 0
+	// SrlNokiaBgp_OperState_up corresponds to the value up of SrlNokiaBgp_OperState
+	SrlNokiaBgp_OperState_up E_SrlNokiaBgp_OperState = 1
+	// SrlNokiaBgp_OperState_down corresponds to the value down of SrlNokiaBgp_OperState
+	SrlNokiaBgp_OperState_down E_SrlNokiaBgp_OperState = 2
+	// SrlNokiaBgp_OperState_empty corresponds to the value empty of SrlNokiaBgp_OperState
+	SrlNokiaBgp_OperState_empty E_SrlNokiaBgp_OperState = 3
+	// SrlNokiaBgp_OperState_downloading corresponds to the value downloading of SrlNokiaBgp_OperState
+	SrlNokiaBgp_OperState_downloading E_SrlNokiaBgp_OperState = 4
+	// SrlNokiaBgp_OperState_booting corresponds to the value booting of SrlNokiaBgp_OperState
+	SrlNokiaBgp_OperState_booting E_SrlNokiaBgp_OperState = 5
+	// SrlNokiaBgp_OperState_starting corresponds to the value starting of SrlNokiaBgp_OperState
+	SrlNokiaBgp_OperState_starting E_SrlNokiaBgp_OperState = 6
+	// SrlNokiaBgp_OperState_failed corresponds to the value failed of SrlNokiaBgp_OperState
+	SrlNokiaBgp_OperState_failed E_SrlNokiaBgp_OperState = 7
+	// SrlNokiaBgp_OperState_synchronizing corresponds to the value synchronizing of SrlNokiaBgp_OperState
+	SrlNokiaBgp_OperState_synchronizing E_SrlNokiaBgp_OperState = 8
+	// SrlNokiaBgp_OperState_upgrading corresponds to the value upgrading of SrlNokiaBgp_OperState
+	SrlNokiaBgp_OperState_upgrading E_SrlNokiaBgp_OperState = 9
+)
+
+// E_SrlNokiaCommon_IpRouteType is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaCommon_IpRouteType. An additional value named
+// SrlNokiaCommon_IpRouteType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaCommon_IpRouteType int64
+
+// IsYANGGoEnum ensures that SrlNokiaCommon_IpRouteType implements the yang.GoEnum
+// interface. This ensures that SrlNokiaCommon_IpRouteType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaCommon_IpRouteType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaCommon_IpRouteType.
+func (E_SrlNokiaCommon_IpRouteType) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_SrlNokiaCommon_IpRouteType.
+func (e E_SrlNokiaCommon_IpRouteType) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaCommon_IpRouteType")
+}
+
+const (
+	// SrlNokiaCommon_IpRouteType_UNSET corresponds to the value UNSET of SrlNokiaCommon_IpRouteType
+	SrlNokiaCommon_IpRouteType_UNSET E_SrlNokiaCommon_IpRouteType = 0
+	// SrlNokiaCommon_IpRouteType_aggregate corresponds to the value aggregate of SrlNokiaCommon_IpRouteType
+	SrlNokiaCommon_IpRouteType_aggregate E_SrlNokiaCommon_IpRouteType = 1
+	// SrlNokiaCommon_IpRouteType_bgp corresponds to the value bgp of SrlNokiaCommon_IpRouteType
+	SrlNokiaCommon_IpRouteType_bgp E_SrlNokiaCommon_IpRouteType = 2
+	// SrlNokiaCommon_IpRouteType_host corresponds to the value host of SrlNokiaCommon_IpRouteType
+	SrlNokiaCommon_IpRouteType_host E_SrlNokiaCommon_IpRouteType = 3
+	// SrlNokiaCommon_IpRouteType_local corresponds to the value local of SrlNokiaCommon_IpRouteType
+	SrlNokiaCommon_IpRouteType_local E_SrlNokiaCommon_IpRouteType = 4
+	// SrlNokiaCommon_IpRouteType_static corresponds to the value static of SrlNokiaCommon_IpRouteType
+	SrlNokiaCommon_IpRouteType_static E_SrlNokiaCommon_IpRouteType = 5
+)
+
+// E_SrlNokiaInterfaces_AdminState is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaInterfaces_AdminState. An additional value named
+// SrlNokiaInterfaces_AdminState_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaInterfaces_AdminState int64
+
+// IsYANGGoEnum ensures that SrlNokiaInterfaces_AdminState implements the yang.GoEnum
+// interface. This ensures that SrlNokiaInterfaces_AdminState can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaInterfaces_AdminState) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaInterfaces_AdminState.
+func (E_SrlNokiaInterfaces_AdminState) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_SrlNokiaInterfaces_AdminState.
+func (e E_SrlNokiaInterfaces_AdminState) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaInterfaces_AdminState")
+}
+
+const (
+	// SrlNokiaInterfaces_AdminState_UNSET corresponds to the value UNSET of SrlNokiaInterfaces_AdminState
+	SrlNokiaInterfaces_AdminState_UNSET E_SrlNokiaInterfaces_AdminState = 0
+	// SrlNokiaInterfaces_AdminState_enable corresponds to the value enable of SrlNokiaInterfaces_AdminState
+	SrlNokiaInterfaces_AdminState_enable E_SrlNokiaInterfaces_AdminState = 1
+	// SrlNokiaInterfaces_AdminState_disable corresponds to the value disable of SrlNokiaInterfaces_AdminState
+	SrlNokiaInterfaces_AdminState_disable E_SrlNokiaInterfaces_AdminState = 2
+)
+
+// E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaInterfaces_Interface_Ethernet_PortSpeed. An additional value named
+// SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed int64
+
+// IsYANGGoEnum ensures that SrlNokiaInterfaces_Interface_Ethernet_PortSpeed implements the yang.GoEnum
+// interface. This ensures that SrlNokiaInterfaces_Interface_Ethernet_PortSpeed can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaInterfaces_Interface_Ethernet_PortSpeed.
+func (E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed.
+func (e E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed")
+}
+
+const (
+	// SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_UNSET corresponds to the value UNSET of SrlNokiaInterfaces_Interface_Ethernet_PortSpeed
+	SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_UNSET E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed = 0
+	// SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_1G corresponds to the value 1G of SrlNokiaInterfaces_Interface_Ethernet_PortSpeed
+	SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_1G E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed = 1
+	// SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_10G corresponds to the value 10G of SrlNokiaInterfaces_Interface_Ethernet_PortSpeed
+	SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_10G E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed = 2
+	// SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_25G corresponds to the value 25G of SrlNokiaInterfaces_Interface_Ethernet_PortSpeed
+	SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_25G E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed = 3
+	// SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_40G corresponds to the value 40G of SrlNokiaInterfaces_Interface_Ethernet_PortSpeed
+	SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_40G E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed = 4
+	// SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_50G corresponds to the value 50G of SrlNokiaInterfaces_Interface_Ethernet_PortSpeed
+	SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_50G E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed = 5
+	// SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_100G corresponds to the value 100G of SrlNokiaInterfaces_Interface_Ethernet_PortSpeed
+	SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_100G E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed = 6
+	// SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_400G corresponds to the value 400G of SrlNokiaInterfaces_Interface_Ethernet_PortSpeed
+	SrlNokiaInterfaces_Interface_Ethernet_PortSpeed_400G E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed = 7
+)
+
+// E_SrlNokiaInterfaces_Interface_OperDownReason is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaInterfaces_Interface_OperDownReason. An additional value named
+// SrlNokiaInterfaces_Interface_OperDownReason_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaInterfaces_Interface_OperDownReason int64
+
+// IsYANGGoEnum ensures that SrlNokiaInterfaces_Interface_OperDownReason implements the yang.GoEnum
+// interface. This ensures that SrlNokiaInterfaces_Interface_OperDownReason can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaInterfaces_Interface_OperDownReason) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaInterfaces_Interface_OperDownReason.
+func (E_SrlNokiaInterfaces_Interface_OperDownReason) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_SrlNokiaInterfaces_Interface_OperDownReason.
+func (e E_SrlNokiaInterfaces_Interface_OperDownReason) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaInterfaces_Interface_OperDownReason")
+}
+
+const (
+	// SrlNokiaInterfaces_Interface_OperDownReason_UNSET corresponds to the value UNSET of SrlNokiaInterfaces_Interface_OperDownReason
+	SrlNokiaInterfaces_Interface_OperDownReason_UNSET E_SrlNokiaInterfaces_Interface_OperDownReason = 0
+	// SrlNokiaInterfaces_Interface_OperDownReason_port_admin_disabled corresponds to the value port_admin_disabled of SrlNokiaInterfaces_Interface_OperDownReason
+	SrlNokiaInterfaces_Interface_OperDownReason_port_admin_disabled E_SrlNokiaInterfaces_Interface_OperDownReason = 1
+	// SrlNokiaInterfaces_Interface_OperDownReason_mda_admin_disabled corresponds to the value mda_admin_disabled of SrlNokiaInterfaces_Interface_OperDownReason
+	SrlNokiaInterfaces_Interface_OperDownReason_mda_admin_disabled E_SrlNokiaInterfaces_Interface_OperDownReason = 2
+	// SrlNokiaInterfaces_Interface_OperDownReason_transceiver_oper_down corresponds to the value transceiver_oper_down of SrlNokiaInterfaces_Interface_OperDownReason
+	SrlNokiaInterfaces_Interface_OperDownReason_transceiver_oper_down E_SrlNokiaInterfaces_Interface_OperDownReason = 3
+	// SrlNokiaInterfaces_Interface_OperDownReason_transceiver_not_present corresponds to the value transceiver_not_present of SrlNokiaInterfaces_Interface_OperDownReason
+	SrlNokiaInterfaces_Interface_OperDownReason_transceiver_not_present E_SrlNokiaInterfaces_Interface_OperDownReason = 4
+	// SrlNokiaInterfaces_Interface_OperDownReason_port_not_present corresponds to the value port_not_present of SrlNokiaInterfaces_Interface_OperDownReason
+	SrlNokiaInterfaces_Interface_OperDownReason_port_not_present E_SrlNokiaInterfaces_Interface_OperDownReason = 5
+	// SrlNokiaInterfaces_Interface_OperDownReason_lower_layer_down corresponds to the value lower_layer_down of SrlNokiaInterfaces_Interface_OperDownReason
+	SrlNokiaInterfaces_Interface_OperDownReason_lower_layer_down E_SrlNokiaInterfaces_Interface_OperDownReason = 6
+	// SrlNokiaInterfaces_Interface_OperDownReason_other corresponds to the value other of SrlNokiaInterfaces_Interface_OperDownReason
+	SrlNokiaInterfaces_Interface_OperDownReason_other E_SrlNokiaInterfaces_Interface_OperDownReason = 7
+)
+
+// E_SrlNokiaInterfaces_OperState is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaInterfaces_OperState. An additional value named
+// SrlNokiaInterfaces_OperState_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaInterfaces_OperState int64
+
+// IsYANGGoEnum ensures that SrlNokiaInterfaces_OperState implements the yang.GoEnum
+// interface. This ensures that SrlNokiaInterfaces_OperState can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaInterfaces_OperState) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaInterfaces_OperState.
+func (E_SrlNokiaInterfaces_OperState) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_SrlNokiaInterfaces_OperState.
+func (e E_SrlNokiaInterfaces_OperState) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaInterfaces_OperState")
+}
+
+const (
+	// SrlNokiaInterfaces_OperState_UNSET corresponds to the value UNSET of SrlNokiaInterfaces_OperState
+	SrlNokiaInterfaces_OperState_UNSET E_SrlNokiaInterfaces_OperState = 0
+	// SrlNokiaInterfaces_OperState_up corresponds to the value up of SrlNokiaInterfaces_OperState
+	SrlNokiaInterfaces_OperState_up E_SrlNokiaInterfaces_OperState = 1
+	// SrlNokiaInterfaces_OperState_down corresponds to the value down of SrlNokiaInterfaces_OperState
+	SrlNokiaInterfaces_OperState_down E_SrlNokiaInterfaces_OperState = 2
+	// SrlNokiaInterfaces_OperState_empty corresponds to the value empty of SrlNokiaInterfaces_OperState
+	SrlNokiaInterfaces_OperState_empty E_SrlNokiaInterfaces_OperState = 3
+	// SrlNokiaInterfaces_OperState_downloading corresponds to the value downloading of SrlNokiaInterfaces_OperState
+	SrlNokiaInterfaces_OperState_downloading E_SrlNokiaInterfaces_OperState = 4
+	// SrlNokiaInterfaces_OperState_booting corresponds to the value booting of SrlNokiaInterfaces_OperState
+	SrlNokiaInterfaces_OperState_booting E_SrlNokiaInterfaces_OperState = 5
+	// SrlNokiaInterfaces_OperState_starting corresponds to the value starting of SrlNokiaInterfaces_OperState
+	SrlNokiaInterfaces_OperState_starting E_SrlNokiaInterfaces_OperState = 6
+	// SrlNokiaInterfaces_OperState_failed corresponds to the value failed of SrlNokiaInterfaces_OperState
+	SrlNokiaInterfaces_OperState_failed E_SrlNokiaInterfaces_OperState = 7
+	// SrlNokiaInterfaces_OperState_synchronizing corresponds to the value synchronizing of SrlNokiaInterfaces_OperState
+	SrlNokiaInterfaces_OperState_synchronizing E_SrlNokiaInterfaces_OperState = 8
+	// SrlNokiaInterfaces_OperState_upgrading corresponds to the value upgrading of SrlNokiaInterfaces_OperState
+	SrlNokiaInterfaces_OperState_upgrading E_SrlNokiaInterfaces_OperState = 9
+)
+
+// E_SrlNokiaNetworkInstance_AdminState is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaNetworkInstance_AdminState. An additional value named
+// SrlNokiaNetworkInstance_AdminState_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaNetworkInstance_AdminState int64
+
+// IsYANGGoEnum ensures that SrlNokiaNetworkInstance_AdminState implements the yang.GoEnum
+// interface. This ensures that SrlNokiaNetworkInstance_AdminState can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaNetworkInstance_AdminState) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaNetworkInstance_AdminState.
+func (E_SrlNokiaNetworkInstance_AdminState) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_SrlNokiaNetworkInstance_AdminState.
+func (e E_SrlNokiaNetworkInstance_AdminState) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaNetworkInstance_AdminState")
+}
+
+const (
+	// SrlNokiaNetworkInstance_AdminState_UNSET corresponds to the value UNSET of SrlNokiaNetworkInstance_AdminState
+	SrlNokiaNetworkInstance_AdminState_UNSET E_SrlNokiaNetworkInstance_AdminState = 0
+	// SrlNokiaNetworkInstance_AdminState_enable corresponds to the value enable of SrlNokiaNetworkInstance_AdminState
+	SrlNokiaNetworkInstance_AdminState_enable E_SrlNokiaNetworkInstance_AdminState = 1
+	// SrlNokiaNetworkInstance_AdminState_disable corresponds to the value disable of SrlNokiaNetworkInstance_AdminState
+	SrlNokiaNetworkInstance_AdminState_disable E_SrlNokiaNetworkInstance_AdminState = 2
+)
+
+// E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState. An additional value named
+// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState int64
+
+// IsYANGGoEnum ensures that SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState implements the yang.GoEnum
+// interface. This ensures that SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState.
+func (E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState.
+func (e E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState")
+}
+
+const (
+	// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_UNSET corresponds to the value UNSET of SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState
+	SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_UNSET E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState = 0
+	// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_idle corresponds to the value idle of SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState
+	SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_idle E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState = 1
+	// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_connect corresponds to the value connect of SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState
+	SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_connect E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState = 2
+	// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_active corresponds to the value active of SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState
+	SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_active E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState = 3
+	// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_opensent corresponds to the value opensent of SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState
+	SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_opensent E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState = 4
+	// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_openconfirm corresponds to the value openconfirm of SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState
+	SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_openconfirm E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState = 5
+	// SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_established corresponds to the value established of SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState
+	SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState_established E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState = 6
+)
+
+// E_SrlNokiaNetworkInstance_NiType is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaNetworkInstance_NiType. An additional value named
+// SrlNokiaNetworkInstance_NiType_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaNetworkInstance_NiType int64
+
+// IsYANGGoEnum ensures that SrlNokiaNetworkInstance_NiType implements the yang.GoEnum
+// interface. This ensures that SrlNokiaNetworkInstance_NiType can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaNetworkInstance_NiType) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaNetworkInstance_NiType.
+func (E_SrlNokiaNetworkInstance_NiType) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_SrlNokiaNetworkInstance_NiType.
+func (e E_SrlNokiaNetworkInstance_NiType) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaNetworkInstance_NiType")
+}
+
+const (
+	// SrlNokiaNetworkInstance_NiType_UNSET corresponds to the value UNSET of SrlNokiaNetworkInstance_NiType
+	SrlNokiaNetworkInstance_NiType_UNSET E_SrlNokiaNetworkInstance_NiType = 0
+	// SrlNokiaNetworkInstance_NiType_default corresponds to the value default of SrlNokiaNetworkInstance_NiType
+	SrlNokiaNetworkInstance_NiType_default E_SrlNokiaNetworkInstance_NiType = 1
+	// SrlNokiaNetworkInstance_NiType_ip_vrf corresponds to the value ip_vrf of SrlNokiaNetworkInstance_NiType
+	SrlNokiaNetworkInstance_NiType_ip_vrf E_SrlNokiaNetworkInstance_NiType = 2
+	// SrlNokiaNetworkInstance_NiType_mac_vrf corresponds to the value mac_vrf of SrlNokiaNetworkInstance_NiType
+	SrlNokiaNetworkInstance_NiType_mac_vrf E_SrlNokiaNetworkInstance_NiType = 3
+)
+
+// E_SrlNokiaNetworkInstance_OperState is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaNetworkInstance_OperState. An additional value named
+// SrlNokiaNetworkInstance_OperState_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaNetworkInstance_OperState int64
+
+// IsYANGGoEnum ensures that SrlNokiaNetworkInstance_OperState implements the yang.GoEnum
+// interface. This ensures that SrlNokiaNetworkInstance_OperState can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaNetworkInstance_OperState) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaNetworkInstance_OperState.
+func (E_SrlNokiaNetworkInstance_OperState) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_SrlNokiaNetworkInstance_OperState.
+func (e E_SrlNokiaNetworkInstance_OperState) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaNetworkInstance_OperState")
+}
+
+const (
+	// SrlNokiaNetworkInstance_OperState_UNSET corresponds to the value UNSET of SrlNokiaNetworkInstance_OperState
+	SrlNokiaNetworkInstance_OperState_UNSET E_SrlNokiaNetworkInstance_OperState = 0
+	// SrlNokiaNetworkInstance_OperState_up corresponds to the value up of SrlNokiaNetworkInstance_OperState
+	SrlNokiaNetworkInstance_OperState_up E_SrlNokiaNetworkInstance_OperState = 1
+	// SrlNokiaNetworkInstance_OperState_down corresponds to the value down of SrlNokiaNetworkInstance_OperState
+	SrlNokiaNetworkInstance_OperState_down E_SrlNokiaNetworkInstance_OperState = 2
+	// SrlNokiaNetworkInstance_OperState_empty corresponds to the value empty of SrlNokiaNetworkInstance_OperState
+	SrlNokiaNetworkInstance_OperState_empty E_SrlNokiaNetworkInstance_OperState = 3
+	// SrlNokiaNetworkInstance_OperState_downloading corresponds to the value downloading of SrlNokiaNetworkInstance_OperState
+	SrlNokiaNetworkInstance_OperState_downloading E_SrlNokiaNetworkInstance_OperState = 4
+	// SrlNokiaNetworkInstance_OperState_booting corresponds to the value booting of SrlNokiaNetworkInstance_OperState
+	SrlNokiaNetworkInstance_OperState_booting E_SrlNokiaNetworkInstance_OperState = 5
+	// SrlNokiaNetworkInstance_OperState_starting corresponds to the value starting of SrlNokiaNetworkInstance_OperState
+	SrlNokiaNetworkInstance_OperState_starting E_SrlNokiaNetworkInstance_OperState = 6
+	// SrlNokiaNetworkInstance_OperState_failed corresponds to the value failed of SrlNokiaNetworkInstance_OperState
+	SrlNokiaNetworkInstance_OperState_failed E_SrlNokiaNetworkInstance_OperState = 7
+	// SrlNokiaNetworkInstance_OperState_synchronizing corresponds to the value synchronizing of SrlNokiaNetworkInstance_OperState
+	SrlNokiaNetworkInstance_OperState_synchronizing E_SrlNokiaNetworkInstance_OperState = 8
+	// SrlNokiaNetworkInstance_OperState_upgrading corresponds to the value upgrading of SrlNokiaNetworkInstance_OperState
+	SrlNokiaNetworkInstance_OperState_upgrading E_SrlNokiaNetworkInstance_OperState = 9
+)
+
+// E_SrlNokiaNextHopGroups_AdminState is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaNextHopGroups_AdminState. An additional value named
+// SrlNokiaNextHopGroups_AdminState_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaNextHopGroups_AdminState int64
+
+// IsYANGGoEnum ensures that SrlNokiaNextHopGroups_AdminState implements the yang.GoEnum
+// interface. This ensures that SrlNokiaNextHopGroups_AdminState can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaNextHopGroups_AdminState) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaNextHopGroups_AdminState.
+func (E_SrlNokiaNextHopGroups_AdminState) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_SrlNokiaNextHopGroups_AdminState.
+func (e E_SrlNokiaNextHopGroups_AdminState) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaNextHopGroups_AdminState")
+}
+
+const (
+	// SrlNokiaNextHopGroups_AdminState_UNSET corresponds to the value UNSET of SrlNokiaNextHopGroups_AdminState
+	SrlNokiaNextHopGroups_AdminState_UNSET E_SrlNokiaNextHopGroups_AdminState = 0
+	// SrlNokiaNextHopGroups_AdminState_enable corresponds to the value enable of SrlNokiaNextHopGroups_AdminState
+	SrlNokiaNextHopGroups_AdminState_enable E_SrlNokiaNextHopGroups_AdminState = 1
+	// SrlNokiaNextHopGroups_AdminState_disable corresponds to the value disable of SrlNokiaNextHopGroups_AdminState
+	SrlNokiaNextHopGroups_AdminState_disable E_SrlNokiaNextHopGroups_AdminState = 2
+)
+
+// E_SrlNokiaNtp_AdminState is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaNtp_AdminState. An additional value named
+// SrlNokiaNtp_AdminState_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaNtp_AdminState int64
+
+// IsYANGGoEnum ensures that SrlNokiaNtp_AdminState implements the yang.GoEnum
+// interface. This ensures that SrlNokiaNtp_AdminState can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaNtp_AdminState) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaNtp_AdminState.
+func (E_SrlNokiaNtp_AdminState) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_SrlNokiaNtp_AdminState.
+func (e E_SrlNokiaNtp_AdminState) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaNtp_AdminState")
+}
+
+const (
+	// SrlNokiaNtp_AdminState_UNSET corresponds to the value UNSET of SrlNokiaNtp_AdminState
+	SrlNokiaNtp_AdminState_UNSET E_SrlNokiaNtp_AdminState = 0
+	// SrlNokiaNtp_AdminState_enable corresponds to the value enable of SrlNokiaNtp_AdminState
+	SrlNokiaNtp_AdminState_enable E_SrlNokiaNtp_AdminState = 1
+	// SrlNokiaNtp_AdminState_disable corresponds to the value disable of SrlNokiaNtp_AdminState
+	SrlNokiaNtp_AdminState_disable E_SrlNokiaNtp_AdminState = 2
+)
+
+// E_SrlNokiaNtp_OperState is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaNtp_OperState. An additional value named
+// SrlNokiaNtp_OperState_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaNtp_OperState int64
+
+// IsYANGGoEnum ensures that SrlNokiaNtp_OperState implements the yang.GoEnum
+// interface. This ensures that SrlNokiaNtp_OperState can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaNtp_OperState) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaNtp_OperState.
+func (E_SrlNokiaNtp_OperState) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_SrlNokiaNtp_OperState.
+func (e E_SrlNokiaNtp_OperState) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaNtp_OperState")
+}
+
+const (
+	// SrlNokiaNtp_OperState_UNSET corresponds to the value UNSET of SrlNokiaNtp_OperState
+	SrlNokiaNtp_OperState_UNSET E_SrlNokiaNtp_OperState = 0
+	// SrlNokiaNtp_OperState_up corresponds to the value up of SrlNokiaNtp_OperState
+	SrlNokiaNtp_OperState_up E_SrlNokiaNtp_OperState = 1
+	// SrlNokiaNtp_OperState_down corresponds to the value down of SrlNokiaNtp_OperState
+	SrlNokiaNtp_OperState_down E_SrlNokiaNtp_OperState = 2
+	// SrlNokiaNtp_OperState_empty corresponds to the value empty of SrlNokiaNtp_OperState
+	SrlNokiaNtp_OperState_empty E_SrlNokiaNtp_OperState = 3
+	// SrlNokiaNtp_OperState_downloading corresponds to the value downloading of SrlNokiaNtp_OperState
+	SrlNokiaNtp_OperState_downloading E_SrlNokiaNtp_OperState = 4
+	// SrlNokiaNtp_OperState_booting corresponds to the value booting of SrlNokiaNtp_OperState
+	SrlNokiaNtp_OperState_booting E_SrlNokiaNtp_OperState = 5
+	// SrlNokiaNtp_OperState_starting corresponds to the value starting of SrlNokiaNtp_OperState
+	SrlNokiaNtp_OperState_starting E_SrlNokiaNtp_OperState = 6
+	// SrlNokiaNtp_OperState_failed corresponds to the value failed of SrlNokiaNtp_OperState
+	SrlNokiaNtp_OperState_failed E_SrlNokiaNtp_OperState = 7
+	// SrlNokiaNtp_OperState_synchronizing corresponds to the value synchronizing of SrlNokiaNtp_OperState
+	SrlNokiaNtp_OperState_synchronizing E_SrlNokiaNtp_OperState = 8
+	// SrlNokiaNtp_OperState_upgrading corresponds to the value upgrading of SrlNokiaNtp_OperState
+	SrlNokiaNtp_OperState_upgrading E_SrlNokiaNtp_OperState = 9
+)
+
+// E_SrlNokiaStaticRoutes_AdminState is a derived int64 type which is used to represent
+// the enumerated node SrlNokiaStaticRoutes_AdminState. An additional value named
+// SrlNokiaStaticRoutes_AdminState_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_SrlNokiaStaticRoutes_AdminState int64
+
+// IsYANGGoEnum ensures that SrlNokiaStaticRoutes_AdminState implements the yang.GoEnum
+// interface. This ensures that SrlNokiaStaticRoutes_AdminState can be identified as a
+// mapped type for a YANG enumeration.
+func (E_SrlNokiaStaticRoutes_AdminState) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  SrlNokiaStaticRoutes_AdminState.
+func (E_SrlNokiaStaticRoutes_AdminState) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return ΛEnum
+}
+
+// String returns a logging-friendly string for E_SrlNokiaStaticRoutes_AdminState.
+func (e E_SrlNokiaStaticRoutes_AdminState) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_SrlNokiaStaticRoutes_AdminState")
+}
+
+const (
+	// SrlNokiaStaticRoutes_AdminState_UNSET corresponds to the value UNSET of SrlNokiaStaticRoutes_AdminState
+	SrlNokiaStaticRoutes_AdminState_UNSET E_SrlNokiaStaticRoutes_AdminState = 0
+	// SrlNokiaStaticRoutes_AdminState_enable corresponds to the value enable of SrlNokiaStaticRoutes_AdminState
+	SrlNokiaStaticRoutes_AdminState_enable E_SrlNokiaStaticRoutes_AdminState = 1
+	// SrlNokiaStaticRoutes_AdminState_disable corresponds to the value disable of SrlNokiaStaticRoutes_AdminState
+	SrlNokiaStaticRoutes_AdminState_disable E_SrlNokiaStaticRoutes_AdminState = 2
+)
+
+// ΛEnum is a map, keyed by the name of the type defined for each enum in the
+// generated Go code, which provides a mapping between the constant int64 value
+// of each value of the enumeration, and the string that is used to represent it
+// in the YANG schema. The map is named ΛEnum in order to avoid clash with any
+// valid YANG identifier.
+var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
+	"E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator": {
+		1: {Name: "eq"},
+		2: {Name: "ge"},
+		3: {Name: "le"},
+	},
+	"E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol": {
+		1:  {Name: "icmp"},
+		2:  {Name: "igmp"},
+		3:  {Name: "tcp"},
+		4:  {Name: "udp"},
+		5:  {Name: "gre"},
+		6:  {Name: "esp"},
+		7:  {Name: "ah"},
+		8:  {Name: "icmp6"},
+		9:  {Name: "ospf"},
+		10: {Name: "pim"},
+		11: {Name: "vrrp"},
+	},
+	"E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader": {
+		1:  {Name: "icmp"},
+		2:  {Name: "igmp"},
+		3:  {Name: "tcp"},
+		4:  {Name: "udp"},
+		5:  {Name: "gre"},
+		6:  {Name: "esp"},
+		7:  {Name: "ah"},
+		8:  {Name: "icmp6"},
+		9:  {Name: "ospf"},
+		10: {Name: "pim"},
+		11: {Name: "vrrp"},
+	},
+	"E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype": {
+		1: {Name: "arp"},
+		2: {Name: "ipv4"},
+		3: {Name: "ipv6"},
+		4: {Name: "lldp"},
+		5: {Name: "mpls-unicast"},
+		6: {Name: "mpls-multicast"},
+		7: {Name: "pbb"},
+		8: {Name: "slow-protocols"},
+	},
+	"E_SrlNokiaBgp_AdminState": {
+		1: {Name: "enable"},
+		2: {Name: "disable"},
+	},
+	"E_SrlNokiaBgp_OperState": {
+		1: {Name: "up"},
+		2: {Name: "down"},
+		3: {Name: "empty"},
+		4: {Name: "downloading"},
+		5: {Name: "booting"},
+		6: {Name: "starting"},
+		7: {Name: "failed"},
+		8: {Name: "synchronizing"},
+		9: {Name: "upgrading"},
+	},
+	"E_SrlNokiaCommon_IpRouteType": {
+		1: {Name: "aggregate", DefiningModule: "srl_nokia-common"},
+		2: {Name: "bgp", DefiningModule: "srl_nokia-common"},
+		3: {Name: "host", DefiningModule: "srl_nokia-common"},
+		4: {Name: "local", DefiningModule: "srl_nokia-common"},
+		5: {Name: "static", DefiningModule: "srl_nokia-common"},
+	},
+	"E_SrlNokiaInterfaces_AdminState": {
+		1: {Name: "enable"},
+		2: {Name: "disable"},
+	},
+	"E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed": {
+		1: {Name: "1G"},
+		2: {Name: "10G"},
+		3: {Name: "25G"},
+		4: {Name: "40G"},
+		5: {Name: "50G"},
+		6: {Name: "100G"},
+		7: {Name: "400G"},
+	},
+	"E_SrlNokiaInterfaces_Interface_OperDownReason": {
+		1: {Name: "port-admin-disabled"},
+		2: {Name: "mda-admin-disabled"},
+		3: {Name: "transceiver-oper-down"},
+		4: {Name: "transceiver-not-present"},
+		5: {Name: "port-not-present"},
+		6: {Name: "lower-layer-down"},
+		7: {Name: "other"},
+	},
+	"E_SrlNokiaInterfaces_OperState": {
+		1: {Name: "up"},
+		2: {Name: "down"},
+		3: {Name: "empty"},
+		4: {Name: "downloading"},
+		5: {Name: "booting"},
+		6: {Name: "starting"},
+		7: {Name: "failed"},
+		8: {Name: "synchronizing"},
+		9: {Name: "upgrading"},
+	},
+	"E_SrlNokiaNetworkInstance_AdminState": {
+		1: {Name: "enable"},
+		2: {Name: "disable"},
+	},
+	"E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState": {
+		1: {Name: "idle"},
+		2: {Name: "connect"},
+		3: {Name: "active"},
+		4: {Name: "opensent"},
+		5: {Name: "openconfirm"},
+		6: {Name: "established"},
+	},
+	"E_SrlNokiaNetworkInstance_NiType": {
+		1: {Name: "default", DefiningModule: "srl_nokia-network-instance"},
+		2: {Name: "ip-vrf", DefiningModule: "srl_nokia-network-instance"},
+		3: {Name: "mac-vrf", DefiningModule: "srl_nokia-network-instance"},
+	},
+	"E_SrlNokiaNetworkInstance_OperState": {
+		1: {Name: "up"},
+		2: {Name: "down"},
+		3: {Name: "empty"},
+		4: {Name: "downloading"},
+		5: {Name: "booting"},
+		6: {Name: "starting"},
+		7: {Name: "failed"},
+		8: {Name: "synchronizing"},
+		9: {Name: "upgrading"},
+	},
+	"E_SrlNokiaNextHopGroups_AdminState": {
+		1: {Name: "enable"},
+		2: {Name: "disable"},
+	},
+	"E_SrlNokiaNtp_AdminState": {
+		1: {Name: "enable"},
+		2: {Name: "disable"},
+	},
+	"E_SrlNokiaNtp_OperState": {
+		1: {Name: "up"},
+		2: {Name: "down"},
+		3: {Name: "empty"},
+		4: {Name: "downloading"},
+		5: {Name: "booting"},
+		6: {Name: "starting"},
+		7: {Name: "failed"},
+		8: {Name: "synchronizing"},
+		9: {Name: "upgrading"},
+	},
+	"E_SrlNokiaStaticRoutes_AdminState": {
+		1: {Name: "enable"},
+		2: {Name: "disable"},
+	},
+}
+
+var (
+	// ySchema is a byte slice contain a gzip compressed representation of the
+	// YANG schema from which the Go code was generated. When uncompressed the
+	// contents of the byte slice is a JSON document containing an object, keyed
+	// on the name of the generated struct, and containing the JSON marshalled
+	// contents of a goyang yang.Entry struct, which defines the schema for the
+	// fields within the struct.
+	ySchema = []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5d, 0x73, 0xe3, 0x36,
+		0xd2, 0xee, 0x3d, 0x7f, 0x45, 0x17, 0xaf, 0xac, 0x2d, 0x31, 0x96, 0x6c, 0x4b, 0x33, 0xf6, 0xcd,
+		0x94, 0x73, 0x66, 0x72, 0xde, 0xd4, 0x9b, 0x64, 0x53, 0x9b, 0x3d, 0xef, 0xc5, 0x71, 0x54, 0x2e,
+		0x5a, 0x82, 0x6d, 0x9e, 0xc8, 0x94, 0x96, 0xa4, 0x66, 0x67, 0xd6, 0xf6, 0x7f, 0x3f, 0x45, 0x7d,
+		0x58, 0xdf, 0x22, 0xd0, 0x68, 0x50, 0x94, 0xfc, 0xb0, 0x2a, 0x19, 0x8f, 0x87, 0x00, 0x49, 0xa0,
+		0x9f, 0xee, 0x07, 0x8d, 0xee, 0xc6, 0xb3, 0x47, 0x44, 0xe4, 0xff, 0x16, 0x3e, 0x29, 0xff, 0x8a,
+		0xfc, 0x9e, 0xfa, 0x1a, 0x75, 0x95, 0x5f, 0x9f, 0xfc, 0xf6, 0xbf, 0xa3, 0xb8, 0xe7, 0x5f, 0x51,
+		0x73, 0xfa, 0xd7, 0xff, 0x35, 0x88, 0xef, 0xa3, 0x07, 0xff, 0x8a, 0x1a, 0xd3, 0x5f, 0x7c, 0x8e,
+		0x12, 0xff, 0x8a, 0x26, 0x5d, 0x10, 0x11, 0xf9, 0x61, 0xb7, 0xbf, 0xf4, 0x8b, 0xa5, 0xbe, 0xf3,
+		0x7f, 0xac, 0x2f, 0xff, 0xd3, 0xf2, 0x03, 0xde, 0x7e, 0xbd, 0xfa, 0xa0, 0xb7, 0x7f, 0xf8, 0x3d,
+		0x51, 0xf7, 0xd1, 0xb7, 0xb5, 0x47, 0x2c, 0x3d, 0x26, 0x4d, 0xfa, 0xb7, 0xf1, 0xe0, 0xaf, 0x28,
+		0x0c, 0xd6, 0x1f, 0x48, 0x44, 0xe4, 0xff, 0x31, 0x18, 0x25, 0x5d, 0xb5, 0xb1, 0x93, 0xc9, 0x4b,
+		0xa9, 0xef, 0xff, 0x1e, 0x24, 0xf9, 0x7b, 0xf9, 0xc3, 0xc9, 0xf3, 0xea, 0x9b, 0x6f, 0xfc, 0xaf,
+		0x30, 0xbd, 0x4e, 0x1e, 0x46, 0x4f, 0x2a, 0xce, 0xfc, 0x2b, 0xca, 0x92, 0x91, 0xda, 0x72, 0xe3,
+		0xc2, 0x5d, 0x2b, 0xaf, 0xb7, 0x76, 0xff, 0xeb, 0xd2, 0x6f, 0x5e, 0x57, 0xbe, 0x7f, 0x75, 0xc0,
+		0xdf, 0xfe, 0x21, 0x1a, 0x7e, 0xbd, 0x08, 0xee, 0xa3, 0x7e, 0xa6, 0x92, 0xed, 0x1f, 0x36, 0x1b,
+		0xa1, 0xc5, 0x9b, 0xb7, 0xbc, 0xf1, 0xe6, 0x89, 0x29, 0x9c, 0x20, 0x9d, 0x89, 0x32, 0x9e, 0x30,
+		0xdd, 0x89, 0x33, 0x9e, 0x40, 0xe3, 0x89, 0xe4, 0x4c, 0xe8, 0xe6, 0x89, 0xdd, 0x32, 0xc1, 0x85,
+		0x13, 0x3d, 0xbb, 0xfc, 0x9e, 0x4a, 0xbb, 0x49, 0x34, 0xcc, 0xa2, 0x41, 0x5c, 0x3c, 0x20, 0x73,
+		0x74, 0xcf, 0x1b, 0x15, 0x7c, 0xe1, 0x54, 0x00, 0x1a, 0x05, 0xb7, 0x15, 0x09, 0x82, 0x89, 0x40,
+		0xb0, 0x05, 0xc3, 0x54, 0x40, 0xd8, 0x82, 0xc2, 0x16, 0x18, 0x1b, 0xc1, 0xd9, 0x2d, 0x40, 0x05,
+		0x82, 0x34, 0xbb, 0xfc, 0x7f, 0x7e, 0x1f, 0x2a, 0xb3, 0xd1, 0xd7, 0x17, 0x96, 0x25, 0x8d, 0xf1,
+		0x51, 0xe3, 0xde, 0x5f, 0x54, 0xfc, 0x90, 0x3d, 0xfa, 0x57, 0x74, 0x53, 0x78, 0x2f, 0x11, 0x69,
+		0xce, 0x26, 0x11, 0x91, 0xff, 0x6b, 0x14, 0xfb, 0x57, 0x06, 0x0d, 0x0c, 0x64, 0x7d, 0xf5, 0xf2,
+		0xff, 0x27, 0xec, 0x8f, 0xd4, 0x76, 0x25, 0xb9, 0xed, 0xf2, 0x7f, 0x4a, 0xc2, 0x6e, 0x3e, 0xaa,
+		0x9f, 0xa3, 0x87, 0x28, 0x4b, 0xf3, 0x07, 0x6b, 0xb7, 0x7f, 0xad, 0x1b, 0x0c, 0x45, 0xf8, 0xad,
+		0xf4, 0xa1, 0x38, 0x6b, 0xb5, 0x4a, 0x1c, 0x0c, 0x4f, 0xe6, 0xae, 0x8e, 0xc7, 0x6b, 0xbf, 0x63,
+		0x32, 0x7c, 0x15, 0x67, 0xc9, 0x77, 0x7d, 0xc5, 0x3c, 0xb9, 0x5d, 0x4f, 0x25, 0x37, 0xa1, 0x92,
+		0x8f, 0x43, 0x25, 0x17, 0xd9, 0xf8, 0xd9, 0xe5, 0x4f, 0x10, 0xa2, 0x3f, 0x86, 0x73, 0xc2, 0xad,
+		0xa9, 0xbc, 0x0d, 0xc4, 0xcb, 0x58, 0xcc, 0x38, 0xe2, 0x66, 0x2d, 0x76, 0x5c, 0xf1, 0xb3, 0x16,
+		0x43, 0x6b, 0x71, 0x94, 0x10, 0x4b, 0x43, 0x05, 0xa9, 0x39, 0x7f, 0xba, 0xe2, 0xba, 0x20, 0xb6,
+		0x5d, 0x35, 0xcc, 0xcc, 0xc7, 0x7e, 0x2e, 0xbe, 0xe3, 0xf6, 0x75, 0x96, 0x05, 0x33, 0x35, 0xca,
+		0xa6, 0xe2, 0x6c, 0x23, 0xd6, 0x62, 0xe2, 0x6d, 0x2b, 0xe6, 0x62, 0xe2, 0x2e, 0x26, 0xf6, 0x92,
+		0xe2, 0x6f, 0x06, 0x03, 0x43, 0x38, 0xb0, 0x61, 0x31, 0xbb, 0xfc, 0xfe, 0xe0, 0x81, 0x3f, 0x61,
+		0x33, 0xc9, 0xc9, 0x3b, 0x61, 0x8e, 0xf0, 0x67, 0x75, 0x1f, 0x8e, 0xfa, 0xe3, 0x01, 0xbe, 0x0f,
+		0xfb, 0xa9, 0xe2, 0xf6, 0xc3, 0xa3, 0x8c, 0xd6, 0xc0, 0x93, 0x00, 0xa0, 0x38, 0x10, 0xa5, 0x00,
+		0x29, 0x0e, 0x4c, 0x71, 0x80, 0xba, 0x00, 0x2a, 0x0f, 0xb0, 0x4c, 0xe0, 0x9a, 0xaf, 0x8c, 0x0b,
+		0xa5, 0xe7, 0x6e, 0x30, 0xe8, 0xab, 0x30, 0xb6, 0x91, 0x9b, 0x99, 0xf5, 0x6a, 0x7a, 0xe5, 0x0c,
+		0x9c, 0x5b, 0xdd, 0x78, 0x1d, 0xc7, 0x83, 0x2c, 0x34, 0x22, 0xaf, 0x8b, 0x97, 0x9f, 0x76, 0x1f,
+		0xd5, 0x53, 0x38, 0x0c, 0xc7, 0xbe, 0x02, 0xff, 0x74, 0x49, 0xbc, 0x4e, 0xf3, 0xff, 0x16, 0x3c,
+		0x99, 0xa7, 0xe3, 0x35, 0xd4, 0xe9, 0x84, 0xf3, 0x9e, 0xb2, 0xb8, 0xc3, 0xe4, 0x99, 0x59, 0x32,
+		0xea, 0x66, 0xf1, 0x74, 0x4a, 0xff, 0x48, 0xfa, 0xbf, 0xe5, 0x4f, 0xbc, 0xee, 0xf6, 0x6f, 0xf3,
+		0xff, 0x7e, 0x1e, 0x7e, 0xbd, 0xf8, 0x69, 0xfc, 0xbc, 0xdb, 0x2f, 0xf9, 0xf3, 0x6e, 0xaf, 0xc7,
+		0xcf, 0xbb, 0xbd, 0x9e, 0x3c, 0xcf, 0x73, 0x33, 0xf6, 0x26, 0xcb, 0xfe, 0x5e, 0x32, 0x18, 0xf2,
+		0x39, 0xd7, 0xb8, 0x35, 0x18, 0x97, 0x73, 0x05, 0x0f, 0xc6, 0x45, 0x04, 0xc6, 0x05, 0xc6, 0x05,
+		0xc6, 0xb5, 0x57, 0xa0, 0xf2, 0x00, 0xcb, 0x04, 0x2e, 0x11, 0x18, 0xd7, 0xf1, 0x32, 0x2e, 0x06,
+		0x73, 0x20, 0x3e, 0xdf, 0xfa, 0x9c, 0x3f, 0xcd, 0x15, 0xdb, 0x12, 0x75, 0x9b, 0x31, 0x67, 0x84,
+		0x3f, 0x13, 0x06, 0x93, 0xc0, 0x1b, 0x7c, 0x5f, 0x68, 0xdf, 0x45, 0x63, 0x04, 0x8d, 0xb6, 0xb9,
+		0xd7, 0xb4, 0x83, 0xd9, 0x0e, 0x26, 0x99, 0x9b, 0x56, 0x38, 0xc1, 0xdd, 0x9a, 0xbe, 0xea, 0x39,
+		0xc1, 0x8d, 0x4d, 0x97, 0x85, 0x30, 0x92, 0xe1, 0xb6, 0x3a, 0x77, 0x7b, 0x7d, 0x76, 0x31, 0xcc,
+		0x05, 0x67, 0xbb, 0x9d, 0x89, 0xb5, 0xad, 0x7b, 0xce, 0x4d, 0x66, 0x7b, 0x8b, 0x9d, 0x67, 0x43,
+		0xa9, 0xb1, 0xde, 0x96, 0x97, 0x1e, 0x32, 0xe3, 0x6d, 0x7a, 0xd1, 0x41, 0x73, 0x64, 0xb6, 0x3b,
+		0x25, 0x9a, 0xa5, 0xa7, 0x30, 0xeb, 0x3e, 0x9a, 0x1b, 0xa4, 0x49, 0x33, 0xec, 0xc7, 0xc2, 0x14,
+		0xd9, 0x2b, 0x15, 0xf3, 0xfd, 0xd8, 0x9e, 0x4a, 0xb3, 0x28, 0x1e, 0x53, 0xd1, 0x20, 0xb2, 0xf1,
+		0x11, 0x2e, 0xf7, 0x03, 0x6f, 0xa1, 0x33, 0xb1, 0x17, 0x13, 0x7f, 0x31, 0x18, 0x48, 0xc2, 0xc1,
+		0x0c, 0x16, 0x86, 0xf0, 0x60, 0xc3, 0x64, 0x76, 0xcd, 0x06, 0xda, 0xda, 0x61, 0x68, 0x37, 0x61,
+		0xf0, 0xf5, 0xc9, 0xc3, 0x49, 0x1c, 0x56, 0xe2, 0xf0, 0x72, 0x01, 0x33, 0x1e, 0xdc, 0x98, 0xb0,
+		0xe3, 0x2f, 0x98, 0xb6, 0x4a, 0xcf, 0xd8, 0xdf, 0x61, 0x3d, 0x4d, 0x9c, 0x05, 0xd5, 0x3a, 0x28,
+		0xc2, 0x2c, 0x53, 0x49, 0x6c, 0xbc, 0xc2, 0x5a, 0xbd, 0xfc, 0x93, 0x93, 0x9b, 0x46, 0x70, 0xd9,
+		0x79, 0xb9, 0x69, 0x06, 0x97, 0x9d, 0xc9, 0x8f, 0xcd, 0xf1, 0x1f, 0x93, 0x9f, 0xcf, 0x6e, 0x1a,
+		0xc1, 0xc5, 0xec, 0xe7, 0xd6, 0x4d, 0x23, 0x68, 0x75, 0x6a, 0x7f, 0xfe, 0xf9, 0x43, 0xed, 0xf9,
+		0xfc, 0xd5, 0xbc, 0xe1, 0xe9, 0xf4, 0x61, 0xb5, 0x97, 0x93, 0x9b, 0x66, 0x70, 0xd6, 0x99, 0xfd,
+		0xe5, 0xfc, 0xa6, 0x11, 0x9c, 0x75, 0x6a, 0x35, 0xbe, 0x44, 0x75, 0xe0, 0x3a, 0xe5, 0x39, 0xec,
+		0xc6, 0xeb, 0x81, 0x53, 0x2b, 0x3e, 0x45, 0x0c, 0x37, 0xde, 0xaf, 0xf9, 0x63, 0x6f, 0x3f, 0xcf,
+		0x1f, 0xfb, 0xf3, 0xb0, 0x12, 0x3b, 0xd7, 0x0b, 0xe3, 0x30, 0x1c, 0x24, 0x99, 0x0c, 0x43, 0x1d,
+		0xf7, 0x04, 0x8e, 0xea, 0xdc, 0xa8, 0x82, 0xa3, 0xee, 0x81, 0xa3, 0x0e, 0x86, 0x2a, 0x09, 0xb3,
+		0x41, 0x62, 0xcf, 0x52, 0xdf, 0x7a, 0xb2, 0xdf, 0xdb, 0x56, 0xff, 0x02, 0xd9, 0x05, 0xd9, 0xdd,
+		0x2f, 0x5e, 0x79, 0xb8, 0x65, 0xe2, 0xd7, 0x01, 0xd9, 0x55, 0xf1, 0xe8, 0x49, 0x25, 0x13, 0x36,
+		0x22, 0x40, 0x76, 0x2f, 0x2c, 0xfa, 0xf8, 0x12, 0x8f, 0x9e, 0xf2, 0x8f, 0x7a, 0x2d, 0x8b, 0xe5,
+		0x31, 0x6c, 0x57, 0x12, 0xc6, 0x0f, 0xca, 0x5e, 0x09, 0x4e, 0xba, 0xb1, 0x53, 0x5e, 0x4d, 0x28,
+		0x2f, 0x28, 0xaf, 0x43, 0x54, 0x5e, 0x5c, 0x12, 0x32, 0xbb, 0x7c, 0x15, 0xf7, 0xac, 0x3a, 0x58,
+		0x51, 0x80, 0x3d, 0xdb, 0x29, 0xb6, 0x63, 0x13, 0x62, 0xc0, 0x94, 0x04, 0xa8, 0x33, 0xa0, 0x4a,
+		0x03, 0xd6, 0x19, 0x70, 0x9d, 0x01, 0xd8, 0x25, 0x90, 0xed, 0x00, 0x6d, 0x09, 0x6c, 0x39, 0x76,
+		0xb2, 0x26, 0x7d, 0xa3, 0x28, 0xce, 0x9a, 0x6d, 0x09, 0xb1, 0x9b, 0x62, 0xb5, 0x2d, 0xd0, 0xd5,
+		0x3f, 0xa6, 0x5c, 0xe0, 0xc6, 0xba, 0x2b, 0x62, 0x05, 0x45, 0x38, 0x09, 0x96, 0x70, 0xac, 0xe4,
+		0xb6, 0x46, 0x0a, 0x48, 0xf7, 0x2b, 0x10, 0x3f, 0xe0, 0x08, 0x1e, 0x62, 0x41, 0x1a, 0xfb, 0x9a,
+		0xaa, 0x76, 0xab, 0x75, 0xde, 0x3a, 0xa0, 0xe9, 0xf2, 0xaa, 0xd1, 0x4b, 0xc7, 0xdb, 0xcf, 0xf3,
+		0x2d, 0xc4, 0xd5, 0x4f, 0xb3, 0x90, 0xe1, 0x1e, 0xdd, 0xaa, 0xc6, 0x27, 0xdd, 0x81, 0x6d, 0x15,
+		0x0f, 0x14, 0xd8, 0x16, 0xd8, 0x16, 0x11, 0xd8, 0x16, 0x11, 0xd8, 0x96, 0x03, 0x13, 0x0e, 0xb6,
+		0x45, 0x04, 0xb6, 0x05, 0xb6, 0x25, 0xff, 0xfc, 0x72, 0x5d, 0x69, 0x96, 0xa1, 0x04, 0xb2, 0x21,
+		0x05, 0xf9, 0x06, 0xf8, 0xa9, 0x8d, 0x67, 0x9b, 0x24, 0x42, 0x0c, 0x7e, 0x1f, 0x24, 0xd9, 0xed,
+		0xc4, 0x58, 0x54, 0x78, 0x1f, 0xe1, 0xeb, 0x14, 0xdd, 0x96, 0xfb, 0x08, 0x93, 0x6e, 0xb0, 0x09,
+		0x8a, 0x7d, 0x04, 0x86, 0xee, 0xc0, 0x26, 0xa8, 0x18, 0xe1, 0x15, 0x20, 0xba, 0x42, 0x04, 0xf7,
+		0xd9, 0xab, 0x14, 0xa1, 0x15, 0x66, 0x47, 0xd2, 0x04, 0xd6, 0x05, 0x13, 0x12, 0x20, 0xac, 0xa2,
+		0x44, 0xd5, 0xd5, 0x14, 0x08, 0x12, 0x53, 0x27, 0xd3, 0xb0, 0x27, 0x02, 0x88, 0x18, 0x55, 0x51,
+		0x42, 0xb9, 0x8f, 0x28, 0xd5, 0x9c, 0x42, 0x56, 0x22, 0x4e, 0x35, 0xed, 0x0e, 0x83, 0x54, 0xd9,
+		0xc4, 0xa7, 0xce, 0x7a, 0xa8, 0x97, 0x52, 0x9d, 0x19, 0x71, 0xa9, 0x88, 0x4b, 0x65, 0xa9, 0x1b,
+		0x36, 0x95, 0x5b, 0xa2, 0x70, 0x1f, 0x39, 0xb3, 0x3e, 0x15, 0x75, 0x86, 0x25, 0xb3, 0x64, 0x6c,
+		0xcf, 0xde, 0x5e, 0x19, 0x9a, 0xd4, 0x3e, 0x8a, 0x10, 0x23, 0x93, 0xa4, 0x00, 0x36, 0x3b, 0x5e,
+		0x12, 0xcc, 0x4b, 0x7a, 0x68, 0xdb, 0xe7, 0x15, 0x1a, 0xdb, 0x92, 0xe8, 0x4d, 0xc7, 0xa9, 0xbe,
+		0xf9, 0x25, 0x4a, 0xb3, 0xeb, 0x2c, 0x63, 0x06, 0xc3, 0xff, 0x1a, 0xc5, 0x5f, 0xfa, 0x2a, 0x57,
+		0xac, 0xf9, 0x58, 0xc6, 0xa3, 0x7e, 0x9f, 0x57, 0x9e, 0xc1, 0xbe, 0x93, 0xbf, 0x27, 0x3d, 0x95,
+		0xa8, 0xde, 0x8f, 0xdf, 0xa7, 0x5d, 0x54, 0x80, 0xb4, 0x0c, 0x93, 0x41, 0x36, 0xe8, 0x0e, 0xfa,
+		0x7c, 0xd2, 0xf2, 0xd6, 0x03, 0x48, 0x0b, 0x11, 0x48, 0xcb, 0x11, 0x93, 0x96, 0x68, 0x18, 0xcc,
+		0x84, 0x3d, 0xc8, 0xf2, 0xde, 0xf8, 0xfc, 0xa5, 0x79, 0xc9, 0x68, 0x3b, 0xfd, 0x82, 0xd2, 0xf9,
+		0x8b, 0x2d, 0x69, 0x13, 0x20, 0x6f, 0x42, 0x24, 0xce, 0x7e, 0x30, 0x16, 0xad, 0x0a, 0xdc, 0x6e,
+		0xfc, 0xeb, 0xbd, 0xb8, 0xdd, 0xd8, 0x25, 0x95, 0x4a, 0x99, 0x84, 0xc3, 0x72, 0xba, 0xd5, 0xf7,
+		0xa5, 0xf8, 0xde, 0x71, 0xd6, 0x55, 0xa7, 0x02, 0x1c, 0x35, 0x1d, 0x93, 0x26, 0xab, 0xda, 0x44,
+		0xf3, 0x2e, 0x90, 0xf2, 0x4d, 0x04, 0x96, 0x8a, 0xb2, 0x44, 0xdb, 0x84, 0x07, 0x65, 0x89, 0x10,
+		0xa4, 0xc0, 0xbd, 0x10, 0xa4, 0x80, 0xb2, 0x44, 0x28, 0x4b, 0xc4, 0x6b, 0x71, 0x30, 0x5b, 0xbe,
+		0x5c, 0x2a, 0x45, 0xec, 0xbd, 0xde, 0x89, 0xfe, 0xac, 0x46, 0x31, 0xa2, 0xe9, 0xd7, 0xdb, 0xd5,
+		0x21, 0x5a, 0xec, 0x04, 0x7c, 0xd4, 0xb9, 0x01, 0x05, 0x1f, 0xdd, 0x03, 0x1f, 0x45, 0x09, 0x22,
+		0x09, 0xfc, 0x49, 0xe0, 0x50, 0x1c, 0x8f, 0x52, 0xb8, 0x14, 0xc7, 0xa7, 0x38, 0x4e, 0x5d, 0xe0,
+		0x95, 0x87, 0x5b, 0x26, 0x7e, 0x1d, 0x10, 0x5b, 0x94, 0x20, 0x32, 0xb9, 0x50, 0x82, 0x08, 0xca,
+		0x0b, 0xca, 0xcb, 0x52, 0x79, 0xa1, 0x04, 0x91, 0x1b, 0x60, 0x4a, 0x02, 0xd4, 0x19, 0x50, 0xa5,
+		0x01, 0xeb, 0x0c, 0xb8, 0xce, 0x00, 0xec, 0x12, 0xc8, 0x76, 0x80, 0xb6, 0x04, 0xb6, 0x1c, 0x3b,
+		0xd9, 0x18, 0xab, 0x80, 0xa4, 0xf8, 0x7d, 0x04, 0x33, 0x08, 0x2b, 0xb9, 0xad, 0x3b, 0xeb, 0x48,
+		0x8a, 0x3f, 0x98, 0xa9, 0x42, 0x52, 0x7c, 0x89, 0x8e, 0x6a, 0xfb, 0xe7, 0xa3, 0x04, 0x11, 0x11,
+		0xd8, 0x16, 0xd8, 0x16, 0xd8, 0x16, 0x11, 0xd8, 0x16, 0x11, 0x11, 0xd8, 0x16, 0xd8, 0x16, 0x11,
+		0xd8, 0x16, 0xd8, 0x96, 0xfc, 0xf3, 0xdf, 0x59, 0x09, 0xa2, 0x85, 0xbd, 0xef, 0x3d, 0x55, 0x1f,
+		0x9a, 0x30, 0x1b, 0x14, 0x1e, 0x2a, 0x47, 0x5d, 0x61, 0xf7, 0xa0, 0x54, 0x5a, 0x8c, 0xdd, 0x03,
+		0x79, 0xba, 0x8b, 0xc2, 0x43, 0xce, 0x69, 0x2c, 0x32, 0xa0, 0xf6, 0x4e, 0x4f, 0x51, 0x78, 0xa8,
+		0xd4, 0xd6, 0x88, 0x42, 0x95, 0xa2, 0x91, 0x25, 0xc7, 0xa1, 0x3a, 0x2d, 0x37, 0x24, 0x7a, 0x12,
+		0x3c, 0x73, 0x72, 0xd8, 0x93, 0xe2, 0xd7, 0x3d, 0xa7, 0x13, 0xe0, 0x7b, 0x32, 0x63, 0xa8, 0x31,
+		0x7e, 0x7e, 0xaa, 0xfe, 0x35, 0x52, 0x71, 0x1e, 0xe7, 0xac, 0x1f, 0x39, 0x30, 0x67, 0x99, 0x0b,
+		0x8d, 0xeb, 0x9e, 0x03, 0xe5, 0x6b, 0x4c, 0xaa, 0x39, 0x24, 0xda, 0x9a, 0x34, 0x73, 0x49, 0xb2,
+		0x35, 0x29, 0xb6, 0x26, 0xc1, 0x12, 0xa4, 0x57, 0x16, 0xcb, 0xc6, 0x24, 0x76, 0x89, 0xb4, 0x9e,
+		0x9f, 0x99, 0x4c, 0xdb, 0x54, 0x16, 0x3f, 0xd4, 0x3d, 0xc7, 0xa4, 0x94, 0x57, 0xcc, 0xc5, 0x22,
+		0x0e, 0xdc, 0x6e, 0x25, 0x6b, 0x49, 0x2a, 0x25, 0xd8, 0xcb, 0x2b, 0xaf, 0x74, 0xcd, 0xde, 0x87,
+		0xcc, 0x82, 0x04, 0x8a, 0x0c, 0x9b, 0x23, 0x7b, 0xdd, 0x29, 0xd3, 0x22, 0xe5, 0xa6, 0x3c, 0xcd,
+		0xa2, 0x6e, 0xca, 0x30, 0x48, 0xf3, 0xb6, 0x75, 0xcf, 0x41, 0xc8, 0xe8, 0xdc, 0x1e, 0x9d, 0xc1,
+		0x1e, 0xbd, 0x0b, 0x7b, 0x64, 0x1a, 0x92, 0xe9, 0xf7, 0xc3, 0x34, 0x0b, 0x26, 0x34, 0x91, 0x9d,
+		0x37, 0xb5, 0xd0, 0x07, 0x8a, 0x4d, 0x39, 0x13, 0x77, 0x31, 0xb1, 0x17, 0x13, 0x7f, 0x49, 0x18,
+		0x98, 0xc1, 0xc1, 0x10, 0x16, 0x7c, 0xba, 0xb6, 0x36, 0xfb, 0xbd, 0x30, 0x53, 0x41, 0x18, 0xf7,
+		0x82, 0x2c, 0x7a, 0x52, 0x41, 0x4f, 0xf5, 0xb3, 0xd0, 0xa6, 0xde, 0xd4, 0xc7, 0x0a, 0xe4, 0x4e,
+		0x8e, 0x91, 0xab, 0x7a, 0xc1, 0x30, 0xec, 0xfe, 0xa5, 0xb2, 0x94, 0xaf, 0x07, 0x56, 0x3b, 0x82,
+		0x32, 0x80, 0x32, 0x38, 0x6a, 0x65, 0xf0, 0x1f, 0x95, 0x0c, 0x82, 0xbb, 0x30, 0x55, 0xbd, 0xa0,
+		0x3b, 0x18, 0xc5, 0x99, 0x4a, 0xda, 0x17, 0x16, 0xda, 0xe0, 0x23, 0xaa, 0xe7, 0x92, 0xd5, 0x72,
+		0x06, 0xd5, 0x73, 0x9d, 0x0d, 0x6d, 0xf3, 0xe3, 0xc5, 0x45, 0xfb, 0xc3, 0xc5, 0x45, 0xe3, 0xc3,
+		0xf9, 0x87, 0xc6, 0x65, 0xab, 0xd5, 0x6c, 0x37, 0x5b, 0xa8, 0xa7, 0x2b, 0xd5, 0xff, 0xc1, 0x3a,
+		0x98, 0x8d, 0x57, 0xb0, 0xc4, 0xf0, 0x32, 0xff, 0x31, 0x7f, 0x88, 0xd4, 0xc2, 0xde, 0xb3, 0x18,
+		0xe7, 0xdc, 0xe6, 0x9a, 0xb9, 0x93, 0xcd, 0x2a, 0x25, 0xb3, 0x2a, 0x23, 0xb3, 0x2a, 0x21, 0x9b,
+		0x55, 0x3e, 0x2e, 0x1a, 0x16, 0x43, 0xb1, 0x63, 0x88, 0x9b, 0x5f, 0xf7, 0x84, 0x65, 0xcb, 0xf7,
+		0x78, 0x62, 0xb2, 0x63, 0x2c, 0xfc, 0xe9, 0xb3, 0x9f, 0x3d, 0x2d, 0x0e, 0x31, 0xbe, 0xbb, 0xee,
+		0x09, 0xe8, 0x72, 0x6d, 0x26, 0x6c, 0xc2, 0x7c, 0xd9, 0x4c, 0xd7, 0x94, 0xd9, 0xb2, 0x99, 0x2c,
+		0x9b, 0xb9, 0xda, 0x30, 0x55, 0x3b, 0x05, 0xa2, 0xcd, 0x3c, 0x4d, 0xa4, 0x84, 0x0c, 0xeb, 0x52,
+		0xf9, 0xbf, 0xa8, 0xf8, 0x21, 0x7b, 0xd4, 0xe6, 0x8e, 0xcf, 0x9e, 0x53, 0x6e, 0xc8, 0x5d, 0xfe,
+		0xbd, 0x11, 0x94, 0xba, 0x57, 0x16, 0xfb, 0x30, 0x59, 0x5c, 0x73, 0xb8, 0x9c, 0xed, 0x50, 0x18,
+		0x17, 0xb7, 0xb5, 0x1a, 0x0c, 0x21, 0x93, 0xdc, 0xe1, 0x6a, 0x62, 0xcf, 0x60, 0x8a, 0x66, 0xa6,
+		0x7b, 0x07, 0x9c, 0xf4, 0x6c, 0xb5, 0x91, 0x8d, 0x36, 0xb2, 0xcd, 0x7a, 0x36, 0x79, 0xdb, 0xf7,
+		0x69, 0xda, 0x60, 0x23, 0xdb, 0xbb, 0x43, 0xef, 0x68, 0x5b, 0x5b, 0xdf, 0xd3, 0x9b, 0xbb, 0x0d,
+		0xdf, 0x95, 0x97, 0xec, 0x6b, 0xcf, 0x5e, 0x65, 0xdb, 0x47, 0x2d, 0xd6, 0xf7, 0x6b, 0xef, 0x7e,
+		0xef, 0x82, 0x3d, 0x94, 0x42, 0x2b, 0xaa, 0x63, 0x3d, 0x8d, 0xad, 0xa6, 0xae, 0xb5, 0x34, 0xb6,
+		0x92, 0xc6, 0xd6, 0x91, 0x63, 0x15, 0xcd, 0x30, 0x58, 0xb4, 0x47, 0xe1, 0xf7, 0x54, 0xda, 0x4d,
+		0xa2, 0xa1, 0x16, 0x93, 0x9c, 0x3b, 0x66, 0x17, 0x1a, 0x81, 0x4e, 0x81, 0x4e, 0x09, 0x09, 0x0b,
+		0x81, 0x55, 0x95, 0x45, 0x24, 0xc0, 0xaa, 0x74, 0x01, 0x51, 0x02, 0xab, 0xda, 0x61, 0xaa, 0x26,
+		0x4b, 0x71, 0x6d, 0xc5, 0xac, 0xb3, 0x72, 0xd7, 0x8c, 0x6b, 0x80, 0x4a, 0x3e, 0x14, 0x95, 0xac,
+		0x1b, 0x87, 0xe0, 0x4f, 0x10, 0x62, 0x1e, 0x3a, 0x33, 0x6d, 0xe7, 0x38, 0x6c, 0x06, 0x61, 0x9c,
+		0x92, 0xe2, 0x28, 0x21, 0x96, 0x86, 0x0a, 0xd2, 0x55, 0xd8, 0x4c, 0xd8, 0xed, 0xaa, 0xa1, 0x45,
+		0xa9, 0xe1, 0x69, 0x7b, 0x54, 0x19, 0x76, 0x26, 0xe6, 0x62, 0xe2, 0x2e, 0x26, 0xf6, 0x92, 0xe2,
+		0x6f, 0x06, 0x03, 0x43, 0x38, 0xb0, 0x61, 0x31, 0xbb, 0xfc, 0xfe, 0xe0, 0xc1, 0x3e, 0x3b, 0x36,
+		0xef, 0xc4, 0xbe, 0xb6, 0xf0, 0x7d, 0xd8, 0x4f, 0x91, 0x63, 0x8b, 0x1c, 0xdb, 0x3d, 0x03, 0x95,
+		0x07, 0x58, 0x26, 0x70, 0xcd, 0x57, 0xc6, 0x85, 0xd2, 0x73, 0x37, 0x18, 0xf4, 0x55, 0x28, 0x52,
+		0x5a, 0xb8, 0x89, 0x14, 0x3b, 0x4d, 0x0f, 0x6c, 0x7b, 0x79, 0xb3, 0x7d, 0xc2, 0x79, 0x4f, 0x59,
+		0xdc, 0x81, 0xb4, 0xbc, 0xb5, 0xed, 0xa5, 0x7d, 0xf7, 0xeb, 0xf1, 0xf3, 0x6e, 0xaf, 0x27, 0xcf,
+		0xab, 0xc2, 0x51, 0xfe, 0xc9, 0xc0, 0xe2, 0xb0, 0xb1, 0x71, 0x6b, 0x30, 0x2e, 0xe7, 0x0a, 0x1e,
+		0x8c, 0x8b, 0x08, 0x8c, 0x0b, 0x8c, 0x0b, 0x8c, 0x6b, 0xaf, 0x40, 0xe5, 0x01, 0x96, 0x09, 0x5c,
+		0x22, 0x30, 0xae, 0xe3, 0x65, 0x5c, 0x0c, 0xe6, 0x40, 0x7c, 0xbe, 0xf5, 0x39, 0x7f, 0xda, 0xbb,
+		0x0f, 0x34, 0xdd, 0x38, 0x13, 0xa2, 0x41, 0xa6, 0x9b, 0x06, 0xbf, 0xcc, 0x5a, 0x06, 0x26, 0xdb,
+		0xdc, 0x6b, 0xda, 0xc1, 0x6c, 0x07, 0x93, 0x50, 0xcb, 0x40, 0xd8, 0x14, 0xa2, 0x96, 0x81, 0x95,
+		0x30, 0x12, 0xf3, 0x10, 0x4d, 0xd3, 0xed, 0xf5, 0xd9, 0x75, 0xa0, 0x25, 0x0d, 0x9a, 0x28, 0x69,
+		0xe0, 0x7c, 0x9b, 0x5e, 0x74, 0xd0, 0x8e, 0xa0, 0xa0, 0x81, 0x59, 0x32, 0xf8, 0x72, 0xf2, 0x27,
+		0xf6, 0x63, 0x61, 0x8a, 0x04, 0x94, 0x8a, 0xf9, 0x7e, 0x6c, 0x4f, 0xa5, 0x59, 0x14, 0x8f, 0xa9,
+		0x68, 0x7e, 0x04, 0x2e, 0xdf, 0x47, 0xb8, 0xdc, 0x0f, 0xbc, 0x85, 0xce, 0xc4, 0x5e, 0x4c, 0xfc,
+		0xc5, 0x60, 0x20, 0x09, 0x07, 0x33, 0x58, 0x18, 0xc2, 0x83, 0x0d, 0x93, 0xd9, 0x35, 0x1b, 0x68,
+		0x6b, 0x87, 0xa1, 0xdd, 0x84, 0xc1, 0xd7, 0x27, 0x0f, 0x27, 0x71, 0x58, 0x89, 0xc3, 0xcb, 0x05,
+		0xcc, 0x78, 0x70, 0x63, 0xc2, 0x8e, 0xbf, 0x60, 0xda, 0x2a, 0x3d, 0x63, 0x7f, 0x87, 0xf5, 0x34,
+		0x71, 0x16, 0x54, 0xeb, 0xa0, 0x08, 0xb3, 0x4c, 0x25, 0xb1, 0x75, 0x25, 0x63, 0xff, 0xe4, 0xe4,
+		0xa6, 0x11, 0x5c, 0x86, 0xc1, 0xfd, 0x75, 0xf0, 0x53, 0xe7, 0xb9, 0x59, 0xbf, 0x78, 0xbd, 0xaa,
+		0x3d, 0x7f, 0x78, 0x5d, 0xfd, 0xe5, 0xcb, 0xa6, 0xfb, 0xfe, 0xb6, 0xf8, 0xab, 0x46, 0xfd, 0xe2,
+		0xb5, 0xf6, 0xe9, 0xea, 0xaa, 0xf0, 0xc6, 0xe6, 0xf8, 0xc6, 0xda, 0xe9, 0xe4, 0xc6, 0x4e, 0xed,
+		0x65, 0xf2, 0xe7, 0xf3, 0xd9, 0x6b, 0xed, 0xe5, 0xa4, 0x79, 0xd3, 0x08, 0x9a, 0x9d, 0xd9, 0x3f,
+		0x34, 0xcf, 0x6e, 0x1a, 0xc1, 0xc7, 0x4e, 0xad, 0xe6, 0xa3, 0x52, 0x6c, 0xd9, 0x4e, 0xd5, 0x49,
+		0xa5, 0x58, 0x2b, 0xa6, 0x45, 0x0c, 0x07, 0xdf, 0xa4, 0x58, 0xec, 0xe7, 0xf9, 0x63, 0x7f, 0x1e,
+		0x56, 0x62, 0x4f, 0x7b, 0x61, 0x1c, 0xc6, 0x65, 0x73, 0x45, 0xb8, 0x2b, 0xa3, 0x00, 0x2f, 0xd8,
+		0xeb, 0x3e, 0xcd, 0x2c, 0xd8, 0xab, 0xf6, 0xe5, 0x0f, 0x86, 0xf9, 0x41, 0xe7, 0x83, 0xc4, 0x9e,
+		0xbf, 0xbe, 0xf5, 0x64, 0xbf, 0xeb, 0xad, 0xfe, 0x05, 0x1a, 0x0c, 0x1a, 0xbc, 0x5f, 0xbc, 0xf2,
+		0x70, 0xcb, 0xc4, 0xaf, 0x03, 0x1a, 0xac, 0xe2, 0xd1, 0x93, 0x4a, 0x26, 0x6c, 0x44, 0x80, 0x06,
+		0x5f, 0x58, 0xf4, 0xf1, 0x25, 0x1e, 0x3d, 0xe5, 0x1f, 0xf5, 0x5a, 0xe1, 0x53, 0x88, 0x92, 0x69,
+		0xed, 0x2f, 0x4b, 0x25, 0x68, 0x73, 0xdc, 0x13, 0x93, 0x34, 0x40, 0x79, 0x41, 0x79, 0x55, 0x43,
+		0x79, 0x71, 0x49, 0xc8, 0xec, 0xf2, 0x55, 0xdc, 0x93, 0x3b, 0x57, 0x37, 0xef, 0x0c, 0xa7, 0xea,
+		0x96, 0x06, 0x54, 0x69, 0xc0, 0x3a, 0x03, 0xae, 0x33, 0x00, 0xbb, 0x04, 0xb2, 0x1d, 0xa0, 0x2d,
+		0x81, 0x2d, 0xc7, 0x4e, 0x36, 0x9e, 0xdc, 0x80, 0x53, 0x75, 0xcb, 0x08, 0xa3, 0x70, 0xac, 0xe4,
+		0xb6, 0xc6, 0x10, 0xe0, 0x54, 0xdd, 0x83, 0x99, 0x2a, 0x9c, 0xaa, 0x5b, 0xa2, 0xf3, 0xda, 0xfe,
+		0xf9, 0xaf, 0x56, 0xa7, 0xca, 0x86, 0x0c, 0xf7, 0xe8, 0x56, 0x35, 0x3e, 0xe9, 0x0e, 0x6c, 0xab,
+		0x78, 0xa0, 0xc0, 0xb6, 0xc0, 0xb6, 0x88, 0xc0, 0xb6, 0x88, 0xc0, 0xb6, 0x1c, 0x98, 0x70, 0xb0,
+		0x2d, 0x22, 0xb0, 0x2d, 0xb0, 0x2d, 0xf9, 0xe7, 0x97, 0xeb, 0x4a, 0xb3, 0x0c, 0x25, 0x90, 0x0d,
+		0x29, 0xc8, 0x37, 0xc0, 0x4f, 0x6d, 0x3c, 0xdb, 0x24, 0x11, 0x62, 0x90, 0x1f, 0x4a, 0x7b, 0x3b,
+		0x31, 0x16, 0x15, 0xde, 0x47, 0xf8, 0x3a, 0x45, 0xb7, 0xe5, 0x3e, 0xc2, 0xa4, 0x1b, 0x6c, 0x82,
+		0x62, 0x1f, 0x81, 0xa1, 0x3b, 0xb0, 0x09, 0x2a, 0x46, 0x78, 0x05, 0x88, 0xae, 0x10, 0xc1, 0x7d,
+		0xf6, 0x2a, 0x45, 0x68, 0x5d, 0x1d, 0xa5, 0xdf, 0xa8, 0xf0, 0x31, 0xfa, 0x75, 0xaf, 0x52, 0x44,
+		0xd5, 0xd5, 0x14, 0x08, 0x12, 0x53, 0x27, 0xd3, 0xb0, 0x27, 0x02, 0x88, 0x18, 0x55, 0x51, 0x42,
+		0xb9, 0x8f, 0x28, 0xd5, 0x9c, 0x42, 0x56, 0x22, 0x4e, 0x35, 0xed, 0x0e, 0x83, 0x54, 0xd9, 0xc4,
+		0xa7, 0xce, 0x7a, 0xc0, 0xb9, 0x90, 0xce, 0xa9, 0x1f, 0xe2, 0x52, 0xf9, 0xea, 0xc6, 0xfe, 0x5c,
+		0xc8, 0x9c, 0xc2, 0x7d, 0xb4, 0x38, 0x08, 0xb2, 0x85, 0x83, 0x20, 0xed, 0xe8, 0x00, 0x0e, 0x82,
+		0x74, 0x36, 0xb4, 0xed, 0x73, 0x1c, 0xfb, 0x28, 0xab, 0x6f, 0x8c, 0x0e, 0x07, 0xdc, 0x04, 0x3f,
+		0xe3, 0x73, 0xff, 0x36, 0x09, 0x9a, 0x7d, 0x27, 0x46, 0x87, 0x09, 0xf2, 0x27, 0xc5, 0x84, 0xb4,
+		0xc4, 0xea, 0x5b, 0x16, 0x3c, 0xaa, 0xb0, 0xa7, 0x12, 0x3e, 0x6f, 0x59, 0xec, 0x04, 0xd4, 0x85,
+		0x08, 0xd4, 0xe5, 0x88, 0xa9, 0x4b, 0x34, 0x0c, 0x86, 0xc9, 0x20, 0x1b, 0x74, 0x07, 0xfd, 0x20,
+		0xcb, 0x7b, 0xb3, 0x38, 0xdc, 0xfe, 0x92, 0xd1, 0x76, 0xfa, 0x05, 0xa5, 0xb3, 0x18, 0x5b, 0xea,
+		0x26, 0x40, 0xe1, 0x84, 0xa8, 0x9c, 0xfd, 0x60, 0x2c, 0xda, 0x16, 0x38, 0xdf, 0xf8, 0xd7, 0x7b,
+		0x71, 0xbe, 0xb1, 0x4b, 0x2e, 0x95, 0x32, 0x09, 0x87, 0xe5, 0x7a, 0xab, 0xef, 0x4b, 0xf1, 0xbd,
+		0xe3, 0xdc, 0xab, 0x4e, 0x05, 0x98, 0x6a, 0x3a, 0x26, 0x4d, 0x56, 0xb5, 0x8b, 0xe6, 0x5d, 0x20,
+		0xf1, 0x9b, 0x08, 0x2c, 0x15, 0x65, 0x8b, 0xb6, 0x09, 0x0f, 0xca, 0x16, 0x21, 0x54, 0x81, 0x7b,
+		0x21, 0x54, 0x01, 0x65, 0x8b, 0x50, 0xb6, 0x48, 0xba, 0xc5, 0xc1, 0x6c, 0x09, 0x73, 0x49, 0x16,
+		0xb1, 0xf7, 0x82, 0x27, 0x9a, 0xb5, 0x1a, 0xc5, 0x8a, 0xa6, 0x5f, 0x6f, 0x57, 0xa7, 0x68, 0xb1,
+		0x13, 0x30, 0x55, 0xe7, 0xa6, 0x15, 0x4c, 0x75, 0x0f, 0x4c, 0x15, 0x25, 0x8a, 0x24, 0xf0, 0x27,
+		0x81, 0x43, 0x71, 0x3c, 0x4a, 0xe1, 0x52, 0x1c, 0x9f, 0xe2, 0x38, 0x75, 0x81, 0x57, 0x1e, 0x6e,
+		0x99, 0xf8, 0x75, 0x40, 0x79, 0x51, 0xa2, 0xc8, 0xe4, 0x42, 0x89, 0x22, 0x28, 0x2f, 0x28, 0x2f,
+		0x4b, 0xe5, 0x85, 0x12, 0x45, 0x6e, 0x80, 0x29, 0x09, 0x50, 0x67, 0x40, 0x95, 0x06, 0xac, 0x33,
+		0xe0, 0x3a, 0x03, 0xb0, 0x4b, 0x20, 0xdb, 0x01, 0xda, 0x12, 0xd8, 0x72, 0xec, 0x64, 0x63, 0x14,
+		0x03, 0x92, 0xe6, 0xf7, 0x11, 0xe6, 0x20, 0xac, 0xe4, 0xb6, 0xee, 0xb9, 0x23, 0x69, 0xfe, 0x60,
+		0xa6, 0x0a, 0x49, 0xf3, 0x25, 0x3a, 0xaa, 0xed, 0x9f, 0x8f, 0x12, 0x45, 0x44, 0x60, 0x5b, 0x60,
+		0x5b, 0x60, 0x5b, 0x44, 0x60, 0x5b, 0x44, 0x44, 0x60, 0x5b, 0x60, 0x5b, 0x44, 0x60, 0x5b, 0x60,
+		0x5b, 0xf2, 0xcf, 0x7f, 0x67, 0x25, 0x8a, 0x16, 0xf6, 0xbe, 0xf7, 0x54, 0x9d, 0x68, 0xc2, 0x6c,
+		0x50, 0x98, 0xa8, 0x1c, 0x75, 0x85, 0xdd, 0x83, 0x52, 0x69, 0x31, 0x76, 0x0f, 0xe4, 0xe9, 0x2e,
+		0x0a, 0x13, 0x39, 0xa7, 0xb1, 0xc8, 0x8d, 0xda, 0x3b, 0x3d, 0x45, 0x61, 0xa2, 0x52, 0x5b, 0x23,
+		0x0a, 0x55, 0x8a, 0x46, 0x96, 0x1c, 0x87, 0xea, 0xb4, 0x1c, 0x91, 0xe8, 0x19, 0xf2, 0xcc, 0xc9,
+		0x61, 0x4f, 0x8a, 0x5f, 0xf7, 0x9c, 0x4e, 0x80, 0xef, 0xc9, 0x8c, 0xa1, 0xc6, 0xf8, 0xf9, 0xa9,
+		0xfa, 0xd7, 0x48, 0xc5, 0x79, 0x9c, 0xb3, 0x7e, 0xe4, 0xc0, 0x9c, 0x65, 0x2e, 0x34, 0xae, 0x7b,
+		0x0e, 0x94, 0xaf, 0x31, 0xa9, 0xe6, 0x90, 0x68, 0x6b, 0xd2, 0xcc, 0x25, 0xc9, 0xd6, 0xa4, 0xd8,
+		0x9a, 0x04, 0x4b, 0x90, 0x5e, 0x59, 0x2c, 0x1b, 0x93, 0xd8, 0x25, 0xd2, 0x7a, 0x7e, 0x66, 0x32,
+		0x6d, 0x53, 0x59, 0xfc, 0x50, 0xf7, 0x1c, 0x93, 0x52, 0x5e, 0xb1, 0x17, 0x8b, 0x38, 0x70, 0xbb,
+		0x95, 0xac, 0x25, 0xa9, 0x94, 0x60, 0x2f, 0xaf, 0xbc, 0xd2, 0x36, 0x7b, 0x1f, 0x32, 0x0b, 0x12,
+		0x28, 0x32, 0x6c, 0x8e, 0xec, 0x75, 0xa7, 0x4c, 0x8b, 0x94, 0x9b, 0xf2, 0x34, 0x8b, 0xba, 0x29,
+		0xc3, 0x20, 0xcd, 0xdb, 0xd6, 0x3d, 0x07, 0x21, 0xa3, 0x73, 0x7b, 0x74, 0x06, 0x7b, 0xf4, 0x2e,
+		0xec, 0x91, 0x69, 0x48, 0xa6, 0xdf, 0x0f, 0xd3, 0x2c, 0x98, 0xd0, 0x44, 0x76, 0xde, 0xd4, 0x42,
+		0x1f, 0x28, 0x43, 0xe5, 0x4c, 0xdc, 0xc5, 0xc4, 0x5e, 0x4c, 0xfc, 0x25, 0x61, 0x60, 0x06, 0x07,
+		0x43, 0x58, 0xf0, 0xe9, 0xda, 0xda, 0xec, 0xf7, 0xc2, 0x4c, 0x05, 0x61, 0xdc, 0x0b, 0xb2, 0xe8,
+		0x49, 0x05, 0x3d, 0xd5, 0xcf, 0x42, 0x9b, 0x4a, 0x54, 0x1f, 0x2b, 0x90, 0x3b, 0x39, 0x46, 0xae,
+		0xea, 0x05, 0xc3, 0xb0, 0xfb, 0x97, 0xca, 0x52, 0xbe, 0x1e, 0x58, 0xed, 0x08, 0xca, 0x00, 0xca,
+		0xe0, 0xa8, 0x95, 0xc1, 0x7f, 0x54, 0x32, 0x08, 0xee, 0xc2, 0x54, 0xf5, 0x82, 0xee, 0x60, 0x14,
+		0x67, 0x2a, 0x69, 0x5f, 0x58, 0x68, 0x83, 0x8f, 0xa8, 0xae, 0x4b, 0x56, 0xcb, 0x19, 0x54, 0xd7,
+		0x75, 0x36, 0xb4, 0xcd, 0x8f, 0x17, 0x17, 0xed, 0x0f, 0x17, 0x17, 0x8d, 0x0f, 0xe7, 0x1f, 0x1a,
+		0x97, 0xad, 0x56, 0xb3, 0xdd, 0x6c, 0xa1, 0xde, 0xae, 0x54, 0xff, 0x07, 0xeb, 0x60, 0x36, 0x5e,
+		0xc1, 0x12, 0xc3, 0xcb, 0xfc, 0xc7, 0xfc, 0x21, 0x52, 0x0b, 0x7b, 0xcf, 0x62, 0x9c, 0x73, 0x9b,
+		0x6b, 0xe6, 0x4e, 0x36, 0xab, 0xa4, 0xcc, 0xaa, 0x9c, 0xcc, 0xaa, 0x94, 0x6c, 0x56, 0x19, 0xb9,
+		0x68, 0x58, 0x0c, 0xc5, 0x8e, 0x21, 0x6e, 0x7e, 0xdd, 0x13, 0x96, 0x2d, 0xdf, 0xe3, 0x89, 0xc9,
+		0x8e, 0xb1, 0xf0, 0xa7, 0xcf, 0x7e, 0xf6, 0xb4, 0x38, 0xc4, 0xf8, 0xee, 0xba, 0x27, 0xa0, 0xcb,
+		0xb5, 0x99, 0xb0, 0x09, 0xf3, 0x65, 0x33, 0x5d, 0x53, 0x66, 0xcb, 0x66, 0xb2, 0x6c, 0xe6, 0x6a,
+		0xc3, 0x54, 0xed, 0x14, 0x88, 0x36, 0xf3, 0x34, 0x91, 0x12, 0x32, 0xac, 0x58, 0xe5, 0xff, 0xa2,
+		0xe2, 0x87, 0xec, 0x51, 0x9b, 0x3b, 0x3e, 0x7b, 0x4e, 0xb9, 0x21, 0x77, 0xf9, 0xf7, 0x46, 0x50,
+		0xea, 0x5e, 0x59, 0xec, 0xc3, 0x64, 0x71, 0xcd, 0xe1, 0x72, 0xb6, 0x43, 0x61, 0x5c, 0xf6, 0xd6,
+		0x6a, 0x30, 0x84, 0x4c, 0x72, 0x87, 0xab, 0x89, 0x3d, 0x83, 0x29, 0x9a, 0x99, 0xee, 0x1d, 0x70,
+		0xd2, 0xb3, 0xd5, 0x46, 0x36, 0xda, 0xc8, 0x36, 0xeb, 0xd9, 0xe4, 0x6d, 0xdf, 0xa7, 0x69, 0x83,
+		0x8d, 0x6c, 0xef, 0x0e, 0xbd, 0xa3, 0x6d, 0x6d, 0x7d, 0x4f, 0x6f, 0xee, 0x36, 0x7c, 0x97, 0xff,
+		0x14, 0x76, 0x67, 0x6f, 0xb2, 0xed, 0x9b, 0x16, 0x5c, 0x50, 0xdd, 0xdd, 0x6f, 0x5d, 0xb0, 0x83,
+		0x52, 0x68, 0x43, 0x75, 0x6c, 0xa7, 0xb1, 0xcd, 0xd4, 0xb5, 0x95, 0xc6, 0x36, 0xd2, 0xd8, 0x36,
+		0x72, 0x6c, 0xa2, 0x19, 0x02, 0x8b, 0x76, 0x28, 0xfc, 0x9e, 0x4a, 0xbb, 0x49, 0x34, 0xd4, 0xe2,
+		0x91, 0x73, 0xb7, 0xec, 0x42, 0x23, 0x90, 0x29, 0x90, 0x29, 0x21, 0x61, 0x21, 0x70, 0xaa, 0xb2,
+		0x68, 0x04, 0x38, 0x95, 0x2e, 0x20, 0x4a, 0xe0, 0x54, 0x3b, 0x4c, 0xd5, 0x64, 0x21, 0xae, 0xad,
+		0x98, 0x75, 0xd6, 0xed, 0x9a, 0x51, 0x0d, 0x50, 0xc9, 0x87, 0xa2, 0x92, 0x75, 0xa3, 0x10, 0xfc,
+		0x09, 0x42, 0xcc, 0x03, 0x67, 0xa6, 0xed, 0x1c, 0x07, 0xcd, 0x20, 0x88, 0x53, 0x52, 0x1c, 0x25,
+		0xc4, 0xd2, 0x50, 0x41, 0xba, 0x0a, 0x9a, 0x09, 0xbb, 0x5d, 0x35, 0xb4, 0x28, 0x34, 0x3c, 0x6d,
+		0x8f, 0x1a, 0xc3, 0xce, 0xc4, 0x5c, 0x4c, 0xdc, 0xc5, 0xc4, 0x5e, 0x52, 0xfc, 0xcd, 0x60, 0x60,
+		0x08, 0x07, 0x36, 0x2c, 0x66, 0x97, 0xdf, 0x1f, 0x3c, 0xd8, 0xe7, 0xc6, 0xe6, 0x9d, 0xd8, 0x57,
+		0x16, 0xbe, 0x0f, 0xfb, 0x29, 0x32, 0x6c, 0x91, 0x61, 0xbb, 0x67, 0xa0, 0xf2, 0x00, 0xcb, 0x04,
+		0xae, 0xf9, 0xca, 0xb8, 0x50, 0x7a, 0xee, 0x06, 0x83, 0xbe, 0x0a, 0x45, 0x0a, 0x0b, 0x37, 0x91,
+		0x60, 0xa7, 0xe3, 0x7f, 0x9d, 0x3b, 0x32, 0xa7, 0x3b, 0xed, 0x13, 0xca, 0x7b, 0xca, 0xa2, 0x0e,
+		0x54, 0xec, 0xaa, 0xfd, 0x35, 0xec, 0x2e, 0xed, 0xb9, 0x5f, 0x8f, 0x1f, 0x77, 0x7b, 0x3d, 0x79,
+		0x5c, 0x15, 0x8e, 0xf9, 0x4f, 0x06, 0x16, 0x47, 0x90, 0x8d, 0x5b, 0x83, 0x6f, 0x39, 0x57, 0xef,
+		0xe0, 0x5b, 0x44, 0xe0, 0x5b, 0xe0, 0x5b, 0xe0, 0x5b, 0x7b, 0x05, 0x2a, 0x0f, 0xb0, 0x4c, 0xe0,
+		0x12, 0x81, 0x6f, 0x1d, 0x2d, 0xdf, 0x62, 0x10, 0x07, 0x62, 0xb3, 0xad, 0xcf, 0xf9, 0xc3, 0xde,
+		0x77, 0x88, 0xe9, 0x96, 0x79, 0x90, 0x8b, 0x2e, 0xdd, 0x3c, 0xf4, 0x65, 0xd6, 0x30, 0x30, 0xd9,
+		0xe0, 0x5e, 0xd3, 0x0c, 0x66, 0x7b, 0x97, 0x84, 0x1a, 0x06, 0xc2, 0x66, 0x10, 0x35, 0x0c, 0xac,
+		0x84, 0x91, 0x98, 0xc7, 0x6a, 0x9a, 0x6e, 0xac, 0xcf, 0xae, 0x03, 0x2d, 0x65, 0xd0, 0x44, 0x29,
+		0x03, 0xe7, 0x1b, 0xf4, 0xa2, 0x83, 0x76, 0x04, 0x85, 0x0c, 0xcc, 0x92, 0xc0, 0x97, 0x93, 0x3e,
+		0xb1, 0x13, 0x0b, 0x53, 0x24, 0xa0, 0x54, 0xcc, 0x77, 0x62, 0x7b, 0x2a, 0xcd, 0xa2, 0x78, 0x4c,
+		0x44, 0x83, 0xa7, 0xb0, 0x6b, 0xe1, 0x20, 0x5c, 0xe9, 0x08, 0xbe, 0x42, 0x67, 0x82, 0x2f, 0x06,
+		0x00, 0x31, 0x20, 0x48, 0x02, 0xc2, 0x0c, 0x18, 0x86, 0x00, 0x61, 0x03, 0x65, 0x76, 0xf9, 0x61,
+		0xaf, 0x97, 0xa8, 0x34, 0xb5, 0xf7, 0x17, 0xce, 0x3a, 0x82, 0xaf, 0x0f, 0xbe, 0xbe, 0xbd, 0x02,
+		0x8d, 0x07, 0x38, 0x26, 0xf0, 0xf8, 0x8b, 0xa6, 0xad, 0xd2, 0x93, 0x7b, 0x3c, 0xec, 0xc0, 0x44,
+		0xcc, 0x45, 0xd5, 0x3a, 0x28, 0xc2, 0x2c, 0x53, 0x49, 0x6c, 0x5d, 0xc5, 0xd8, 0xbf, 0x69, 0x04,
+		0x97, 0x61, 0x70, 0x7f, 0x1d, 0xfc, 0xd4, 0x79, 0x3e, 0x7b, 0x3d, 0xb9, 0x5a, 0xfe, 0x7b, 0xed,
+		0xb9, 0xf5, 0xea, 0x57, 0xbf, 0x42, 0x2b, 0xc3, 0x22, 0x3e, 0x85, 0xe9, 0x5f, 0xf6, 0xaa, 0x75,
+		0xdc, 0x0b, 0xf4, 0x2a, 0xf4, 0x2a, 0x11, 0xf4, 0x2a, 0x11, 0xf4, 0xea, 0x71, 0xe9, 0xd5, 0x23,
+		0xdb, 0x28, 0x9a, 0x14, 0xbe, 0xb6, 0x5b, 0x3f, 0x92, 0xf9, 0xbe, 0xc5, 0xa4, 0xf6, 0xf5, 0xe7,
+		0xf9, 0x63, 0x7f, 0x0d, 0xbb, 0x55, 0x88, 0xd3, 0x51, 0xd9, 0xa3, 0x4a, 0x32, 0x0e, 0x8a, 0xe7,
+		0x39, 0x23, 0x6f, 0x5d, 0xa0, 0x82, 0x98, 0x73, 0xe3, 0x86, 0x55, 0x38, 0x5f, 0xd9, 0xd8, 0x57,
+		0x10, 0xe3, 0x8a, 0xfa, 0xa2, 0xb8, 0x37, 0x2f, 0x19, 0x6d, 0xa7, 0xaf, 0x5e, 0x7a, 0xd9, 0x30,
+		0x9c, 0xd9, 0x22, 0xbd, 0xe7, 0x24, 0xa4, 0x05, 0x0b, 0x37, 0x58, 0x9a, 0xad, 0xf3, 0x36, 0x8e,
+		0x6d, 0xd9, 0xf7, 0x2c, 0xe0, 0xd8, 0x16, 0x49, 0xf2, 0x5a, 0xdf, 0x97, 0xfa, 0x53, 0xf1, 0xe8,
+		0x49, 0x25, 0xa1, 0xe1, 0xce, 0xf9, 0x36, 0x21, 0x6b, 0x5e, 0x58, 0xf4, 0xf1, 0x25, 0x1e, 0x3d,
+		0xe5, 0x62, 0x7f, 0xf0, 0x35, 0xf4, 0x4c, 0x42, 0x84, 0x26, 0xc7, 0xd4, 0x58, 0xed, 0x18, 0x2d,
+		0xf4, 0x81, 0xcd, 0x22, 0x22, 0xd0, 0x54, 0x6c, 0x16, 0x6d, 0x95, 0x1e, 0x6c, 0x16, 0xc1, 0xa9,
+		0xc9, 0xbe, 0xe0, 0xd4, 0x84, 0x53, 0x93, 0xdb, 0x39, 0x36, 0x8b, 0x34, 0xc4, 0x00, 0x7a, 0x95,
+		0x08, 0x7a, 0x15, 0x7a, 0x15, 0x7a, 0xb5, 0x7a, 0x7a, 0xf5, 0x28, 0x37, 0x8b, 0xd8, 0x4b, 0x47,
+		0xe2, 0xee, 0x13, 0x4d, 0x94, 0x9a, 0xcb, 0x2d, 0xa2, 0x03, 0x4d, 0x2f, 0x12, 0x3e, 0x21, 0x75,
+		0xe3, 0xe8, 0xe3, 0x80, 0x54, 0x36, 0x65, 0x40, 0x44, 0x37, 0x0e, 0x48, 0xd5, 0x1f, 0x7e, 0x1c,
+		0x90, 0xba, 0x73, 0xef, 0x00, 0x07, 0xa4, 0x96, 0xb9, 0xdd, 0x82, 0xbc, 0x22, 0x22, 0x1c, 0x90,
+		0x0a, 0x7b, 0x84, 0x03, 0x52, 0x71, 0x40, 0xaa, 0xd1, 0x85, 0xad, 0x22, 0x7b, 0x38, 0x18, 0xc2,
+		0x82, 0x4f, 0xd7, 0x88, 0x70, 0x40, 0xaa, 0xf6, 0xd8, 0xe0, 0x80, 0x54, 0x28, 0x03, 0x1c, 0x90,
+		0x8a, 0x03, 0x52, 0x5d, 0x43, 0x7e, 0xeb, 0x72, 0x06, 0x07, 0xa4, 0x12, 0xe1, 0x80, 0x54, 0x87,
+		0xad, 0x3a, 0x70, 0x2f, 0x3b, 0x3f, 0x1f, 0x75, 0xd5, 0xc7, 0x8c, 0xe3, 0x51, 0xdf, 0xe7, 0xf1,
+		0xa8, 0xab, 0xc2, 0x66, 0x7f, 0x3a, 0xea, 0x8a, 0x64, 0xe1, 0x70, 0xd4, 0x82, 0x0b, 0x87, 0xc7,
+		0x38, 0x50, 0x1f, 0x38, 0x1c, 0x95, 0x2d, 0xe4, 0xdb, 0xc9, 0x09, 0x0e, 0xf2, 0xc2, 0xe1, 0xa8,
+		0x45, 0x53, 0x84, 0xc3, 0x51, 0x89, 0xc8, 0xd0, 0xf2, 0xf2, 0xcf, 0x46, 0x7d, 0xb3, 0xb5, 0xda,
+		0x47, 0xa3, 0x7a, 0x3b, 0xbe, 0xb1, 0xe8, 0xdb, 0x0a, 0xbf, 0x69, 0xc3, 0x87, 0xec, 0xfe, 0x00,
+		0xdf, 0xdb, 0xfc, 0x72, 0x0b, 0x2f, 0xe6, 0x47, 0xf9, 0x8a, 0xff, 0x3e, 0xdc, 0x60, 0xe8, 0xde,
+		0x74, 0xf7, 0xfc, 0x96, 0xba, 0xb7, 0x09, 0xe6, 0x2b, 0x9a, 0x6b, 0xab, 0x19, 0xdf, 0x65, 0xb6,
+		0x37, 0x98, 0xe9, 0xe8, 0x7e, 0xd3, 0x07, 0x17, 0x58, 0x65, 0x6d, 0x2b, 0xac, 0x6d, 0x75, 0xb7,
+		0x58, 0xd9, 0xe8, 0xde, 0x37, 0x9c, 0xfe, 0x6d, 0xdb, 0x1a, 0x7e, 0xd8, 0x7b, 0x8a, 0xe2, 0x20,
+		0x5f, 0x86, 0xa8, 0xe2, 0xd3, 0x73, 0x17, 0x6f, 0xae, 0x7b, 0x45, 0xb5, 0xde, 0x55, 0x1c, 0xde,
+		0xf5, 0x55, 0xc1, 0x39, 0xbb, 0x8d, 0x72, 0xcf, 0xd9, 0xdd, 0x38, 0xaf, 0xba, 0xf3, 0x6b, 0x3c,
+		0xcf, 0xc6, 0xf3, 0xcd, 0x98, 0x77, 0x9e, 0x2a, 0x2f, 0x24, 0x51, 0x06, 0x73, 0x4e, 0x9a, 0x99,
+		0x66, 0x05, 0x99, 0x64, 0x7a, 0x87, 0x3d, 0xeb, 0x14, 0x47, 0x36, 0xa8, 0x3f, 0x0b, 0x31, 0x3c,
+		0x10, 0x31, 0x2c, 0x9e, 0x4a, 0xd2, 0xa4, 0xf0, 0xba, 0xd4, 0x5d, 0xcf, 0xd3, 0x60, 0xb0, 0x44,
+		0x33, 0x8b, 0x84, 0x33, 0xa4, 0xe6, 0x1c, 0x16, 0xfa, 0xaa, 0xe7, 0x17, 0x71, 0xfe, 0x89, 0xda,
+		0x94, 0x9b, 0xf5, 0x91, 0x4c, 0x6a, 0xdc, 0xb1, 0x50, 0x52, 0xe3, 0xaa, 0x0d, 0xb1, 0xca, 0x8a,
+		0x35, 0xd4, 0xdb, 0x9d, 0x95, 0x3a, 0x8d, 0x1e, 0xea, 0x89, 0x48, 0xeb, 0x2c, 0xfa, 0xe1, 0x20,
+		0xc9, 0x82, 0x74, 0xa8, 0x54, 0x4f, 0xdf, 0x67, 0xb5, 0xd0, 0xe6, 0xa0, 0x3c, 0x57, 0x3b, 0x85,
+		0xc2, 0x54, 0x38, 0xd8, 0x42, 0xc2, 0x16, 0x16, 0x0b, 0xa1, 0xd1, 0x54, 0x25, 0xe2, 0x7e, 0x2b,
+		0xb3, 0x22, 0x00, 0x26, 0xc9, 0xfe, 0x9a, 0x49, 0xfd, 0x52, 0x8e, 0x03, 0xeb, 0x85, 0xf5, 0xdb,
+		0x2a, 0x30, 0x3d, 0x7d, 0xfb, 0xf1, 0xb4, 0x40, 0x77, 0xd2, 0xf6, 0x25, 0xea, 0xcf, 0x6f, 0xdd,
+		0xdd, 0xbe, 0xfd, 0x78, 0xfb, 0x65, 0xd6, 0x9d, 0x85, 0xe2, 0x1f, 0x07, 0x72, 0x75, 0x1f, 0xa7,
+		0x5b, 0xca, 0x05, 0xba, 0x7f, 0xf1, 0x66, 0x4b, 0x76, 0x7a, 0x06, 0xf5, 0xbf, 0x5f, 0x76, 0x6a,
+		0x14, 0xd4, 0x54, 0x10, 0xbc, 0xa4, 0x27, 0x6a, 0x4f, 0xd9, 0xa8, 0x58, 0xc4, 0xf2, 0x9b, 0xb0,
+		0xf0, 0x39, 0x68, 0xd1, 0x2a, 0x2c, 0x84, 0xa5, 0x51, 0xe8, 0x4a, 0x33, 0xc8, 0xa5, 0x2a, 0x2b,
+		0x9e, 0x56, 0xa3, 0x71, 0xfc, 0x8b, 0x9e, 0x4b, 0xb7, 0x5f, 0xb9, 0x87, 0x55, 0xcf, 0xce, 0xad,
+		0x5b, 0x9d, 0xcd, 0x38, 0xe8, 0xa4, 0x03, 0xd1, 0x49, 0x6f, 0x1c, 0x2c, 0xd8, 0x31, 0x9b, 0x74,
+		0x9c, 0xfe, 0x98, 0xf3, 0x77, 0xe0, 0x8f, 0xa9, 0xa2, 0x62, 0xaa, 0x7b, 0xd6, 0x19, 0xf1, 0xfe,
+		0xc9, 0xd3, 0xc3, 0x53, 0xd6, 0x78, 0x99, 0x2d, 0x1c, 0x82, 0x9b, 0x66, 0x70, 0xd9, 0xc9, 0xd3,
+		0xde, 0x3b, 0x9f, 0x4e, 0x17, 0x7e, 0x9e, 0xfc, 0xff, 0xa5, 0x1f, 0x3e, 0xac, 0xff, 0x32, 0x4a,
+		0xee, 0xc6, 0x3f, 0x2d, 0xdf, 0x39, 0x58, 0xfb, 0x5d, 0xcd, 0x77, 0xa2, 0x63, 0x07, 0x43, 0x95,
+		0x04, 0xbd, 0xc1, 0xbf, 0xe3, 0x20, 0x51, 0x61, 0xaa, 0xe3, 0x03, 0x5f, 0x6b, 0x81, 0xa5, 0xc6,
+		0x41, 0xeb, 0x5e, 0x3d, 0xa7, 0x40, 0x69, 0xfb, 0x31, 0x63, 0xf1, 0xd2, 0xdc, 0x3e, 0x5c, 0xb8,
+		0x17, 0x42, 0x78, 0xd0, 0x42, 0x58, 0x38, 0x93, 0xa5, 0xca, 0xa0, 0x46, 0x06, 0xa9, 0x7e, 0xc6,
+		0xa8, 0xb6, 0xcb, 0x1d, 0x32, 0xb8, 0x17, 0x97, 0x7b, 0x14, 0x07, 0xbd, 0x28, 0xed, 0x86, 0x49,
+		0xcf, 0x20, 0xe3, 0x6a, 0x81, 0xb9, 0x6e, 0x68, 0x0d, 0x37, 0x3c, 0xdc, 0xf0, 0xf2, 0x49, 0x4a,
+		0x06, 0x49, 0x49, 0x86, 0x49, 0x48, 0x15, 0x0f, 0x26, 0x6d, 0x20, 0x98, 0x54, 0x2e, 0xe9, 0xe7,
+		0xa0, 0xa3, 0x4b, 0xeb, 0x3b, 0xd5, 0xb8, 0x4a, 0x92, 0x41, 0xc2, 0x52, 0xe1, 0xcb, 0x2d, 0xa1,
+		0xbe, 0xa1, 0xbe, 0xa1, 0xbe, 0xa1, 0xbe, 0xa1, 0xbe, 0x4b, 0x55, 0xdf, 0x83, 0x6e, 0x66, 0xaa,
+		0xb7, 0xa7, 0x4d, 0xa0, 0xb0, 0xa1, 0xb0, 0xa1, 0xb0, 0xa1, 0xb0, 0xa1, 0xb0, 0x4b, 0x55, 0xd8,
+		0xa3, 0x38, 0xea, 0xe6, 0x21, 0x47, 0x1c, 0xc6, 0xbd, 0xda, 0x16, 0x2a, 0x1c, 0x2a, 0x1c, 0x2a,
+		0x1c, 0x2a, 0x1c, 0x2a, 0xbc, 0x3c, 0x15, 0x3e, 0x18, 0x65, 0x36, 0xae, 0xef, 0xcd, 0xcd, 0xa1,
+		0xc8, 0xa1, 0xc8, 0xa1, 0xc8, 0xa1, 0xc8, 0xa1, 0xc8, 0xcb, 0x55, 0xe4, 0x4c, 0xe7, 0xf7, 0x7a,
+		0x53, 0x28, 0x70, 0x28, 0x70, 0x28, 0x70, 0x28, 0x70, 0x28, 0xf0, 0x72, 0x15, 0xb8, 0xa9, 0xfb,
+		0x7b, 0xa1, 0x0d, 0x54, 0x36, 0x54, 0x36, 0x54, 0x36, 0x54, 0x36, 0x54, 0x76, 0xb9, 0x2a, 0x9b,
+		0xed, 0x00, 0xdf, 0xd4, 0x18, 0x4a, 0x1c, 0x4a, 0x1c, 0x4a, 0x1c, 0x4a, 0x1c, 0x4a, 0x5c, 0x58,
+		0x89, 0x57, 0xa0, 0xb2, 0x84, 0x56, 0x55, 0x6e, 0x93, 0xda, 0x12, 0x45, 0x25, 0xb8, 0x35, 0xf3,
+		0x1c, 0x46, 0x77, 0xdb, 0x2b, 0x26, 0xae, 0x6b, 0xff, 0xc5, 0xbb, 0x51, 0x5e, 0xe8, 0x10, 0x73,
+		0x1d, 0xf2, 0xba, 0x9b, 0xda, 0x24, 0xa5, 0xb8, 0xb2, 0xb4, 0xe6, 0xe1, 0x67, 0x28, 0x85, 0x7d,
+		0x28, 0xa5, 0xb0, 0x75, 0x0f, 0x2b, 0xf3, 0xa3, 0x78, 0x38, 0xca, 0xcc, 0x8f, 0xd7, 0x9b, 0x34,
+		0x73, 0x7c, 0xb2, 0x1e, 0x4e, 0x7a, 0x95, 0x14, 0x46, 0x09, 0xa1, 0xd4, 0x13, 0x4e, 0x4d, 0x21,
+		0x35, 0x16, 0xd6, 0xb9, 0xd0, 0x0e, 0xbf, 0x5e, 0xcc, 0xca, 0x28, 0xb3, 0x8f, 0xd4, 0x5a, 0xec,
+		0x04, 0xc7, 0x69, 0x39, 0x13, 0x78, 0x31, 0xc1, 0x17, 0x03, 0x80, 0x24, 0x10, 0xcc, 0x00, 0x61,
+		0x08, 0x0c, 0xf3, 0x45, 0xe6, 0xd6, 0xd9, 0xef, 0xab, 0xf0, 0x3e, 0x51, 0xf7, 0x36, 0xe7, 0xe9,
+		0x7d, 0x60, 0xb4, 0xfd, 0x7d, 0x63, 0xd5, 0xf0, 0xab, 0xbc, 0x72, 0xf8, 0xf2, 0x6f, 0x16, 0xc0,
+		0xb8, 0xf2, 0x2f, 0x63, 0x16, 0x5d, 0x81, 0xa3, 0xfc, 0xa2, 0xe1, 0xd7, 0xb6, 0x84, 0xce, 0x69,
+		0x43, 0xe7, 0x40, 0xe7, 0x40, 0xe7, 0x54, 0x43, 0xe7, 0xb4, 0xab, 0xad, 0x73, 0x16, 0x4e, 0x8b,
+		0xb0, 0x38, 0x39, 0xb4, 0x0b, 0x8d, 0x03, 0x8d, 0x03, 0x8d, 0x53, 0x01, 0x8d, 0xb3, 0x70, 0xee,
+		0x5a, 0x89, 0x0a, 0xa7, 0xda, 0x07, 0x20, 0x6e, 0x76, 0xb3, 0x2e, 0x78, 0x28, 0xc7, 0xe7, 0xe6,
+		0x98, 0xf8, 0x1b, 0xc8, 0xd4, 0x05, 0xbb, 0xf0, 0xb0, 0xf1, 0x01, 0x3b, 0x3f, 0x8f, 0x1f, 0x26,
+		0x75, 0x22, 0x62, 0xf1, 0x4b, 0xe7, 0xbb, 0x88, 0x2c, 0x2f, 0xcc, 0xb4, 0x1d, 0xdc, 0x30, 0x70,
+		0xc3, 0x10, 0xc1, 0x0d, 0x43, 0xe4, 0x44, 0xb0, 0x6d, 0x04, 0x5c, 0x4c, 0xd0, 0x6d, 0x05, 0x5e,
+		0x4c, 0xf0, 0xc5, 0x00, 0x20, 0x09, 0x04, 0x33, 0x40, 0x18, 0x02, 0xe3, 0x1d, 0x11, 0x14, 0xb8,
+		0x61, 0xa0, 0x73, 0xa0, 0x73, 0xa0, 0x73, 0x4a, 0xd6, 0x39, 0x70, 0xc3, 0x40, 0xe3, 0x40, 0xe3,
+		0x40, 0xe3, 0xc0, 0x0d, 0x53, 0x71, 0x37, 0x8c, 0x91, 0xc3, 0x81, 0x6c, 0xfd, 0x30, 0x7f, 0x9f,
+		0x3c, 0x4d, 0xca, 0x11, 0x63, 0x15, 0x50, 0x63, 0x38, 0xe0, 0x96, 0x03, 0xad, 0x31, 0xc2, 0x36,
+		0x23, 0xeb, 0x3b, 0x08, 0xa3, 0xd7, 0x39, 0x2e, 0x78, 0x4d, 0x53, 0xe8, 0x1d, 0x21, 0x4b, 0x26,
+		0xc7, 0x07, 0xaf, 0xaa, 0x12, 0xc4, 0xd9, 0x4b, 0x9a, 0xb5, 0x03, 0x8a, 0xb3, 0xd7, 0x97, 0x2d,
+		0x2a, 0xfd, 0x8c, 0xbc, 0x1d, 0x38, 0xd2, 0x39, 0xc6, 0x78, 0xed, 0x5b, 0xf5, 0xce, 0xc0, 0x05,
+		0x2c, 0x00, 0x0b, 0x03, 0x51, 0x21, 0xcd, 0x63, 0x7a, 0x4c, 0x8f, 0xeb, 0x99, 0x5d, 0x15, 0xcf,
+		0x3b, 0x69, 0x22, 0xef, 0xc4, 0xf8, 0x18, 0x66, 0x91, 0xc1, 0xa8, 0x74, 0xad, 0xbc, 0x9e, 0xfa,
+		0x66, 0x52, 0x1e, 0x2f, 0xbf, 0x1d, 0x0a, 0x19, 0x0a, 0xb9, 0xe0, 0x18, 0xc7, 0xf3, 0x33, 0x03,
+		0x5d, 0xfc, 0x01, 0x19, 0x80, 0xef, 0x56, 0x13, 0x5f, 0x5e, 0x5e, 0x5e, 0x42, 0x15, 0xcf, 0x36,
+		0x9f, 0x0d, 0x34, 0x71, 0x7e, 0xf7, 0x41, 0xe5, 0x40, 0x41, 0x11, 0x97, 0x94, 0x01, 0x15, 0xf6,
+		0x7a, 0x89, 0x4a, 0x53, 0xf3, 0xe8, 0x9b, 0x59, 0xc3, 0xa3, 0x0a, 0xbf, 0x89, 0xee, 0xdf, 0x73,
+		0xf4, 0x8d, 0x8e, 0x58, 0x1a, 0x2a, 0x47, 0x77, 0xc1, 0x37, 0xc1, 0xd0, 0x7c, 0xc6, 0x57, 0x94,
+		0x62, 0xc0, 0x1b, 0xfd, 0xc3, 0xde, 0x92, 0x8a, 0xee, 0xb1, 0x23, 0x25, 0x03, 0x01, 0x33, 0x28,
+		0x18, 0x42, 0xc2, 0x9c, 0x52, 0x8b, 0x0b, 0xfa, 0x92, 0xde, 0xbe, 0x64, 0xb4, 0x9d, 0xbe, 0xfa,
+		0x0d, 0x6b, 0x82, 0x78, 0x82, 0x46, 0x6b, 0xc1, 0x75, 0x56, 0xe2, 0x46, 0x86, 0x4e, 0x20, 0xeb,
+		0xa3, 0x78, 0x8b, 0x2e, 0xff, 0xe4, 0x64, 0x7c, 0x8e, 0xee, 0xcb, 0xfc, 0x04, 0xde, 0x97, 0xe6,
+		0xfc, 0x90, 0xdd, 0x97, 0xb3, 0x9b, 0x46, 0x70, 0x31, 0xfb, 0xb9, 0x75, 0xd3, 0x08, 0x5a, 0x9d,
+		0xda, 0x9f, 0x7f, 0xfe, 0x50, 0x7b, 0x3e, 0x7f, 0x35, 0x6f, 0x78, 0x3a, 0x7d, 0x58, 0xed, 0xe5,
+		0xe4, 0xa6, 0x19, 0x9c, 0x75, 0x66, 0x7f, 0x39, 0xbf, 0x69, 0x04, 0x67, 0x9d, 0x5a, 0xcd, 0x67,
+		0x7f, 0x4a, 0x87, 0xd5, 0xf2, 0xb5, 0xbe, 0x47, 0x49, 0x6a, 0x1f, 0xa7, 0x24, 0x85, 0xc1, 0xfd,
+		0x75, 0xf0, 0x53, 0xe7, 0xb9, 0x59, 0xbf, 0x78, 0xbd, 0xaa, 0x3d, 0x7f, 0x78, 0x5d, 0xfd, 0xe5,
+		0xcb, 0xa6, 0xfb, 0xfe, 0xb6, 0xf8, 0xab, 0x46, 0xfd, 0xe2, 0xb5, 0xf6, 0xe9, 0xea, 0xaa, 0xf0,
+		0xc6, 0xe6, 0xf8, 0xc6, 0x25, 0xb1, 0xca, 0xff, 0x7c, 0x3e, 0x7b, 0xad, 0xbd, 0x9c, 0xe4, 0xc2,
+		0xd8, 0x7c, 0x13, 0xb1, 0x66, 0x2e, 0x8f, 0x1f, 0xf7, 0x21, 0x63, 0x9e, 0xdb, 0xe7, 0xec, 0x6b,
+		0xcb, 0xfc, 0xbf, 0xd5, 0x77, 0x8e, 0x2d, 0xf0, 0x7f, 0x89, 0xd2, 0xec, 0x3a, 0xcb, 0x0c, 0xe9,
+		0xd9, 0xaf, 0x51, 0xfc, 0xa5, 0xaf, 0x72, 0xf3, 0x9a, 0xfa, 0x57, 0x14, 0x8f, 0xfa, 0x7d, 0x33,
+		0xcf, 0x02, 0xbf, 0xf1, 0xdf, 0x93, 0x9e, 0x4a, 0x54, 0xef, 0xc7, 0xef, 0xd3, 0xa6, 0x87, 0x1e,
+		0x77, 0x90, 0xdb, 0xb0, 0x53, 0xb3, 0xb5, 0x16, 0xd9, 0xec, 0x8f, 0xff, 0x3c, 0xfc, 0x7a, 0x71,
+		0x7b, 0x3d, 0x7d, 0xde, 0x7b, 0x8b, 0x3d, 0xd0, 0xf0, 0x93, 0x58, 0x0f, 0xae, 0xef, 0xc6, 0x1b,
+		0xd4, 0x36, 0xf2, 0x06, 0xb5, 0xe1, 0x0d, 0x82, 0x37, 0x68, 0xfd, 0x82, 0x37, 0x08, 0xde, 0x20,
+		0x78, 0x83, 0xe0, 0x0d, 0x72, 0x24, 0xea, 0x62, 0x22, 0x2f, 0x26, 0xfa, 0x82, 0x10, 0x30, 0x83,
+		0x82, 0x21, 0x24, 0x56, 0x5d, 0x2a, 0xf0, 0x06, 0x11, 0xc1, 0x1b, 0x04, 0x6f, 0x10, 0x11, 0xbc,
+		0x41, 0xf0, 0x06, 0x39, 0x94, 0x31, 0x78, 0x83, 0x88, 0xe0, 0x0d, 0x12, 0x71, 0x4c, 0x08, 0x39,
+		0x28, 0xda, 0x25, 0x7b, 0x83, 0xda, 0xef, 0xd9, 0x1b, 0xd4, 0x76, 0xec, 0x0d, 0x6a, 0xbb, 0xf0,
+		0x06, 0xf5, 0xf3, 0xe3, 0x18, 0xba, 0x8f, 0xd3, 0x18, 0x38, 0x4d, 0xa7, 0xd0, 0x62, 0x23, 0xe1,
+		0x90, 0xcd, 0x33, 0xf8, 0x86, 0x74, 0xb4, 0xc9, 0xe1, 0xc4, 0xd0, 0x87, 0x99, 0x0a, 0xc2, 0xb8,
+		0x17, 0x64, 0xd1, 0x93, 0x0a, 0x7a, 0xaa, 0x9f, 0x85, 0x46, 0xb1, 0xf4, 0x2e, 0x4e, 0x31, 0x19,
+		0xaa, 0xc4, 0x34, 0xfb, 0x6a, 0xa1, 0x0d, 0x04, 0x1e, 0x02, 0x2f, 0x21, 0x29, 0x54, 0xa5, 0x54,
+		0xaa, 0x85, 0xc3, 0x1a, 0xb4, 0x31, 0xa1, 0x75, 0xc0, 0x03, 0x71, 0x36, 0x08, 0x80, 0x89, 0x2a,
+		0x63, 0xc2, 0xa0, 0x60, 0x3e, 0xe3, 0xa8, 0xed, 0xb5, 0x89, 0xdb, 0xd8, 0x8b, 0xd9, 0xd6, 0x41,
+		0x03, 0x5b, 0x07, 0xd8, 0x3a, 0xb0, 0x50, 0xee, 0x52, 0x07, 0x53, 0xad, 0xca, 0xa5, 0x81, 0x33,
+		0xc7, 0x30, 0x4d, 0x65, 0x76, 0x31, 0x7c, 0xbf, 0x9c, 0xb4, 0x15, 0x26, 0xe0, 0xb6, 0xe6, 0x6e,
+		0x70, 0xdb, 0x5b, 0x24, 0x70, 0x18, 0x0a, 0x8f, 0x75, 0x7a, 0x8b, 0xf4, 0x90, 0xd9, 0x1f, 0x78,
+		0x25, 0x3a, 0x8a, 0x8e, 0x3c, 0x5b, 0x9d, 0x12, 0x4b, 0x8d, 0x46, 0xb1, 0xe1, 0x01, 0xe3, 0x6b,
+		0x6a, 0x62, 0xad, 0x07, 0x98, 0x2d, 0x98, 0x2d, 0x22, 0x98, 0xad, 0xa2, 0x0b, 0x66, 0xcb, 0x58,
+		0xe1, 0xc2, 0x6c, 0xc1, 0x6c, 0xd1, 0xd4, 0x6c, 0x69, 0x1e, 0xab, 0xbe, 0xa6, 0x1f, 0xe6, 0x4d,
+		0x61, 0xa8, 0x60, 0xa8, 0x88, 0x60, 0xa8, 0x8a, 0x2e, 0x18, 0x2a, 0x63, 0x15, 0x0b, 0x43, 0x05,
+		0x43, 0x45, 0x53, 0x43, 0x65, 0x7a, 0x98, 0xfc, 0x9a, 0xa2, 0xd8, 0xd0, 0x07, 0x4c, 0x17, 0x4c,
+		0x17, 0x11, 0x4c, 0x57, 0xd1, 0x05, 0xd3, 0x65, 0xac, 0x74, 0x61, 0xba, 0x60, 0xba, 0x68, 0x7a,
+		0x0a, 0x91, 0xc4, 0xd6, 0xd6, 0xe6, 0x6e, 0x60, 0xc0, 0x60, 0xc0, 0x88, 0x60, 0xc0, 0x8a, 0x2e,
+		0x18, 0x30, 0x63, 0xd5, 0x0b, 0x03, 0x06, 0x03, 0x46, 0x33, 0x03, 0x66, 0xb9, 0xb9, 0xb5, 0xde,
+		0x05, 0x0c, 0x17, 0x0c, 0x17, 0x11, 0x0c, 0x57, 0xd1, 0x05, 0xc3, 0x65, 0xac, 0x72, 0x61, 0xb8,
+		0x60, 0xb8, 0x68, 0x66, 0xb8, 0xb8, 0xdb, 0x5b, 0x0b, 0x6d, 0x61, 0xaa, 0x60, 0xaa, 0x88, 0x60,
+		0xaa, 0x8a, 0x2e, 0x98, 0x2a, 0x63, 0x25, 0x0b, 0x53, 0x05, 0x53, 0x45, 0x33, 0x53, 0x65, 0xbd,
+		0xc1, 0xb5, 0xa9, 0x13, 0x18, 0x2f, 0x18, 0x2f, 0x22, 0x18, 0xaf, 0xa2, 0x0b, 0xc6, 0xcb, 0x58,
+		0xed, 0xc2, 0x78, 0xbd, 0x07, 0xe3, 0x75, 0x28, 0x49, 0xf5, 0xda, 0x19, 0x86, 0x64, 0x93, 0x5a,
+		0xff, 0xc7, 0xfc, 0x29, 0x0e, 0x32, 0x2b, 0xbf, 0xf6, 0x43, 0x83, 0xd3, 0xe9, 0xc6, 0x77, 0x1f,
+		0x58, 0xb9, 0xc5, 0x40, 0xe3, 0xa5, 0xe9, 0xb0, 0x53, 0x2a, 0x27, 0x9f, 0x58, 0x95, 0xbc, 0x4a,
+		0x15, 0x77, 0xc3, 0xa1, 0x39, 0x95, 0x9c, 0x34, 0x3b, 0xb2, 0xa2, 0x8b, 0xba, 0xc2, 0xc7, 0x15,
+		0x42, 0x6b, 0x61, 0xb4, 0x16, 0x4a, 0x29, 0xe1, 0x34, 0xb3, 0x37, 0xce, 0xca, 0x2f, 0xa6, 0x51,
+		0xfc, 0xd0, 0x57, 0x41, 0x16, 0x3e, 0x3c, 0xa8, 0x1e, 0xbf, 0x04, 0xe3, 0x72, 0x37, 0xbc, 0x32,
+		0x8c, 0xcd, 0x43, 0x2d, 0xc3, 0x68, 0x2a, 0xf4, 0xb6, 0xc2, 0x2f, 0x06, 0x02, 0x31, 0x30, 0x48,
+		0x83, 0xc2, 0x0c, 0x1c, 0x86, 0x20, 0x61, 0x83, 0x65, 0x76, 0x8d, 0x69, 0x41, 0x10, 0xf5, 0xf8,
+		0x13, 0xb7, 0xc8, 0x2f, 0xf2, 0x8e, 0xea, 0x7b, 0xa1, 0xeb, 0x5c, 0x00, 0x49, 0x00, 0xc9, 0x09,
+		0xa0, 0xa4, 0x80, 0x25, 0x0e, 0x30, 0x71, 0xa0, 0xb9, 0x02, 0x1c, 0x0f, 0x78, 0x4c, 0x00, 0xf2,
+		0x9d, 0x1f, 0x3b, 0x8f, 0xa4, 0x6c, 0xb6, 0x05, 0x2a, 0x3b, 0xb6, 0x2d, 0xba, 0xe0, 0xb9, 0x47,
+		0x56, 0x2f, 0x3b, 0xd1, 0x25, 0x5b, 0xf7, 0x89, 0xb0, 0xb2, 0xd9, 0xee, 0x2b, 0x10, 0xea, 0x4f,
+		0xc0, 0x51, 0x20, 0x24, 0xce, 0x62, 0xee, 0x98, 0xb2, 0xa6, 0xe0, 0xa2, 0x71, 0xd9, 0xaa, 0xf0,
+		0x2c, 0x78, 0xfb, 0x69, 0x5d, 0x56, 0xd5, 0x51, 0xb7, 0x1c, 0x87, 0x59, 0xe8, 0x52, 0xc2, 0x5d,
+		0x94, 0x5b, 0xa0, 0xd3, 0xf1, 0x2a, 0xf7, 0xd4, 0x66, 0x79, 0x40, 0x36, 0xce, 0xa4, 0xff, 0xe9,
+		0x87, 0xf1, 0xed, 0x97, 0xfc, 0x1d, 0x6e, 0xff, 0x18, 0xbf, 0xc3, 0x3f, 0x27, 0xaf, 0x70, 0x10,
+		0x95, 0x5e, 0xf7, 0x50, 0xa4, 0x74, 0x3e, 0x67, 0x65, 0x94, 0x28, 0x9d, 0xcf, 0xce, 0xbb, 0x2b,
+		0x50, 0xaa, 0xeb, 0xa4, 0xb3, 0x19, 0x5a, 0xb6, 0xff, 0xd4, 0x33, 0x18, 0xbc, 0xb7, 0x7a, 0xc4,
+		0x3b, 0x4e, 0x8c, 0xd7, 0xab, 0x3d, 0x6c, 0x54, 0x6b, 0xd8, 0xa8, 0xb6, 0xb0, 0x5e, 0x2d, 0xe1,
+		0x6d, 0x1f, 0x78, 0x3d, 0x7a, 0xc8, 0x1f, 0xa3, 0x7a, 0x3b, 0x29, 0x9d, 0xa6, 0x47, 0x79, 0x51,
+		0x48, 0xee, 0xaf, 0x16, 0x84, 0x63, 0xf1, 0xd7, 0x8b, 0x92, 0x02, 0xf7, 0xb3, 0xe5, 0x5a, 0xec,
+		0xd8, 0xdd, 0xcf, 0x5a, 0x3b, 0x1a, 0x6b, 0x53, 0x66, 0xb0, 0xc2, 0x86, 0xf3, 0x59, 0x4a, 0x14,
+		0xad, 0x45, 0x52, 0x4a, 0x34, 0xf5, 0x44, 0x54, 0xff, 0x2e, 0x07, 0x35, 0x50, 0xa1, 0x4f, 0xf3,
+		0x97, 0x0f, 0xbb, 0xfd, 0x63, 0xd6, 0xa5, 0xf9, 0xe7, 0x55, 0xe6, 0xfc, 0xb4, 0x6e, 0x9f, 0x71,
+		0x76, 0x9a, 0xd6, 0xfc, 0x1c, 0x90, 0x16, 0xd5, 0xff, 0xa0, 0xa3, 0xd4, 0xa0, 0x5a, 0x02, 0x59,
+		0x19, 0xed, 0xb9, 0xf1, 0x5f, 0x3a, 0x75, 0xcf, 0x66, 0x71, 0x63, 0xb1, 0xa8, 0xf1, 0xeb, 0x9e,
+		0xf4, 0x3a, 0xc6, 0xf7, 0xf4, 0x3e, 0x7c, 0x83, 0x16, 0x98, 0x6c, 0xae, 0xe4, 0x2e, 0x87, 0x28,
+		0x7e, 0xd8, 0xfa, 0xc9, 0xcb, 0x5b, 0x31, 0xb3, 0xbb, 0xeb, 0xde, 0x2e, 0x08, 0x6f, 0x41, 0x64,
+		0x21, 0x64, 0x75, 0x20, 0x6a, 0x1a, 0x92, 0xa9, 0x8b, 0x40, 0x63, 0xc4, 0x19, 0x23, 0x8c, 0x11,
+		0x52, 0x69, 0xb6, 0xd0, 0x2c, 0xdc, 0x25, 0x78, 0x1b, 0xbb, 0xbb, 0xc1, 0xa0, 0xaf, 0x76, 0x52,
+		0xc1, 0x37, 0x6d, 0xdc, 0xd4, 0x15, 0x30, 0x6f, 0xc7, 0x2b, 0xce, 0xd6, 0xc0, 0x63, 0xf1, 0xae,
+		0x7b, 0xfa, 0x4b, 0x5f, 0xad, 0x25, 0xaf, 0xd6, 0x52, 0x77, 0xf7, 0x12, 0x77, 0xf5, 0x7d, 0x0b,
+		0x74, 0x81, 0x99, 0x0e, 0xd8, 0x30, 0xcc, 0x06, 0x70, 0xf7, 0xbd, 0xcd, 0xc3, 0xbc, 0xf0, 0xca,
+		0x7e, 0xac, 0xb2, 0x7f, 0x0f, 0x92, 0xbf, 0x82, 0x28, 0x4e, 0xb3, 0x30, 0xde, 0x20, 0xed, 0x6f,
+		0x33, 0xbf, 0x76, 0x67, 0xdd, 0xdb, 0x38, 0xed, 0x75, 0x4f, 0x0b, 0xb9, 0xbb, 0x10, 0xbb, 0x01,
+		0xa9, 0xb1, 0xca, 0xf2, 0x07, 0x6f, 0x1a, 0x90, 0x02, 0x98, 0x6a, 0xc3, 0x53, 0x1b, 0x96, 0x5b,
+		0xe0, 0x38, 0x7b, 0x45, 0x43, 0x01, 0xdf, 0xc6, 0xa2, 0xfc, 0xb0, 0xf7, 0x14, 0xc5, 0x05, 0xc7,
+		0x77, 0x2c, 0x9c, 0x34, 0x3b, 0xbf, 0x79, 0xcb, 0x5b, 0x7f, 0x56, 0xf7, 0xe1, 0xa8, 0x9f, 0x4d,
+		0x02, 0xa4, 0xc2, 0xbb, 0xbe, 0xaa, 0x96, 0x2e, 0xde, 0x3e, 0xc3, 0x07, 0xa3, 0x90, 0xb7, 0x4a,
+		0x80, 0x6b, 0xad, 0x5c, 0x3c, 0xfb, 0xa4, 0x79, 0x06, 0x47, 0xc1, 0xd9, 0x1b, 0x7a, 0xf4, 0xa0,
+		0xa7, 0xd2, 0x6e, 0x12, 0x0d, 0x77, 0x12, 0xa2, 0xb7, 0x77, 0x5f, 0xbc, 0x19, 0x02, 0x79, 0x24,
+		0x02, 0x59, 0x3c, 0xa9, 0xa4, 0x79, 0xd6, 0xa3, 0xff, 0x8b, 0x8a, 0x1f, 0xb2, 0xc7, 0x9d, 0x0e,
+		0x62, 0xd2, 0xda, 0xd3, 0x37, 0xda, 0xb3, 0x37, 0xcd, 0x29, 0x32, 0xdc, 0x73, 0xe7, 0xec, 0xe6,
+		0xea, 0x64, 0x62, 0x99, 0xec, 0x89, 0x73, 0x3f, 0xf1, 0xac, 0xd5, 0x72, 0xf8, 0x91, 0xcc, 0x05,
+		0x5b, 0xc7, 0x42, 0x5d, 0xcd, 0xe9, 0x56, 0xa1, 0xb2, 0x2a, 0x5a, 0x90, 0x15, 0xb8, 0x22, 0xa0,
+		0xaa, 0xca, 0x52, 0x55, 0x45, 0xbe, 0x29, 0x7f, 0x4a, 0xa0, 0x35, 0x7d, 0xa1, 0x1b, 0x96, 0x1f,
+		0x4c, 0x38, 0x95, 0xe5, 0xde, 0x2c, 0x16, 0x09, 0x53, 0xd1, 0x60, 0x8b, 0x08, 0x5b, 0x54, 0x6c,
+		0x45, 0x46, 0x53, 0xa9, 0x88, 0x9f, 0x8c, 0xb6, 0xe8, 0xb9, 0x09, 0x34, 0x44, 0x87, 0x0c, 0xcf,
+		0x3d, 0xd6, 0xb5, 0x89, 0xb3, 0xcb, 0xec, 0x50, 0x5a, 0x86, 0xcb, 0x91, 0x77, 0xd8, 0xff, 0xcc,
+		0xa0, 0x18, 0x46, 0x48, 0xd9, 0x44, 0x42, 0xbd, 0x9a, 0x1d, 0xb1, 0x5b, 0xfa, 0x50, 0x9c, 0x95,
+		0x39, 0x16, 0x42, 0x3e, 0xd6, 0x4e, 0xdd, 0x13, 0x3f, 0x90, 0xdb, 0x3f, 0x79, 0x7a, 0x78, 0xca,
+		0x1a, 0x2f, 0x2a, 0x7b, 0x54, 0x49, 0xac, 0xb2, 0x60, 0x7e, 0x22, 0xfb, 0xa7, 0xd3, 0x85, 0x9f,
+		0x27, 0xff, 0x7f, 0xe9, 0x87, 0x0f, 0xeb, 0xbf, 0x8c, 0x92, 0xbb, 0x9b, 0xc6, 0xea, 0x2f, 0xfb,
+		0x83, 0xb5, 0xdf, 0xe5, 0x67, 0xbf, 0x9f, 0x34, 0x5e, 0xd6, 0x3a, 0x98, 0xfe, 0x6b, 0xb1, 0x7a,
+		0xe9, 0xe0, 0xe8, 0x4e, 0x98, 0x2a, 0x22, 0xa2, 0x03, 0x34, 0x55, 0x15, 0x3e, 0xc4, 0x93, 0x13,
+		0x2a, 0xb5, 0xc3, 0xd8, 0x1e, 0x78, 0xa4, 0x94, 0xed, 0x16, 0xd3, 0xaa, 0xfb, 0xf6, 0x74, 0xed,
+		0x17, 0x16, 0x9b, 0x4d, 0xbf, 0x4d, 0xfa, 0xfa, 0x79, 0xda, 0xd5, 0xed, 0xea, 0xdf, 0x7f, 0x96,
+		0xd8, 0x74, 0xda, 0xc9, 0xd8, 0x75, 0x98, 0x3a, 0xfc, 0x48, 0x07, 0xe7, 0x47, 0x2a, 0x20, 0xcf,
+		0x70, 0x20, 0xc1, 0x81, 0xa4, 0x6d, 0x50, 0xc8, 0x99, 0x03, 0x29, 0x56, 0xdf, 0xb2, 0xe0, 0x71,
+		0x30, 0x0c, 0x1e, 0x92, 0xc1, 0x68, 0x98, 0x6a, 0x28, 0xa9, 0x95, 0x06, 0xd5, 0x72, 0x26, 0x3d,
+		0x3e, 0x1c, 0xb4, 0xae, 0x7a, 0x7c, 0x28, 0xcd, 0x89, 0x34, 0x9e, 0x3e, 0x7d, 0x82, 0x3e, 0xb9,
+		0xfd, 0xa0, 0xa2, 0xe4, 0x76, 0x0b, 0x83, 0xa9, 0x50, 0xb0, 0x85, 0x83, 0x2d, 0x24, 0x36, 0xc2,
+		0xa2, 0xa9, 0x52, 0xc4, 0xa2, 0xe4, 0x34, 0xf6, 0x7b, 0xb7, 0xce, 0x98, 0xde, 0x0e, 0x20, 0x6b,
+		0x3f, 0xd8, 0x56, 0xed, 0xef, 0x29, 0xdc, 0x4e, 0x4f, 0x72, 0xb9, 0x12, 0x6c, 0x2d, 0xc9, 0xd6,
+		0x12, 0x2d, 0x21, 0xd9, 0x7a, 0x12, 0xae, 0x29, 0xe9, 0xfa, 0xf4, 0x4e, 0x50, 0x8a, 0xc9, 0x70,
+		0x59, 0x6a, 0xb8, 0x3c, 0xd5, 0x1f, 0x20, 0x1d, 0x72, 0xa5, 0xb5, 0xe7, 0xb0, 0x36, 0x28, 0x9a,
+		0x0e, 0x64, 0x40, 0x13, 0xd0, 0x74, 0x08, 0x4d, 0x03, 0x29, 0x24, 0xc3, 0xed, 0x0c, 0xd3, 0x95,
+		0xda, 0xea, 0x75, 0xa0, 0xd5, 0x0f, 0x9b, 0xa8, 0x7e, 0x68, 0x3a, 0x64, 0xda, 0x2b, 0x47, 0x27,
+		0x83, 0x76, 0x04, 0x95, 0x7a, 0xf3, 0x85, 0xe7, 0xe3, 0x80, 0x51, 0x52, 0x6d, 0xd6, 0xf0, 0xa8,
+		0x32, 0x32, 0x60, 0x87, 0x0e, 0xa6, 0x98, 0x1a, 0x67, 0x71, 0xb4, 0x36, 0xf3, 0x3c, 0x7a, 0x49,
+		0x36, 0x8b, 0x25, 0x4b, 0x0d, 0x58, 0x95, 0x4a, 0x6c, 0x66, 0x48, 0xb1, 0x45, 0x8c, 0x18, 0x72,
+		0xc4, 0x10, 0x24, 0x89, 0x24, 0x33, 0x44, 0x19, 0x22, 0x8b, 0xcf, 0xf4, 0x04, 0xd1, 0x42, 0xcc,
+		0x45, 0x19, 0x73, 0x71, 0x66, 0x3e, 0xa0, 0x06, 0x83, 0x39, 0xad, 0xbe, 0xc0, 0xd6, 0x39, 0xbb,
+		0x8a, 0x37, 0x40, 0x59, 0x40, 0x59, 0x1c, 0x89, 0xb2, 0x60, 0x57, 0x87, 0xb3, 0xa8, 0x0a, 0x67,
+		0x59, 0x0d, 0xce, 0xa2, 0x26, 0x9e, 0x44, 0xf5, 0x37, 0xa1, 0x92, 0x63, 0xb6, 0xc5, 0xf4, 0x25,
+		0x57, 0x48, 0x4c, 0xf1, 0x13, 0x5b, 0x66, 0xba, 0x1a, 0xda, 0x76, 0xab, 0x75, 0xde, 0xaa, 0xd0,
+		0xf0, 0x96, 0x54, 0x3e, 0xad, 0x53, 0x05, 0xeb, 0x3b, 0x0c, 0xc2, 0x5e, 0x2f, 0x51, 0x69, 0x6a,
+		0x61, 0x82, 0xe7, 0x7d, 0xc0, 0x0e, 0x13, 0xc1, 0x0e, 0x1f, 0xb1, 0x1d, 0x66, 0xcb, 0xfa, 0xa2,
+		0xbc, 0x37, 0x2f, 0x19, 0x6d, 0xa7, 0xef, 0x5e, 0xba, 0x2d, 0x9e, 0x7f, 0xf9, 0xd7, 0x0b, 0x8b,
+		0x6f, 0xb7, 0x71, 0x5c, 0x5b, 0x87, 0x3b, 0x6f, 0xbb, 0xfc, 0x93, 0x93, 0x71, 0x14, 0xf2, 0x42,
+		0x70, 0xf2, 0x4b, 0x73, 0x1e, 0xc0, 0xfc, 0x72, 0x76, 0xd3, 0x08, 0x2e, 0x66, 0x3f, 0xb7, 0x6e,
+		0x1a, 0x41, 0xab, 0x93, 0x47, 0x33, 0xd7, 0x9e, 0xcf, 0x5f, 0xcd, 0x1b, 0xfa, 0x65, 0xd7, 0xf3,
+		0xac, 0xef, 0x51, 0x54, 0xda, 0x47, 0x28, 0x2a, 0x57, 0x2f, 0xf9, 0x84, 0x86, 0xc1, 0xfd, 0x75,
+		0xf0, 0x53, 0xe7, 0xb9, 0x51, 0xbf, 0x78, 0xad, 0x5d, 0xd5, 0x4e, 0x56, 0x7f, 0x77, 0x55, 0x7b,
+		0x6e, 0xd4, 0x5b, 0xaf, 0x27, 0x27, 0x1b, 0xfe, 0xe5, 0xd3, 0xa6, 0x3e, 0x6a, 0x2f, 0x27, 0x27,
+		0x27, 0x53, 0x21, 0x59, 0x12, 0x9c, 0x9b, 0x46, 0x73, 0x29, 0x56, 0xfe, 0x4d, 0xf4, 0xb4, 0x6e,
+		0xae, 0x95, 0x2f, 0x70, 0x07, 0xc9, 0x80, 0x12, 0x95, 0x0e, 0xfa, 0x5f, 0x2d, 0xbc, 0x9e, 0xb3,
+		0x0e, 0xf8, 0x1e, 0xcf, 0xdc, 0xf4, 0x82, 0x3a, 0x11, 0x81, 0x3a, 0x1d, 0x33, 0x75, 0x2a, 0x2e,
+		0x6d, 0xc3, 0x2c, 0x79, 0x23, 0xa0, 0x2f, 0x44, 0x37, 0x5f, 0x34, 0xca, 0xca, 0xf2, 0x92, 0x27,
+		0x36, 0xf9, 0x49, 0xb4, 0xf3, 0x23, 0xac, 0x92, 0x2b, 0x78, 0xc9, 0x16, 0xdc, 0x01, 0x14, 0xaf,
+		0x1e, 0x5d, 0x9c, 0x9c, 0xb1, 0x12, 0x31, 0x7c, 0x3a, 0xfe, 0xe3, 0xd4, 0x6c, 0x57, 0x96, 0xf8,
+		0x09, 0x1c, 0xbf, 0xa9, 0x6f, 0xd9, 0x7f, 0x0d, 0x86, 0xff, 0x7b, 0xfc, 0xf0, 0xdb, 0xf1, 0x1f,
+		0xb7, 0xbf, 0x4d, 0x1f, 0x5e, 0x89, 0xfa, 0xd2, 0xc5, 0xf9, 0x3f, 0x3c, 0x51, 0x66, 0x89, 0x30,
+		0x4b, 0x74, 0xcd, 0x44, 0xb6, 0xb4, 0x7a, 0xdb, 0x4c, 0xd1, 0xe4, 0x17, 0xe0, 0x66, 0x88, 0x62,
+		0x39, 0xd5, 0xb8, 0x4b, 0x48, 0xc1, 0xd2, 0xcb, 0x0b, 0x90, 0x1a, 0x3c, 0x9b, 0x64, 0x2c, 0x8d,
+		0xfc, 0x54, 0xfd, 0x1c, 0x43, 0xed, 0xc4, 0xac, 0x33, 0x24, 0x66, 0x55, 0x24, 0x31, 0xab, 0x70,
+		0x4e, 0xa9, 0xcc, 0x82, 0x53, 0xc3, 0x64, 0x90, 0x0d, 0xba, 0x83, 0xbe, 0x46, 0xea, 0xcd, 0xfc,
+		0x56, 0x54, 0x70, 0xa9, 0xb4, 0x2c, 0x16, 0x26, 0xdf, 0xdc, 0x3d, 0x18, 0xa4, 0xde, 0xe4, 0x37,
+		0x1f, 0x54, 0xe2, 0x4d, 0xf1, 0x0b, 0xd3, 0x21, 0x27, 0xde, 0xe4, 0x9f, 0x87, 0xc4, 0x9b, 0xa3,
+		0x4c, 0xbc, 0xd1, 0x93, 0x5c, 0xae, 0x04, 0x5b, 0x4b, 0xb2, 0xb5, 0x44, 0x4b, 0x48, 0xb6, 0x9e,
+		0x84, 0x6b, 0x4a, 0xba, 0xbe, 0xf9, 0x16, 0x94, 0x62, 0x3a, 0xa4, 0xc4, 0x9b, 0x70, 0x94, 0x0d,
+		0xe2, 0xc1, 0xd3, 0x60, 0x94, 0x06, 0xe9, 0xf7, 0x34, 0x53, 0x4f, 0x0c, 0x9c, 0xaf, 0x75, 0x01,
+		0xd0, 0x02, 0xb4, 0xfb, 0x05, 0x6d, 0x1a, 0xc4, 0xa3, 0xa7, 0x3b, 0x95, 0x30, 0x20, 0xfb, 0xa1,
+		0xee, 0x39, 0x0e, 0xb3, 0x42, 0x56, 0x8e, 0x69, 0x1f, 0x87, 0x9a, 0x95, 0x73, 0x71, 0x76, 0x79,
+		0x71, 0xd9, 0xfe, 0x70, 0x76, 0x89, 0xe4, 0x1c, 0x9b, 0xb9, 0xf5, 0xd5, 0xb7, 0xe1, 0x20, 0xc9,
+		0x82, 0xe1, 0xa0, 0x1f, 0x75, 0xbf, 0x9b, 0x5b, 0xa8, 0xe5, 0xe6, 0xb0, 0x4e, 0xb0, 0x4e, 0x7b,
+		0xb5, 0x4e, 0x48, 0x18, 0x25, 0x82, 0x69, 0x42, 0xc2, 0xe8, 0x61, 0xdb, 0x24, 0xbd, 0x0a, 0x37,
+		0x6b, 0xe0, 0xd7, 0xdd, 0x03, 0xa2, 0xc3, 0x49, 0x16, 0x85, 0x0d, 0x42, 0xb2, 0x28, 0x91, 0x53,
+		0x07, 0x9f, 0xa5, 0xf6, 0xab, 0x4a, 0xf0, 0x94, 0x19, 0x52, 0x6c, 0x11, 0x23, 0x86, 0x1c, 0x31,
+		0x04, 0x49, 0x22, 0xc9, 0x0c, 0x51, 0x86, 0xc8, 0xe2, 0xb3, 0x3c, 0x41, 0xb4, 0xd0, 0x31, 0x25,
+		0x8b, 0xea, 0x9c, 0x7c, 0x53, 0x38, 0x96, 0x7a, 0x87, 0xa7, 0x40, 0x71, 0x40, 0x71, 0x1c, 0x81,
+		0xe2, 0xe0, 0x0b, 0x3b, 0x59, 0x86, 0xe0, 0x73, 0x57, 0x8f, 0xb3, 0xeb, 0xc8, 0xf2, 0x47, 0x9b,
+		0xc8, 0x1f, 0x75, 0x35, 0xb4, 0xec, 0x55, 0xa8, 0x93, 0xc1, 0x7d, 0x47, 0xb9, 0x13, 0x3c, 0x8f,
+		0xea, 0x9a, 0x8e, 0xe2, 0x78, 0x56, 0x89, 0x60, 0x92, 0x61, 0x92, 0x05, 0xb0, 0x54, 0xbe, 0x49,
+		0x36, 0xf4, 0xdc, 0x12, 0xc1, 0x16, 0xc3, 0x16, 0x13, 0xc1, 0x16, 0x93, 0x5b, 0x4d, 0x70, 0xd8,
+		0xb6, 0x78, 0xec, 0x12, 0x0e, 0x8c, 0x4a, 0xe0, 0xae, 0x69, 0xa6, 0x85, 0x3e, 0x60, 0x85, 0x89,
+		0x60, 0x85, 0x61, 0x85, 0x89, 0x60, 0x85, 0xed, 0x40, 0xbe, 0xd5, 0x54, 0xc0, 0x0a, 0x13, 0xc1,
+		0x0a, 0x1f, 0x97, 0x15, 0x8e, 0x9e, 0x44, 0x56, 0xc4, 0xcb, 0xdd, 0xc0, 0x16, 0x13, 0xc1, 0x16,
+		0xc3, 0x16, 0x13, 0xc1, 0x16, 0xdb, 0x81, 0x7c, 0xab, 0xc1, 0x80, 0x2d, 0x26, 0x82, 0x2d, 0x3e,
+		0x32, 0x5b, 0x9c, 0x57, 0x2c, 0x1b, 0xc5, 0x51, 0x37, 0x4c, 0x33, 0x9b, 0xea, 0x86, 0x0b, 0xbd,
+		0xf0, 0x2c, 0x71, 0x13, 0x96, 0x98, 0x08, 0x96, 0x98, 0x9c, 0x5b, 0x62, 0xd3, 0x48, 0xae, 0xd9,
+		0xe5, 0xe7, 0xfa, 0xe5, 0xab, 0x0a, 0x92, 0xc1, 0x28, 0x53, 0x29, 0x7f, 0xea, 0xde, 0xa2, 0x55,
+		0x96, 0xba, 0xab, 0xef, 0x25, 0x44, 0x55, 0xf7, 0x70, 0x6d, 0x17, 0x60, 0x12, 0x07, 0x95, 0x14,
+		0xb8, 0xc4, 0x41, 0x26, 0x0e, 0x36, 0x17, 0xa0, 0xe3, 0x81, 0x8f, 0x09, 0x42, 0x7b, 0x5a, 0xbc,
+		0xb1, 0xf8, 0xf7, 0xf9, 0x99, 0x40, 0x25, 0xc5, 0x0f, 0x16, 0x5d, 0xd8, 0x15, 0x03, 0x9f, 0x5d,
+		0x76, 0x62, 0x4b, 0x52, 0xf4, 0x59, 0x48, 0xc9, 0x6c, 0xe5, 0x7c, 0x52, 0xfd, 0x09, 0x32, 0x3f,
+		0x4b, 0x71, 0x16, 0xa7, 0xd9, 0xae, 0xa7, 0xc0, 0x36, 0x6b, 0xae, 0x94, 0xb9, 0xf0, 0xf6, 0xd3,
+		0xba, 0xac, 0xfa, 0x9e, 0x0c, 0x59, 0xb3, 0x0a, 0x2e, 0x5f, 0xd3, 0x9c, 0x76, 0x61, 0xb3, 0x24,
+		0x49, 0x43, 0x1a, 0xa0, 0x21, 0x44, 0xa0, 0x21, 0x07, 0x4c, 0x43, 0xec, 0xc1, 0x44, 0x96, 0xb1,
+		0xe8, 0x96, 0x31, 0xe9, 0xe5, 0x2a, 0xb2, 0x44, 0x75, 0x55, 0xf4, 0x55, 0xf5, 0xc4, 0x56, 0x55,
+		0xab, 0x1d, 0x62, 0x5d, 0x05, 0x85, 0x06, 0x85, 0x46, 0x84, 0x75, 0x15, 0xd6, 0x55, 0x15, 0xe0,
+		0xf2, 0x58, 0x57, 0x55, 0x67, 0x2e, 0xb0, 0xae, 0x5a, 0xbd, 0xfc, 0x54, 0xc5, 0x99, 0x18, 0x15,
+		0x59, 0xec, 0x0c, 0x34, 0x04, 0x34, 0x84, 0x08, 0x34, 0x84, 0x08, 0x34, 0x04, 0x34, 0x64, 0xef,
+		0xa6, 0x0f, 0x34, 0xa4, 0x3a, 0x73, 0x71, 0xec, 0x34, 0xc4, 0xe9, 0x66, 0x36, 0xf3, 0x14, 0x10,
+		0x89, 0x73, 0x02, 0xde, 0x8a, 0x98, 0x9f, 0xde, 0x3d, 0x0c, 0xa7, 0x67, 0x81, 0x58, 0xc4, 0x81,
+		0x10, 0xff, 0x40, 0x81, 0xdf, 0x67, 0x6f, 0x72, 0xfb, 0xe3, 0xc3, 0x70, 0x7a, 0x30, 0xc8, 0xcf,
+		0xc3, 0xaf, 0x17, 0xff, 0x67, 0xfa, 0x22, 0xd5, 0x08, 0xb3, 0x69, 0x8b, 0x84, 0xd9, 0xb4, 0x11,
+		0x66, 0x53, 0x1e, 0x55, 0x44, 0x98, 0x0d, 0x11, 0xc2, 0x6c, 0xb0, 0x0e, 0x73, 0x02, 0x2e, 0x71,
+		0x90, 0x89, 0x83, 0xcd, 0x05, 0xe8, 0x78, 0xe0, 0x63, 0x82, 0x90, 0x08, 0xeb, 0xb0, 0xed, 0x17,
+		0xd6, 0x61, 0x7b, 0xe7, 0xfe, 0x58, 0x87, 0x55, 0x67, 0x2e, 0xe0, 0x0e, 0x5e, 0xbd, 0x10, 0x66,
+		0x43, 0x04, 0x1a, 0x42, 0x04, 0x1a, 0x62, 0x8f, 0x25, 0x42, 0x98, 0xcd, 0x1e, 0x15, 0x19, 0xc2,
+		0x6c, 0xa0, 0xd0, 0xa0, 0xd0, 0x88, 0xb0, 0xae, 0x22, 0xc2, 0xba, 0x4a, 0x86, 0xd4, 0x63, 0x5d,
+		0x45, 0x84, 0x75, 0x95, 0x23, 0x85, 0x74, 0xdc, 0xeb, 0x2a, 0x84, 0xd9, 0x10, 0x81, 0x86, 0x10,
+		0x81, 0x86, 0x58, 0x63, 0x89, 0x40, 0x43, 0xb6, 0x5c, 0xa0, 0x21, 0x7b, 0x37, 0x7d, 0xa0, 0x21,
+		0xd5, 0x99, 0x0b, 0x84, 0xd9, 0x1c, 0x55, 0x98, 0x4d, 0xbb, 0x32, 0x61, 0x36, 0xed, 0x0a, 0x85,
+		0xd9, 0x0c, 0x95, 0x4a, 0x82, 0x30, 0xe5, 0x47, 0xd8, 0xcc, 0x3a, 0x40, 0x35, 0x39, 0xe7, 0x04,
+		0x11, 0xc1, 0x35, 0x7c, 0x7d, 0x24, 0x70, 0x56, 0x12, 0xe3, 0xdc, 0x66, 0x01, 0x8e, 0x67, 0xc9,
+		0xed, 0x50, 0x50, 0xce, 0x15, 0x4f, 0x38, 0xb6, 0x82, 0x72, 0x52, 0x9c, 0x0c, 0x75, 0xe5, 0x4c,
+		0xef, 0x7c, 0xd5, 0x3d, 0x71, 0x53, 0x7d, 0x67, 0x95, 0x53, 0xf7, 0x7f, 0x89, 0xd2, 0xec, 0x3a,
+		0xcb, 0x0c, 0xcf, 0x63, 0xfc, 0x35, 0x8a, 0xbf, 0xf4, 0x55, 0xae, 0xdd, 0xf3, 0x49, 0x8c, 0x47,
+		0xfd, 0xbe, 0x01, 0xaf, 0xf8, 0x35, 0xfc, 0xc6, 0x6f, 0xfc, 0xf7, 0xa4, 0xa7, 0x12, 0xd5, 0xfb,
+		0xf1, 0xfb, 0xb4, 0xa9, 0xe8, 0x28, 0x32, 0x99, 0xa9, 0x30, 0x23, 0x35, 0x30, 0x22, 0x72, 0xe4,
+		0xd3, 0x2f, 0xf1, 0x08, 0x5a, 0x5e, 0xc9, 0x62, 0xab, 0x52, 0xc5, 0x38, 0x16, 0x5d, 0x92, 0x2c,
+		0xe2, 0x58, 0x74, 0x1c, 0x8b, 0x6e, 0x0f, 0xb2, 0xad, 0x74, 0x03, 0xc7, 0xa2, 0x97, 0x57, 0xf2,
+		0x17, 0xc7, 0xa2, 0x13, 0xb1, 0x4b, 0xf7, 0xda, 0x94, 0xec, 0xc5, 0x21, 0xe9, 0x44, 0xb0, 0x48,
+		0x82, 0x39, 0x3f, 0x96, 0xb9, 0x3e, 0x22, 0x39, 0x3e, 0xd6, 0xbe, 0xbb, 0x33, 0xf8, 0xee, 0xe0,
+		0xbb, 0xd3, 0xbf, 0x04, 0x7c, 0x77, 0xec, 0x4d, 0x5a, 0x38, 0xee, 0x84, 0xbc, 0x4b, 0x0d, 0x38,
+		0xee, 0x88, 0xe0, 0xb8, 0x93, 0x6e, 0x55, 0x85, 0x03, 0x21, 0x6c, 0x72, 0x5e, 0x04, 0xc2, 0xf3,
+		0xb1, 0x95, 0x46, 0x04, 0x73, 0x6c, 0x81, 0xa3, 0x3d, 0x6c, 0xa5, 0xb1, 0x85, 0x9d, 0x2c, 0x73,
+		0x50, 0x98, 0xb9, 0x27, 0x6e, 0x14, 0x87, 0x6d, 0x8e, 0x89, 0x50, 0x6e, 0x09, 0xf8, 0x3c, 0x14,
+		0x08, 0x11, 0xf8, 0x3c, 0x11, 0xf8, 0xbc, 0x36, 0xe9, 0x04, 0x9f, 0x27, 0x02, 0x9f, 0x3f, 0x4a,
+		0x3e, 0x6f, 0x93, 0x6b, 0x21, 0x90, 0x63, 0x01, 0x73, 0x4c, 0x04, 0x73, 0x6c, 0x81, 0x23, 0x98,
+		0x63, 0x8d, 0x0b, 0xe6, 0xd8, 0x99, 0xa9, 0x80, 0x39, 0x76, 0x3f, 0xc6, 0x88, 0x8b, 0xab, 0x52,
+		0x44, 0x17, 0xb3, 0x88, 0xa7, 0x48, 0x60, 0x97, 0x71, 0xd9, 0x4e, 0xb1, 0xad, 0xf4, 0xb6, 0xd5,
+		0x56, 0x7a, 0x1b, 0x5b, 0xe9, 0xd8, 0x4a, 0x27, 0xc2, 0x56, 0x3a, 0xb8, 0xbe, 0xe1, 0x05, 0xae,
+		0x6f, 0x0c, 0x0e, 0x22, 0x70, 0x7d, 0x22, 0x70, 0x7d, 0x02, 0xd7, 0x27, 0x02, 0xd7, 0x2f, 0x97,
+		0xeb, 0x63, 0x2b, 0x9d, 0x08, 0xe6, 0x98, 0x08, 0xe6, 0xd8, 0x81, 0x39, 0xc6, 0x56, 0x3a, 0x61,
+		0x2b, 0x1d, 0x0a, 0x04, 0x0a, 0x84, 0x08, 0x7c, 0x9e, 0x08, 0x7c, 0x1e, 0x7c, 0x1e, 0x7c, 0x9e,
+		0x08, 0x5b, 0xe9, 0x04, 0x73, 0x0c, 0x73, 0x6c, 0x8b, 0x23, 0x98, 0x63, 0x8d, 0x0b, 0xe6, 0xd8,
+		0x99, 0xa9, 0x80, 0x39, 0x76, 0x3f, 0xc6, 0xd8, 0x4a, 0xaf, 0xd8, 0x56, 0x7a, 0x7b, 0x8f, 0x5b,
+		0xe9, 0xed, 0x3d, 0x6c, 0xa5, 0xc7, 0x2a, 0x7a, 0x78, 0xbc, 0x1b, 0x24, 0xe6, 0xdb, 0xe8, 0x6f,
+		0x2d, 0xb1, 0x85, 0x8e, 0x2d, 0xf4, 0xbd, 0x6c, 0xa1, 0xef, 0xd9, 0x5f, 0xff, 0x59, 0xdd, 0x87,
+		0xa3, 0xfe, 0x78, 0x00, 0x55, 0x1c, 0xde, 0xf5, 0xe1, 0xf0, 0x77, 0x88, 0x18, 0x31, 0xe4, 0x88,
+		0x21, 0x48, 0x12, 0x49, 0x66, 0x88, 0x32, 0x44, 0x96, 0xe0, 0x02, 0x01, 0x0e, 0x7f, 0x22, 0x22,
+		0xbf, 0xa7, 0xd2, 0x6e, 0x12, 0x0d, 0x59, 0x05, 0x86, 0xdf, 0xc6, 0x72, 0xb1, 0x13, 0x28, 0x0e,
+		0x28, 0x8e, 0xa3, 0x56, 0x1c, 0x7c, 0x61, 0x27, 0x66, 0x45, 0x33, 0xdb, 0xca, 0x66, 0xb3, 0x0b,
+		0x35, 0x6c, 0x9d, 0x2d, 0x7e, 0x8f, 0xcc, 0xc1, 0xc0, 0xae, 0x90, 0xe6, 0x64, 0x70, 0xdf, 0x91,
+		0xa3, 0x5f, 0x7d, 0xe3, 0x54, 0xfb, 0x5c, 0xd3, 0x51, 0xcb, 0xdd, 0xc0, 0x24, 0x13, 0xc1, 0x24,
+		0x1f, 0xb1, 0x49, 0x36, 0xac, 0x2a, 0x4a, 0x04, 0x5b, 0x0c, 0x5b, 0x4c, 0x04, 0x5b, 0x4c, 0x6e,
+		0x35, 0xc1, 0x61, 0xdb, 0x62, 0x5e, 0xe5, 0xed, 0x35, 0xe5, 0xc4, 0xa9, 0xc0, 0x4d, 0x04, 0x5b,
+		0x0c, 0x5b, 0x2c, 0x80, 0x25, 0xd8, 0x62, 0x8d, 0x0b, 0xb6, 0xd8, 0x99, 0xb9, 0x80, 0x2d, 0x76,
+		0x38, 0xb8, 0xef, 0xc9, 0x16, 0x73, 0x2a, 0x8e, 0xaf, 0xe9, 0x26, 0x66, 0x7e, 0x39, 0x99, 0xef,
+		0xf9, 0x12, 0xc1, 0x12, 0xc3, 0x12, 0x5b, 0xa8, 0x42, 0xe3, 0x3d, 0xe4, 0xd9, 0x65, 0x99, 0x8e,
+		0xbd, 0x26, 0x43, 0x36, 0x69, 0xd9, 0xab, 0xe0, 0xc1, 0x31, 0xd7, 0x82, 0xe0, 0x12, 0x07, 0x99,
+		0x38, 0xd8, 0x5c, 0x80, 0x8e, 0x07, 0x3e, 0x26, 0x08, 0xed, 0x69, 0xb1, 0x5c, 0x5c, 0xaa, 0x40,
+		0x7c, 0xaa, 0x50, 0x9c, 0xea, 0xec, 0xc2, 0x31, 0xd7, 0xfb, 0x62, 0x7e, 0x96, 0xe2, 0x2c, 0x4e,
+		0xb3, 0x5d, 0x4f, 0x01, 0x8e, 0xb9, 0x96, 0x62, 0xe7, 0xfc, 0xe7, 0x31, 0x64, 0xcd, 0x2a, 0xac,
+		0x6d, 0x4d, 0x73, 0xda, 0x05, 0xec, 0x90, 0x24, 0x0d, 0x69, 0x80, 0x86, 0x10, 0x81, 0x86, 0x1c,
+		0x30, 0x0d, 0xb1, 0x07, 0x13, 0x59, 0x46, 0xc1, 0x59, 0x46, 0xc3, 0x95, 0xab, 0xc8, 0x6c, 0xd3,
+		0xe2, 0xd7, 0xc6, 0xdf, 0x2e, 0x3d, 0x9e, 0x08, 0xeb, 0x2a, 0x28, 0x34, 0x28, 0x34, 0xac, 0xab,
+		0x88, 0xb0, 0xae, 0xc2, 0xba, 0x8a, 0x08, 0xeb, 0xaa, 0xf7, 0xb4, 0xae, 0xb2, 0x29, 0x07, 0xb0,
+		0xa6, 0x39, 0xf9, 0x65, 0x01, 0x88, 0x40, 0x43, 0x88, 0x40, 0x43, 0x40, 0x43, 0x40, 0x43, 0x88,
+		0x40, 0x43, 0x40, 0x43, 0x88, 0x40, 0x43, 0x0e, 0x94, 0x86, 0x38, 0xdd, 0xcc, 0x66, 0x96, 0x43,
+		0x90, 0x2f, 0x8b, 0x30, 0xcb, 0xf4, 0x3f, 0xb5, 0x08, 0x05, 0x21, 0xa9, 0x3a, 0x09, 0xbf, 0x4d,
+		0x5f, 0xc6, 0xfc, 0xec, 0x01, 0xf3, 0x59, 0x33, 0x0c, 0xb6, 0x69, 0x8b, 0x04, 0xdb, 0xb4, 0x11,
+		0x6c, 0x53, 0x1e, 0x61, 0x44, 0xb0, 0x0d, 0x11, 0x82, 0x6d, 0xb0, 0x1a, 0x73, 0x02, 0x2e, 0x71,
+		0x90, 0x89, 0x83, 0xcd, 0x05, 0xe8, 0x78, 0xe0, 0x63, 0x82, 0x90, 0x08, 0xab, 0xb1, 0xed, 0x17,
+		0x56, 0x63, 0x7b, 0x5f, 0x01, 0x60, 0x35, 0x56, 0x9d, 0xb9, 0x80, 0x53, 0x78, 0xf5, 0x42, 0xb0,
+		0x0d, 0x11, 0x68, 0x08, 0x11, 0x68, 0x88, 0x3d, 0x96, 0x08, 0xc1, 0x36, 0x7b, 0x54, 0x64, 0x08,
+		0xb6, 0x81, 0x42, 0x83, 0x42, 0x23, 0xc2, 0xba, 0x8a, 0x08, 0xeb, 0x2a, 0x19, 0x52, 0x8f, 0x75,
+		0x15, 0x11, 0xd6, 0x55, 0x8e, 0x14, 0xd2, 0x71, 0xaf, 0xab, 0x10, 0x6c, 0x43, 0x04, 0x1a, 0x42,
+		0x04, 0x1a, 0x62, 0x8d, 0x25, 0x02, 0x0d, 0xd9, 0x72, 0x81, 0x86, 0xec, 0xdd, 0xf4, 0x81, 0x86,
+		0x54, 0x67, 0x2e, 0x10, 0x6c, 0x73, 0x6c, 0xc1, 0x36, 0xed, 0x2a, 0x05, 0xdb, 0xb4, 0x2b, 0x14,
+		0x6c, 0xd3, 0x0f, 0xd3, 0x2c, 0x50, 0x69, 0x16, 0xde, 0xf5, 0xa3, 0xf4, 0x51, 0xf5, 0xf8, 0x01,
+		0x37, 0x6b, 0x3d, 0xe1, 0x90, 0x37, 0xe7, 0xc4, 0x11, 0x41, 0x37, 0x7c, 0x3d, 0x25, 0x50, 0x8a,
+		0x3d, 0xcc, 0x54, 0x10, 0xc6, 0xbd, 0x20, 0x8b, 0x9e, 0x54, 0xd0, 0x53, 0xfd, 0x2c, 0xb4, 0x2a,
+		0x3d, 0x57, 0x15, 0x75, 0x60, 0x79, 0x10, 0xcc, 0x42, 0x1f, 0x50, 0x01, 0x50, 0x01, 0x47, 0xad,
+		0x02, 0xd2, 0x2c, 0x89, 0xe2, 0x87, 0x43, 0x47, 0xfd, 0x50, 0xa9, 0x24, 0x08, 0x7b, 0xbd, 0x44,
+		0xa5, 0x16, 0x07, 0xbc, 0x2e, 0xf5, 0x82, 0x42, 0xb3, 0x40, 0xfe, 0x51, 0x23, 0x3f, 0x1a, 0x32,
+		0x65, 0x7d, 0x09, 0xfd, 0x97, 0x8c, 0xb6, 0xd3, 0x77, 0x2f, 0xbd, 0xd8, 0xec, 0x52, 0x19, 0x4b,
+		0xfe, 0xb7, 0xaf, 0x6b, 0x40, 0x8b, 0x3e, 0x7e, 0x0f, 0xb3, 0x4c, 0x25, 0xb1, 0xb5, 0x03, 0xcc,
+		0x3f, 0x39, 0xb9, 0x69, 0x04, 0x97, 0x9d, 0x97, 0x9b, 0x66, 0x70, 0xd9, 0x99, 0xfc, 0xd8, 0x1c,
+		0xff, 0x31, 0xf9, 0xf9, 0xec, 0xa6, 0x11, 0x5c, 0xcc, 0x7e, 0x6e, 0xdd, 0x34, 0x82, 0x56, 0xa7,
+		0xf6, 0xe7, 0x9f, 0x3f, 0xd4, 0x9e, 0xcf, 0x5f, 0xcd, 0x1b, 0xfa, 0x65, 0x7b, 0x08, 0xea, 0x7b,
+		0x14, 0x95, 0xf6, 0x11, 0x8a, 0xca, 0xd5, 0x4b, 0x3e, 0xa1, 0x61, 0x70, 0x7f, 0x1d, 0xfc, 0xd4,
+		0x79, 0x6e, 0xd4, 0x2f, 0x5e, 0x6b, 0x57, 0xb5, 0x93, 0xd5, 0xdf, 0x5d, 0xd5, 0x9e, 0x1b, 0xf5,
+		0xd6, 0xeb, 0xc9, 0xc9, 0x86, 0x7f, 0xf9, 0xb4, 0xa9, 0x8f, 0xda, 0xcb, 0xc9, 0xc9, 0xc9, 0x54,
+		0x48, 0x96, 0x04, 0xe7, 0xa6, 0xd1, 0xec, 0x7c, 0x1a, 0xff, 0x38, 0xf9, 0xff, 0x9b, 0xe8, 0x69,
+		0xdd, 0x5c, 0x2b, 0x5f, 0xe0, 0x0e, 0xb2, 0xc8, 0xef, 0x84, 0xbf, 0x58, 0x13, 0x20, 0x70, 0x1f,
+		0x22, 0x70, 0x9f, 0xe3, 0x3e, 0xbc, 0x32, 0x0d, 0xe2, 0xd1, 0xd3, 0x9d, 0x4a, 0x70, 0xc0, 0xbd,
+		0x73, 0x9c, 0x6f, 0xdd, 0x49, 0x41, 0x9d, 0x7d, 0x67, 0x43, 0x8b, 0x03, 0xee, 0xe5, 0xfb, 0x37,
+		0xb6, 0xc4, 0x0f, 0xc9, 0x60, 0x34, 0xb4, 0x34, 0xc6, 0x93, 0x3e, 0x60, 0x8f, 0x89, 0x60, 0x8f,
+		0x8f, 0xd8, 0x1e, 0xf7, 0x55, 0x78, 0x9f, 0xa8, 0x7b, 0x1b, 0x47, 0x04, 0xc7, 0x1c, 0xff, 0x3e,
+		0xdd, 0xa3, 0xfd, 0xe1, 0x87, 0xd3, 0x1f, 0x7e, 0x38, 0x1d, 0x63, 0x6d, 0xf2, 0xff, 0x60, 0xbc,
+		0x57, 0x5a, 0x01, 0x45, 0x92, 0xaa, 0x34, 0x8d, 0x06, 0xd6, 0x47, 0xdb, 0x2f, 0x77, 0x83, 0x4d,
+		0x0d, 0xa8, 0x93, 0xa3, 0x56, 0x27, 0x2a, 0x1e, 0x3d, 0xa9, 0x24, 0xb4, 0x3d, 0x62, 0xba, 0x92,
+		0x67, 0xd3, 0x7b, 0x82, 0x43, 0x9e, 0x4b, 0x29, 0x73, 0xe3, 0xc3, 0xff, 0x25, 0x4a, 0xb3, 0xeb,
+		0x2c, 0x33, 0xab, 0xfb, 0x90, 0xaf, 0x43, 0xbe, 0xf4, 0x55, 0x2e, 0x86, 0xa9, 0x7f, 0x45, 0xf1,
+		0xa8, 0xdf, 0x37, 0x50, 0x86, 0xbf, 0x86, 0xdf, 0xf8, 0x8d, 0xff, 0x9e, 0xf4, 0x54, 0xa2, 0x7a,
+		0x3f, 0x7e, 0x9f, 0x36, 0x15, 0x1d, 0x47, 0x66, 0xc8, 0x90, 0x7c, 0xa8, 0x90, 0x5f, 0xf7, 0xf6,
+		0x12, 0x15, 0xe4, 0x7b, 0x32, 0xc2, 0xab, 0x31, 0xe0, 0xfe, 0x60, 0xa8, 0x12, 0x43, 0x83, 0xf8,
+		0xa6, 0x19, 0x16, 0xda, 0xd6, 0x3d, 0x13, 0x65, 0xa0, 0x69, 0xfd, 0x8c, 0xad, 0x1e, 0xc7, 0xda,
+		0x59, 0x5b, 0x39, 0xae, 0x75, 0xb3, 0xb6, 0x6a, 0xd6, 0xd6, 0x4c, 0xc2, 0x8a, 0xc9, 0xaa, 0x50,
+		0x63, 0x6b, 0xc5, 0x97, 0x45, 0x62, 0x1a, 0x27, 0x43, 0xa3, 0x24, 0x83, 0xd2, 0x71, 0x92, 0x46,
+		0x12, 0x44, 0x3d, 0x73, 0x90, 0xce, 0x9b, 0x3a, 0xc6, 0x68, 0x03, 0x18, 0x05, 0x46, 0x5d, 0x6c,
+		0x15, 0x73, 0xf6, 0xfb, 0xd8, 0xfb, 0x7b, 0x15, 0xdd, 0xfa, 0xed, 0x48, 0x29, 0x1b, 0xcf, 0x42,
+		0x04, 0x4c, 0x99, 0x99, 0x18, 0x23, 0xd3, 0x10, 0x16, 0x09, 0x02, 0xe6, 0x7b, 0xbc, 0xa1, 0x7b,
+		0xf5, 0x0c, 0x06, 0xd3, 0xbf, 0x1e, 0x3d, 0xe4, 0x78, 0x56, 0xbd, 0x9d, 0xb2, 0xf9, 0xec, 0x69,
+		0x41, 0x6a, 0x79, 0x4c, 0xf3, 0xd1, 0xbb, 0x5a, 0x1b, 0xca, 0xf5, 0x5b, 0xde, 0x06, 0xb7, 0x60,
+		0x60, 0x35, 0x4b, 0x1f, 0x6a, 0xdb, 0x00, 0x13, 0xdd, 0xcf, 0xd6, 0xf9, 0xa6, 0xba, 0x9e, 0xad,
+		0xe3, 0xd9, 0xba, 0xdd, 0x46, 0xa7, 0xdb, 0x01, 0x58, 0xb7, 0xb4, 0xa0, 0x9f, 0xbf, 0x8b, 0x31,
+		0xd5, 0xd0, 0xb7, 0xc9, 0x86, 0x35, 0x35, 0x41, 0x32, 0xdc, 0x08, 0xa2, 0x84, 0x40, 0xea, 0xdb,
+		0x1e, 0x11, 0x0b, 0x65, 0xa8, 0x84, 0x3b, 0x75, 0xcf, 0xc6, 0x92, 0x89, 0x58, 0xb0, 0x1d, 0xb3,
+		0x69, 0x6b, 0xb5, 0x7c, 0x4f, 0x6f, 0x28, 0x36, 0xe8, 0x85, 0xc9, 0xc2, 0x20, 0xc8, 0x13, 0x74,
+		0xb6, 0x8b, 0xec, 0xf2, 0x2a, 0x62, 0x7a, 0x73, 0xdd, 0x63, 0x40, 0xba, 0x70, 0x2d, 0xaf, 0x03,
+		0xd9, 0x0d, 0x10, 0x8d, 0x86, 0xc1, 0xc2, 0xbb, 0xed, 0x1c, 0x6b, 0x4d, 0x78, 0x1a, 0xc3, 0xd1,
+		0x18, 0x7e, 0x5b, 0xe0, 0xb6, 0xfa, 0x29, 0x42, 0x7c, 0xa3, 0x48, 0xe7, 0x9b, 0x1d, 0x67, 0xce,
+		0x39, 0xbe, 0xbc, 0x62, 0x34, 0x42, 0x5f, 0x64, 0xb8, 0x9a, 0xbd, 0x32, 0x94, 0x42, 0x5b, 0xa4,
+		0x34, 0xb5, 0xaf, 0x14, 0xbd, 0x18, 0xbf, 0x14, 0xd3, 0x97, 0x71, 0x5c, 0x14, 0xc3, 0x5c, 0x18,
+		0xb9, 0x42, 0x69, 0x2d, 0x9c, 0xd6, 0x42, 0x2a, 0x2d, 0xac, 0x7a, 0x42, 0xab, 0x29, 0xbc, 0xc6,
+		0x42, 0x3c, 0xbb, 0xa6, 0x75, 0xb2, 0xf9, 0xfb, 0xc9, 0xd3, 0xf6, 0xef, 0x2a, 0x2e, 0x85, 0x2f,
+		0xf6, 0xb6, 0xe2, 0x2f, 0x06, 0x03, 0x31, 0x38, 0xb8, 0x82, 0x85, 0x19, 0x3c, 0x0c, 0x61, 0xc2,
+		0x77, 0x07, 0xae, 0x49, 0xc5, 0xdd, 0x60, 0xd0, 0x57, 0xa1, 0xd5, 0xe6, 0x72, 0xb3, 0x0a, 0x07,
+		0x55, 0x58, 0x64, 0xcb, 0x47, 0x3d, 0xc0, 0x1f, 0xf0, 0x7f, 0x9f, 0xf0, 0xcf, 0x0b, 0x27, 0x35,
+		0xdb, 0x16, 0xe8, 0x6f, 0x23, 0x76, 0xdc, 0x2e, 0xc0, 0xb9, 0x81, 0xd8, 0x71, 0x57, 0x43, 0xdb,
+		0x6e, 0xb5, 0xce, 0x11, 0x36, 0x2e, 0xd6, 0xbf, 0xe1, 0xc1, 0x51, 0x17, 0xc1, 0x90, 0x67, 0xb6,
+		0x96, 0xbd, 0x1c, 0xbc, 0x55, 0x12, 0x2c, 0x34, 0x2c, 0xb4, 0x0b, 0x7c, 0xed, 0x23, 0xb9, 0x9d,
+		0x0b, 0x02, 0xb2, 0x4c, 0xd7, 0xb5, 0x4e, 0xd3, 0x2d, 0x75, 0x3b, 0xff, 0x74, 0xfa, 0xb0, 0xda,
+		0xcb, 0xc9, 0x4d, 0x33, 0x38, 0xeb, 0xcc, 0xfe, 0x72, 0x7e, 0xd3, 0x08, 0xce, 0x3a, 0x9c, 0xc4,
+		0xdb, 0x2a, 0xe8, 0xd1, 0x27, 0x95, 0x25, 0x51, 0x97, 0xaf, 0x42, 0xa7, 0xed, 0xa1, 0x3d, 0xa1,
+		0x3d, 0xdf, 0xed, 0xfa, 0x86, 0x55, 0x18, 0x16, 0xb9, 0xb1, 0x58, 0xdf, 0x54, 0x7d, 0x7d, 0x83,
+		0xdc, 0x58, 0xf9, 0xfe, 0x4d, 0x8c, 0x73, 0xac, 0xbe, 0x65, 0xc1, 0xe3, 0x60, 0x68, 0x9b, 0x1f,
+		0xbb, 0xd2, 0x0f, 0x8c, 0x35, 0x11, 0x8c, 0xf5, 0x3b, 0x35, 0xd6, 0xed, 0x0b, 0x0b, 0x63, 0xfd,
+		0x11, 0xc6, 0xda, 0xce, 0xa2, 0xc0, 0x58, 0x3b, 0x1b, 0xda, 0xe6, 0xc7, 0x8b, 0x8b, 0xf6, 0x87,
+		0x8b, 0x8b, 0xc6, 0x87, 0xf3, 0x0f, 0x8d, 0xcb, 0x56, 0xab, 0xd9, 0x6e, 0xc2, 0x6c, 0x8b, 0xf5,
+		0x6f, 0x54, 0xd2, 0x22, 0x51, 0xf7, 0x2a, 0x51, 0x71, 0xd7, 0x22, 0x6c, 0x60, 0xa1, 0x0f, 0x98,
+		0x6b, 0x22, 0x98, 0xeb, 0x77, 0x6a, 0xae, 0x3f, 0x5a, 0x58, 0xeb, 0x16, 0xac, 0xb5, 0x9d, 0x49,
+		0x81, 0xb5, 0x76, 0x36, 0xb4, 0x67, 0x2d, 0x18, 0x67, 0xb1, 0xfe, 0x4d, 0x8c, 0xf3, 0x44, 0x65,
+		0x0e, 0xfe, 0x1d, 0xab, 0x84, 0x6f, 0x9d, 0x17, 0x3b, 0x81, 0x79, 0x26, 0x82, 0x79, 0x7e, 0x87,
+		0xe6, 0xf9, 0x38, 0xea, 0xe1, 0x4f, 0xe7, 0x88, 0x33, 0x1a, 0xab, 0xf9, 0x33, 0x79, 0x1f, 0xd0,
+		0x06, 0xd0, 0x06, 0xef, 0x33, 0x8c, 0xa0, 0xa7, 0xe2, 0x2c, 0xca, 0xbe, 0x5b, 0xd6, 0xa6, 0xe3,
+		0x70, 0xf6, 0x9f, 0xa7, 0x8f, 0xfe, 0x31, 0x4c, 0x95, 0xfd, 0xb9, 0xa9, 0xf3, 0x99, 0x32, 0xc7,
+		0xf3, 0x32, 0xcd, 0x4b, 0xad, 0xea, 0x8f, 0x5b, 0xf2, 0xd5, 0xd9, 0xe7, 0xf4, 0x07, 0xdd, 0xb0,
+		0xef, 0xef, 0x83, 0x77, 0x0b, 0x7d, 0xc0, 0xe3, 0xc0, 0xf4, 0xf4, 0xb4, 0x6a, 0xbd, 0x7f, 0x5e,
+		0xb6, 0x26, 0xea, 0x1e, 0xf2, 0x17, 0x84, 0x0f, 0x0f, 0x89, 0x7a, 0x08, 0x33, 0x75, 0xc8, 0x1f,
+		0xb1, 0x87, 0x63, 0x6b, 0x3b, 0xae, 0x95, 0xfc, 0x7e, 0x4b, 0xe1, 0x2d, 0x04, 0x8e, 0x51, 0xd4,
+		0xa3, 0xb9, 0xd2, 0x24, 0xf3, 0xe5, 0x11, 0x6a, 0xe4, 0x31, 0xf2, 0xd7, 0x25, 0xf3, 0xd9, 0x17,
+		0xc8, 0xc9, 0xe9, 0x62, 0xf2, 0xef, 0xa9, 0x49, 0x56, 0x26, 0xf1, 0x93, 0xde, 0xff, 0x91, 0x3f,
+		0xe6, 0x9f, 0xf9, 0xe3, 0xf3, 0x53, 0x33, 0x2f, 0xa6, 0xa7, 0x66, 0x4e, 0x7e, 0xed, 0xbf, 0xb7,
+		0xf2, 0x38, 0xdb, 0x26, 0xc3, 0x5d, 0xa5, 0x9c, 0xcd, 0xc3, 0xcf, 0x2e, 0x99, 0x53, 0xdf, 0x99,
+		0x8f, 0xde, 0x66, 0xe5, 0xa3, 0xb7, 0x91, 0x8f, 0x2e, 0xbc, 0x06, 0x42, 0x3e, 0xfa, 0xce, 0x39,
+		0x44, 0x3e, 0xba, 0x85, 0x50, 0x5a, 0x0b, 0xa7, 0xb5, 0x90, 0x4a, 0x0b, 0xab, 0xbe, 0x9d, 0x21,
+		0xe4, 0xa3, 0x33, 0xc5, 0xdc, 0x46, 0xdc, 0x9d, 0x88, 0xbd, 0xad, 0xf8, 0x8b, 0xc1, 0x40, 0x0c,
+		0x0e, 0xae, 0x60, 0x61, 0x06, 0x0f, 0x43, 0x98, 0x08, 0xfa, 0xa9, 0x90, 0x8f, 0x8e, 0x7c, 0x74,
+		0xc0, 0x1f, 0xf9, 0xe8, 0x44, 0xc8, 0x47, 0x77, 0x09, 0xfe, 0xcd, 0x2e, 0x71, 0x04, 0x95, 0x10,
+		0x21, 0x1f, 0x5d, 0xb8, 0x55, 0x45, 0xf2, 0xd1, 0xdb, 0x12, 0xf9, 0xe8, 0x6d, 0xe4, 0xa3, 0xc3,
+		0x42, 0x13, 0xbd, 0xef, 0x7c, 0xf4, 0xf6, 0x61, 0xe7, 0xa3, 0xcf, 0x0e, 0x7a, 0x6e, 0x4e, 0x0e,
+		0x86, 0xfe, 0xf0, 0xba, 0xfa, 0xcb, 0x97, 0x4d, 0xf7, 0xfd, 0x6d, 0xed, 0x8c, 0xe8, 0x4f, 0x57,
+		0x57, 0x85, 0x37, 0x36, 0xc7, 0x37, 0x2e, 0x25, 0xa7, 0xe7, 0x7f, 0x3e, 0x9f, 0xbd, 0xd6, 0x5e,
+		0x4e, 0xf2, 0x94, 0xf6, 0xe6, 0x5b, 0xa2, 0x7a, 0x33, 0xcf, 0x6a, 0xff, 0x88, 0x4c, 0x75, 0xe8,
+		0x55, 0xe8, 0x55, 0x64, 0xaa, 0x13, 0x21, 0x53, 0xdd, 0x0d, 0xf8, 0xb7, 0xd2, 0x73, 0xac, 0x7c,
+		0x88, 0x90, 0xa9, 0x2e, 0xde, 0x0a, 0x99, 0xea, 0x44, 0x30, 0xd6, 0x04, 0x63, 0xed, 0x0c, 0x65,
+		0xc8, 0x54, 0xd7, 0xb8, 0x60, 0xac, 0x9d, 0x19, 0x12, 0x64, 0xaa, 0x97, 0x39, 0xda, 0xc8, 0x54,
+		0x37, 0x52, 0x55, 0xc8, 0x54, 0x87, 0xb9, 0x26, 0x82, 0xb9, 0x46, 0xa6, 0xba, 0x7b, 0xec, 0x6f,
+		0x35, 0x29, 0xb0, 0xd6, 0x44, 0xc8, 0x54, 0x3f, 0x2e, 0xe3, 0x8c, 0x4c, 0x75, 0xf3, 0xcf, 0x85,
+		0x79, 0x26, 0x82, 0x79, 0x26, 0x42, 0xa6, 0xfa, 0x2e, 0x75, 0x80, 0x4c, 0x75, 0x68, 0x03, 0x64,
+		0xaa, 0x23, 0x53, 0x1d, 0x99, 0xea, 0x44, 0xc8, 0x54, 0x47, 0xa6, 0x3a, 0x11, 0x32, 0xd5, 0x9d,
+		0x3e, 0x67, 0xff, 0x99, 0xea, 0x6d, 0x64, 0xaa, 0x1f, 0x67, 0xa6, 0x7a, 0x7b, 0xbf, 0x99, 0xea,
+		0x6d, 0x64, 0xaa, 0x6f, 0x9a, 0x8c, 0xb2, 0x32, 0xd5, 0xdb, 0xb6, 0x99, 0xea, 0x46, 0x87, 0xad,
+		0x6b, 0x0e, 0xac, 0xd0, 0x80, 0xee, 0x18, 0x43, 0xeb, 0xb1, 0xf3, 0x3d, 0xbd, 0xd1, 0xd8, 0x30,
+		0x12, 0x93, 0x35, 0x64, 0x12, 0xec, 0xc8, 0x40, 0x5b, 0x5e, 0x6e, 0x8e, 0x6f, 0xad, 0x7b, 0xbb,
+		0xc8, 0xf4, 0x96, 0xc5, 0x61, 0xe1, 0xea, 0x51, 0x67, 0x95, 0xb8, 0x61, 0x35, 0x18, 0xab, 0x2c,
+		0x1f, 0xf0, 0x5d, 0x03, 0xac, 0xb9, 0xda, 0x33, 0x5e, 0xd5, 0x19, 0xaf, 0xde, 0xb6, 0xac, 0xd2,
+		0x66, 0x9f, 0x20, 0x24, 0xd9, 0x85, 0xab, 0xaa, 0xe5, 0xe3, 0xc2, 0xc2, 0x5e, 0x2f, 0x51, 0xe9,
+		0xae, 0x65, 0xb4, 0x4e, 0x1c, 0xb6, 0x76, 0xbc, 0x75, 0xa9, 0xe7, 0x7c, 0xf9, 0x9e, 0x19, 0x4b,
+		0xd2, 0x03, 0xcd, 0x84, 0x5b, 0x4f, 0xd6, 0x6b, 0x69, 0x31, 0x70, 0x96, 0x6f, 0xaf, 0x7b, 0x8c,
+		0x9a, 0x03, 0xae, 0xc0, 0x33, 0x79, 0xb5, 0x43, 0xc6, 0x4e, 0xd1, 0x3a, 0xc7, 0x0c, 0x3a, 0x45,
+		0x19, 0xfc, 0x9a, 0xe5, 0x27, 0x8c, 0xca, 0x4e, 0x54, 0xac, 0xc6, 0x49, 0xa1, 0x48, 0x98, 0x8a,
+		0x06, 0x5b, 0x44, 0xd8, 0xa2, 0x62, 0x29, 0x32, 0xbb, 0x45, 0xa7, 0x40, 0x84, 0xb4, 0x45, 0x69,
+		0x76, 0xf9, 0x61, 0xef, 0x29, 0x8a, 0xc7, 0x2f, 0xc5, 0xa8, 0x6b, 0xb2, 0xd8, 0x58, 0xb7, 0x4a,
+		0x85, 0xba, 0x0f, 0x47, 0xfd, 0xf1, 0x98, 0xa8, 0xb8, 0x80, 0x9b, 0x6c, 0x92, 0xd3, 0x46, 0xb5,
+		0xcb, 0xa2, 0x68, 0xcb, 0x2f, 0x57, 0x8e, 0xad, 0xe5, 0xd9, 0x5a, 0xae, 0x85, 0xe4, 0x5b, 0x4f,
+		0xce, 0x35, 0xe5, 0x5d, 0x9f, 0x7d, 0x08, 0xca, 0xf2, 0x92, 0xfa, 0xbc, 0x30, 0x68, 0xf3, 0x25,
+		0x1e, 0x3d, 0xe5, 0x2f, 0xf9, 0x2a, 0xb5, 0xc6, 0x2b, 0x7e, 0xb6, 0x69, 0xce, 0x13, 0x2f, 0xd7,
+		0x69, 0x11, 0xdd, 0x4d, 0x00, 0x1b, 0xc0, 0xae, 0x02, 0xb0, 0x8d, 0xb3, 0x95, 0x18, 0x59, 0x4a,
+		0xcc, 0x10, 0xaa, 0x67, 0xaf, 0xd4, 0x90, 0x29, 0xcb, 0x78, 0x1e, 0xdb, 0x10, 0x29, 0x89, 0xe8,
+		0x1d, 0x86, 0x4f, 0xdd, 0x2a, 0x14, 0x4a, 0x6a, 0xc8, 0x6c, 0xb3, 0x8a, 0x44, 0xc6, 0xce, 0x91,
+		0xa3, 0xbd, 0x53, 0xa2, 0x19, 0x63, 0x66, 0x07, 0xd9, 0x65, 0x05, 0xc1, 0x44, 0x11, 0xc1, 0x44,
+		0x39, 0x34, 0x51, 0x63, 0xef, 0x2b, 0x83, 0x74, 0x1a, 0xa4, 0xe6, 0xf8, 0xbf, 0xa8, 0xf8, 0x21,
+		0x7b, 0x7c, 0x2f, 0x26, 0xaa, 0x09, 0x13, 0x55, 0x5a, 0x74, 0x2e, 0x6c, 0x13, 0x11, 0x33, 0x05,
+		0x86, 0x9f, 0xfa, 0xb2, 0xb8, 0xd4, 0x6a, 0xc1, 0x8e, 0xc1, 0x8e, 0x55, 0xc1, 0x8e, 0x99, 0x26,
+		0xaf, 0x30, 0x92, 0x56, 0xb0, 0xd2, 0x2a, 0x4b, 0x23, 0xc3, 0x8c, 0xbd, 0x5f, 0x33, 0x66, 0xa0,
+		0xc4, 0x97, 0x4c, 0x98, 0xfe, 0xae, 0x0a, 0x4c, 0x11, 0x11, 0x4c, 0x91, 0x3b, 0x53, 0x14, 0x0d,
+		0xcd, 0x2b, 0xbf, 0xbd, 0xad, 0xab, 0x2e, 0x0d, 0xda, 0x4c, 0x5f, 0xd1, 0xb9, 0x39, 0x5a, 0x8a,
+		0x9a, 0x38, 0xe8, 0xa2, 0x76, 0xe5, 0x04, 0x5f, 0x2c, 0xd6, 0xb1, 0x6b, 0x06, 0x67, 0x6f, 0xb5,
+		0xeb, 0xce, 0x6f, 0x1a, 0xc1, 0x59, 0x19, 0xa5, 0xeb, 0xea, 0x25, 0x4a, 0x04, 0xca, 0x1c, 0xbe,
+		0x93, 0x32, 0x87, 0x9d, 0x4a, 0x84, 0x85, 0x4e, 0x83, 0xa1, 0xb5, 0x64, 0xce, 0x2c, 0xca, 0x99,
+		0x15, 0xdd, 0xcc, 0x8a, 0x6a, 0x36, 0x8b, 0x66, 0xae, 0x50, 0xa0, 0xec, 0x52, 0x40, 0x97, 0x76,
+		0xa8, 0x32, 0x33, 0xce, 0xf3, 0x8f, 0xf1, 0xc3, 0xc6, 0xd1, 0x9e, 0xa9, 0x4e, 0x60, 0xf2, 0x01,
+		0xc5, 0xc7, 0xea, 0x04, 0xc6, 0x09, 0x8d, 0x9c, 0x4d, 0x8c, 0xec, 0xce, 0x2c, 0xcd, 0x37, 0x1b,
+		0xb0, 0x23, 0x6f, 0x6b, 0xd1, 0x53, 0xd4, 0x9b, 0xfe, 0x88, 0x18, 0x5a, 0x22, 0x3a, 0x8a, 0x18,
+		0x5a, 0xad, 0x0c, 0x44, 0x9d, 0x4c, 0x43, 0xb3, 0x8c, 0xc2, 0xb7, 0x17, 0x88, 0x23, 0x9d, 0x9c,
+		0x41, 0x93, 0xdc, 0x40, 0xc3, 0x65, 0xe7, 0x4c, 0xa4, 0x25, 0xd6, 0xbe, 0x86, 0x8f, 0x8e, 0x86,
+		0xc1, 0xd7, 0xe4, 0x7e, 0x1f, 0x4f, 0x7e, 0x0a, 0xbb, 0x9a, 0x8f, 0xf6, 0x78, 0x6c, 0xe2, 0x55,
+		0x57, 0x63, 0x79, 0x3b, 0x3e, 0x74, 0xc6, 0x13, 0x36, 0x6c, 0x6f, 0xed, 0x66, 0x05, 0x5a, 0x2c,
+		0x40, 0xcb, 0xea, 0xef, 0xb6, 0xf2, 0xab, 0xef, 0x7b, 0x3d, 0x7a, 0xc8, 0xbb, 0x53, 0xbd, 0x8d,
+		0x82, 0x5a, 0xa0, 0x82, 0x4f, 0xd7, 0xd4, 0xc1, 0xd5, 0xaa, 0xcd, 0x41, 0xf8, 0xb5, 0xac, 0xda,
+		0x2d, 0x39, 0xfc, 0x5a, 0x2f, 0xf2, 0x7e, 0x7d, 0xc4, 0x35, 0x89, 0x06, 0x21, 0x1c, 0xbb, 0xf8,
+		0xaa, 0x68, 0x38, 0xb6, 0xbd, 0x22, 0xad, 0x1f, 0xbd, 0xbe, 0x89, 0x1f, 0x1f, 0x0e, 0x9a, 0xe3,
+		0x3d, 0x3e, 0x94, 0xa6, 0x69, 0x96, 0xc3, 0x94, 0x0c, 0x74, 0xcd, 0x6a, 0xc3, 0x83, 0xd2, 0x36,
+		0xbb, 0x05, 0xe4, 0xe0, 0x55, 0xcd, 0x4e, 0x01, 0x82, 0x9e, 0x11, 0xd3, 0x33, 0xfa, 0x15, 0x7a,
+		0x2a, 0xaf, 0x73, 0xb4, 0x2b, 0xe8, 0xb8, 0x48, 0x34, 0x9b, 0x3c, 0xd4, 0x30, 0xdd, 0xac, 0x30,
+		0xe1, 0x98, 0xa5, 0x77, 0xce, 0x70, 0xb0, 0x76, 0x35, 0x0f, 0xd6, 0x16, 0x5e, 0x40, 0x76, 0xea,
+		0x9e, 0x89, 0x1b, 0xd0, 0xc2, 0xfd, 0xe7, 0xd7, 0x3d, 0x19, 0x47, 0x9f, 0xef, 0x6d, 0xfe, 0x9c,
+		0x05, 0xec, 0xf9, 0xe9, 0xf7, 0x34, 0x53, 0x4f, 0x6b, 0x9f, 0x30, 0x17, 0xc5, 0xc9, 0xbf, 0xd7,
+		0x3d, 0x0d, 0x90, 0x6c, 0xd5, 0x9a, 0xbb, 0x40, 0xb0, 0x89, 0xda, 0x6f, 0x7a, 0xa6, 0x8e, 0x6c,
+		0x6b, 0xcb, 0xb2, 0xb6, 0xec, 0x6e, 0xa3, 0xe6, 0x93, 0x37, 0x34, 0xf4, 0x39, 0x6c, 0xd3, 0x6d,
+		0x7e, 0x9c, 0x0d, 0x8b, 0xfd, 0xa8, 0xf9, 0x4d, 0xd5, 0x22, 0xcd, 0x5b, 0x5f, 0xe8, 0x20, 0x0c,
+		0x58, 0xfe, 0xfa, 0x65, 0x19, 0x2d, 0x93, 0x54, 0x56, 0x46, 0xda, 0xdf, 0x92, 0x1f, 0x3d, 0x4a,
+		0x0d, 0xcc, 0x5c, 0xa3, 0x22, 0xf4, 0x3a, 0x1b, 0x1e, 0x35, 0xbd, 0xce, 0x86, 0xae, 0xb3, 0xaa,
+		0xb5, 0xc3, 0x51, 0x18, 0xe2, 0x45, 0x86, 0xd9, 0xa4, 0x9a, 0x59, 0xa4, 0x3b, 0x2c, 0x74, 0x7d,
+		0xd7, 0xfa, 0x73, 0xc5, 0x5a, 0x1a, 0x2c, 0x40, 0xb5, 0x96, 0x06, 0x80, 0x08, 0x20, 0x62, 0x96,
+		0xfc, 0x62, 0x12, 0xa7, 0x62, 0x9a, 0xec, 0x62, 0x56, 0x7d, 0x8d, 0x11, 0xdc, 0xc7, 0xab, 0x78,
+		0xcb, 0x4c, 0x66, 0xb1, 0x09, 0x64, 0x7d, 0x35, 0xab, 0x25, 0x57, 0xfa, 0x50, 0x18, 0x47, 0xf7,
+		0x5a, 0x0d, 0x86, 0x50, 0xb0, 0x4d, 0xc7, 0x81, 0x86, 0x1e, 0x0c, 0x55, 0x62, 0xca, 0x75, 0x16,
+		0xda, 0x08, 0x6b, 0xe5, 0x33, 0x68, 0xe5, 0x23, 0xd3, 0xca, 0xda, 0xb2, 0x42, 0x55, 0xe2, 0x2d,
+		0xa9, 0x4a, 0xbe, 0x6a, 0x9c, 0x71, 0x30, 0x97, 0xb1, 0xc9, 0xfd, 0x87, 0xe5, 0x25, 0x07, 0x1a,
+		0xca, 0x2b, 0x8e, 0x34, 0x29, 0x4e, 0xc7, 0x28, 0x8c, 0x54, 0x54, 0xd5, 0x8e, 0xa1, 0x6e, 0x8d,
+		0x05, 0x8d, 0x23, 0x70, 0xd6, 0x82, 0xc7, 0x15, 0x40, 0x6b, 0x41, 0xb4, 0x16, 0x48, 0x09, 0xc1,
+		0xd4, 0x13, 0x50, 0x4d, 0x41, 0x35, 0x57, 0xdf, 0x9b, 0x0b, 0x89, 0x1f, 0x5d, 0x06, 0x44, 0x60,
+		0x86, 0x2f, 0xdb, 0x2f, 0xb4, 0xfb, 0x52, 0xfe, 0x17, 0x73, 0x2b, 0x66, 0x4a, 0x2e, 0xa5, 0x9c,
+		0x85, 0xfe, 0xaf, 0xa4, 0x00, 0xec, 0xbb, 0x22, 0xa7, 0x1d, 0x87, 0xb7, 0x04, 0xb8, 0x1b, 0x51,
+		0x69, 0x1f, 0xa1, 0xa8, 0x5c, 0xbd, 0xac, 0x25, 0x7d, 0x5c, 0xd5, 0x4e, 0x56, 0x7f, 0x77, 0x55,
+		0x7b, 0x6e, 0xd4, 0x5b, 0xaf, 0x27, 0x27, 0x1b, 0xfe, 0xe5, 0xd3, 0xa6, 0x3e, 0x6a, 0x2f, 0x27,
+		0x27, 0x27, 0x53, 0x21, 0x59, 0x12, 0x9c, 0x9b, 0x46, 0xb3, 0xf3, 0x69, 0xfc, 0xe3, 0xe4, 0xff,
+		0x6f, 0xa2, 0xa7, 0x75, 0x73, 0xad, 0x7c, 0x81, 0x3b, 0x9a, 0xac, 0xa6, 0xde, 0xe0, 0x29, 0x8c,
+		0xe2, 0xc0, 0xb0, 0x34, 0x8a, 0x84, 0xdc, 0x72, 0x4b, 0xa5, 0x08, 0xa0, 0xb7, 0x8a, 0x07, 0x22,
+		0x36, 0x71, 0x20, 0xa2, 0xab, 0xa1, 0x3d, 0x6b, 0x9d, 0xbf, 0xc3, 0x03, 0x11, 0xf7, 0x93, 0x67,
+		0x78, 0x72, 0x13, 0x06, 0xff, 0xb9, 0x0e, 0xfe, 0x6f, 0x23, 0xb8, 0xbc, 0xed, 0x2c, 0xfc, 0xe5,
+		0xcf, 0x3f, 0x83, 0xdb, 0x4e, 0x6e, 0x2f, 0xda, 0xcd, 0xd7, 0xda, 0xa7, 0xf9, 0xef, 0x3b, 0xb9,
+		0xa6, 0xff, 0x1b, 0xa7, 0xd5, 0xa7, 0xda, 0xcb, 0x9f, 0x7f, 0xfe, 0x70, 0x84, 0x39, 0x83, 0xc5,
+		0x13, 0xe7, 0x47, 0x77, 0xa3, 0x24, 0xcd, 0xcc, 0x17, 0xcc, 0xd3, 0x76, 0xe6, 0xb5, 0x6f, 0xee,
+		0xc3, 0x7e, 0xaa, 0xb0, 0xce, 0xc6, 0x3a, 0xdb, 0x52, 0x72, 0xc9, 0x6e, 0x9d, 0x7d, 0x37, 0x18,
+		0xf4, 0x55, 0x18, 0x73, 0x96, 0xda, 0xcd, 0x12, 0xe1, 0xf9, 0xff, 0xa2, 0x5c, 0x8f, 0x9a, 0xc3,
+		0x73, 0xda, 0xce, 0x31, 0xcc, 0xce, 0x00, 0x33, 0xc0, 0x6c, 0xe7, 0xec, 0xf5, 0x54, 0x37, 0x7a,
+		0x0a, 0xfb, 0xed, 0x0b, 0x0e, 0xd0, 0xce, 0xea, 0x1e, 0x9f, 0x57, 0x9d, 0x1f, 0x6b, 0x89, 0xaa,
+		0x33, 0x94, 0xa8, 0x32, 0x1d, 0xb2, 0xf3, 0x03, 0x1e, 0xb2, 0x23, 0xe0, 0x98, 0x83, 0xfb, 0xfb,
+		0x54, 0x31, 0x38, 0xe6, 0xb4, 0x1d, 0x8c, 0x18, 0x8c, 0x18, 0x11, 0x8c, 0x18, 0x11, 0x8c, 0x18,
+		0x8c, 0xd8, 0x41, 0x0e, 0xd9, 0x31, 0x54, 0x59, 0x1c, 0xf4, 0xfb, 0x41, 0x14, 0x67, 0x2a, 0xf9,
+		0x1a, 0xf6, 0x19, 0xc5, 0x16, 0x97, 0x9a, 0xc3, 0xa4, 0xc1, 0xa4, 0xed, 0xd5, 0xa4, 0xe5, 0x85,
+		0x7f, 0x9b, 0x6d, 0x86, 0x3d, 0x6b, 0xa3, 0xf2, 0x6f, 0xf5, 0xd4, 0xeb, 0x81, 0x56, 0xfe, 0x6d,
+		0xb7, 0x5a, 0xe7, 0xa8, 0xfd, 0x6b, 0x33, 0xad, 0xd3, 0x32, 0xf4, 0xdc, 0xf2, 0xf5, 0x70, 0xdf,
+		0xc3, 0x7e, 0xc1, 0x7d, 0xef, 0x10, 0x9e, 0x69, 0x96, 0x84, 0xd9, 0xe8, 0xc9, 0x1c, 0x9f, 0xb3,
+		0x86, 0x20, 0x8a, 0x00, 0xda, 0xde, 0x89, 0x22, 0x4e, 0x88, 0x00, 0x4f, 0xdc, 0xe7, 0x90, 0xe1,
+		0x84, 0x08, 0xf6, 0x1d, 0x9a, 0x85, 0xa1, 0xf5, 0xe2, 0x4e, 0xcb, 0xaf, 0x0c, 0x6d, 0x68, 0x35,
+		0x75, 0x15, 0x15, 0xc7, 0xb4, 0x2c, 0x9a, 0x95, 0xa7, 0xf0, 0x5b, 0xa0, 0x66, 0x6f, 0x59, 0xf7,
+		0x4a, 0x31, 0x2c, 0x4b, 0x46, 0xe5, 0xa3, 0x5f, 0x22, 0x89, 0xa9, 0x66, 0xdd, 0xed, 0x49, 0xb1,
+		0x91, 0xd3, 0xe9, 0x1f, 0x71, 0x36, 0x3c, 0xd5, 0xca, 0x0d, 0xa3, 0xed, 0x75, 0x63, 0xfe, 0x18,
+		0x77, 0x75, 0x3b, 0xfd, 0xe3, 0xb7, 0x6c, 0x78, 0xfb, 0xc7, 0xa4, 0x47, 0x17, 0x79, 0x6f, 0xdf,
+		0xe3, 0xee, 0x63, 0x32, 0x88, 0xa3, 0xff, 0xa8, 0x5e, 0xe1, 0x40, 0x2c, 0x94, 0x9d, 0x59, 0x68,
+		0x85, 0x8c, 0x50, 0x64, 0x84, 0xee, 0x1e, 0xfd, 0x2c, 0x89, 0xe2, 0x07, 0xa3, 0x4c, 0xfd, 0xc3,
+		0x28, 0x15, 0xbf, 0x06, 0x7d, 0xf3, 0x62, 0xf0, 0x6b, 0x58, 0xf7, 0x45, 0xea, 0x27, 0x4b, 0xd5,
+		0x23, 0x9e, 0x7c, 0xda, 0xd5, 0xd6, 0x92, 0x4f, 0x84, 0xfa, 0x46, 0x07, 0x50, 0xdf, 0x68, 0x57,
+		0x01, 0xab, 0xb5, 0x11, 0x2e, 0xd6, 0x90, 0xfe, 0x67, 0x95, 0x76, 0x93, 0x68, 0x38, 0x45, 0x8e,
+		0xff, 0xcf, 0xc1, 0x30, 0xe8, 0xab, 0xaf, 0xaa, 0x4f, 0xdd, 0x41, 0x9c, 0x85, 0x51, 0xac, 0x12,
+		0xba, 0x1f, 0x24, 0xf4, 0xdb, 0x3f, 0x7f, 0xcf, 0x7f, 0x73, 0x1f, 0x3d, 0x8c, 0x92, 0x31, 0xcc,
+		0x28, 0x8c, 0x7b, 0x64, 0x52, 0x46, 0x00, 0x89, 0xd3, 0x87, 0x62, 0x34, 0xaa, 0x5a, 0xce, 0x6f,
+		0x49, 0x45, 0xeb, 0x97, 0xee, 0x5b, 0x52, 0xcb, 0x5b, 0x0b, 0xf5, 0x79, 0x0b, 0xa8, 0xdc, 0xf6,
+		0x8a, 0x7e, 0x94, 0xfe, 0x14, 0xfe, 0xa5, 0xfe, 0x31, 0x18, 0xac, 0xcf, 0xd5, 0xea, 0x6b, 0xfb,
+		0x75, 0x6f, 0xcb, 0x7b, 0x7d, 0x56, 0x5f, 0xa3, 0x59, 0xc5, 0xc0, 0x57, 0xef, 0xf5, 0xff, 0x03,
+		0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xfd, 0x80, 0x65, 0x66, 0x32, 0xe3, 0x05, 0x00,
+	}
+)
+
+// ΛEnumTypes is a map, keyed by a YANG schema path, of the enumerated types that
+// correspond with the leaf. The type is represented as a reflect.Type. The naming
+// of the map ensures that there are no clashes with valid YANG identifiers.
+var ΛEnumTypes = map[string][]reflect.Type{
+	"/acl/ipv4-filter/entry/match/destination-port/operator": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator)(0)),
+	},
+	"/acl/ipv4-filter/entry/match/protocol": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol)(0)),
+	},
+	"/acl/ipv4-filter/entry/match/source-port/operator": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator)(0)),
+	},
+	"/acl/ipv6-filter/entry/match/destination-port/operator": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator)(0)),
+	},
+	"/acl/ipv6-filter/entry/match/next-header": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader)(0)),
+	},
+	"/acl/ipv6-filter/entry/match/source-port/operator": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator)(0)),
+	},
+	"/acl/mac-filter/entry/match/ethertype": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype)(0)),
+	},
+	"/interface/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaInterfaces_AdminState)(0)),
+	},
+	"/interface/ethernet/port-speed": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaInterfaces_Interface_Ethernet_PortSpeed)(0)),
+	},
+	"/interface/oper-down-reason": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaInterfaces_Interface_OperDownReason)(0)),
+	},
+	"/interface/oper-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaInterfaces_OperState)(0)),
+	},
+	"/interface/subinterface/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaInterfaces_AdminState)(0)),
+	},
+	"/interface/subinterface/oper-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaInterfaces_OperState)(0)),
+	},
+	"/network-instance/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaNetworkInstance_AdminState)(0)),
+	},
+	"/network-instance/interface/oper-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaNetworkInstance_OperState)(0)),
+	},
+	"/network-instance/next-hop-groups/group/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaNextHopGroups_AdminState)(0)),
+	},
+	"/network-instance/next-hop-groups/group/nexthop/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaNextHopGroups_AdminState)(0)),
+	},
+	"/network-instance/oper-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaNetworkInstance_OperState)(0)),
+	},
+	"/network-instance/protocols/bgp/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaBgp_AdminState)(0)),
+	},
+	"/network-instance/protocols/bgp/group/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaBgp_AdminState)(0)),
+	},
+	"/network-instance/protocols/bgp/group/ipv4-unicast/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaBgp_AdminState)(0)),
+	},
+	"/network-instance/protocols/bgp/group/ipv6-unicast/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaBgp_AdminState)(0)),
+	},
+	"/network-instance/protocols/bgp/ipv4-unicast/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaBgp_AdminState)(0)),
+	},
+	"/network-instance/protocols/bgp/ipv6-unicast/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaBgp_AdminState)(0)),
+	},
+	"/network-instance/protocols/bgp/neighbor/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaBgp_AdminState)(0)),
+	},
+	"/network-instance/protocols/bgp/neighbor/ipv4-unicast/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaBgp_AdminState)(0)),
+	},
+	"/network-instance/protocols/bgp/neighbor/ipv6-unicast/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaBgp_AdminState)(0)),
+	},
+	"/network-instance/protocols/bgp/neighbor/session-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaNetworkInstance_NetworkInstance_Protocols_Bgp_Neighbor_SessionState)(0)),
+	},
+	"/network-instance/protocols/bgp/oper-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaBgp_OperState)(0)),
+	},
+	"/network-instance/route-table/ipv4-unicast/route/route-type": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaCommon_IpRouteType)(0)),
+	},
+	"/network-instance/route-table/ipv6-unicast/route/route-type": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaCommon_IpRouteType)(0)),
+	},
+	"/network-instance/static-routes/route/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaStaticRoutes_AdminState)(0)),
+	},
+	"/network-instance/type": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaNetworkInstance_NiType)(0)),
+	},
+	"/system/ntp/admin-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaNtp_AdminState)(0)),
+	},
+	"/system/ntp/oper-state": []reflect.Type{
+		reflect.TypeOf((E_SrlNokiaNtp_OperState)(0)),
+	},
+}
@@ -0,0 +1,607 @@
+package translate
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openconfig/ygot/ygot"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+)
+
+// maxAclSequenceID is the highest sequence id of an entry of a filter
+const maxAclSequenceID = 65535
+
+// aclSequenceIDStep is the distance between the sequence ids assigned to
+// consecutive entries when there is room for it, so entries can be inserted
+// later on without renumbering the ones around them
+const aclSequenceIDStep = 10
+
+// AclFilter is a /acl/ipv4-filter, /acl/ipv6-filter or /acl/mac-filter list entry
+// of SR Linux
+type AclFilter struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Entry       []AclFilterEntry `json:"entry,omitempty"`
+}
+
+// AclFilterEntry is an entry list entry of a filter
+type AclFilterEntry struct {
+	SequenceID  uint32               `json:"sequence-id"`
+	Description string               `json:"description,omitempty"`
+	Match       *AclFilterMatch      `json:"match,omitempty"`
+	Action      *AclFilterAction     `json:"action,omitempty"`
+	Statistics  *AclFilterStatistics `json:"statistics,omitempty"`
+}
+
+// AclFilterMatch is the match container of a filter entry. Protocol is used by
+// the ipv4 filters and NextHeader by the ipv6 filters, the mac filters only use
+// SourceMac, DestinationMac and Ethertype.
+type AclFilterMatch struct {
+	Protocol        *intstr.IntOrString           `json:"protocol,omitempty"`
+	NextHeader      *intstr.IntOrString           `json:"next-header,omitempty"`
+	SourceIP        *AclFilterPrefix              `json:"source-ip,omitempty"`
+	DestinationIP   *AclFilterPrefix              `json:"destination-ip,omitempty"`
+	SourcePort      *srlinuxv1alpha1.AclPortMatch `json:"source-port,omitempty"`
+	DestinationPort *srlinuxv1alpha1.AclPortMatch `json:"destination-port,omitempty"`
+	DscpSet         []uint8                       `json:"dscp-set,omitempty"`
+	SourceMac       *AclFilterMac                 `json:"source-mac,omitempty"`
+	DestinationMac  *AclFilterMac                 `json:"destination-mac,omitempty"`
+	Ethertype       *intstr.IntOrString           `json:"ethertype,omitempty"`
+}
+
+// AclFilterMac is the source-mac or destination-mac container of a match
+type AclFilterMac struct {
+	Address string `json:"address"`
+	Mask    string `json:"mask,omitempty"`
+}
+
+// AclFilterPrefix is the source-ip or destination-ip container of a match
+type AclFilterPrefix struct {
+	Prefix string `json:"prefix"`
+}
+
+// AclFilterAction is the action container of a filter entry
+type AclFilterAction struct {
+	Accept *AclFilterLog `json:"accept,omitempty"`
+	Drop   *AclFilterLog `json:"drop,omitempty"`
+}
+
+// AclFilterLog is the accept or drop container of an action
+type AclFilterLog struct {
+	Log bool `json:"log"`
+}
+
+// AclFilterStatistics is the statistics container of a filter entry
+type AclFilterStatistics struct {
+	MatchedPackets Counter `json:"matched-packets"`
+	LastMatch      string  `json:"last-match,omitempty"`
+}
+
+// Acl is an ipv4, ipv6 or mac filter of SR Linux together with the subinterfaces
+// it is bound to
+type Acl struct {
+	// Type is ipv4, ipv6 or mac
+	Type     string
+	Filter   AclFilter
+	Bindings []srlinuxv1alpha1.AclBinding
+}
+
+// AclSequenceIDs returns the sequence ids of the entries of an Acl. Entries keep
+// the sequence id they are pinned to or, where the order of the entries allows
+// it, the one they were assigned before in previous; the other entries are
+// assigned ids in the gaps between them.
+func AclSequenceIDs(entries []srlinuxv1alpha1.AclEntry, previous []srlinuxv1alpha1.AclEntrySequenceID) ([]uint32, error) {
+	n := len(entries)
+	prev := make(map[string]uint32, len(previous))
+	for _, p := range previous {
+		prev[p.Name] = p.SequenceID
+	}
+	names := make(map[string]bool, n)
+	// candidate holds the id an entry would like to keep, -1 for none
+	candidate := make([]int64, n)
+	pinned := make([]bool, n)
+	lastPinned := int64(-1)
+	for i, e := range entries {
+		if names[e.Name] {
+			return nil, fmt.Errorf("duplicate entry name '%s'", e.Name)
+		}
+		names[e.Name] = true
+		candidate[i] = -1
+		if e.SequenceID != nil {
+			if *e.SequenceID > maxAclSequenceID {
+				return nil, fmt.Errorf("sequence id %d of entry '%s' is out of range", *e.SequenceID, e.Name)
+			}
+			if int64(*e.SequenceID) <= lastPinned {
+				return nil, fmt.Errorf("sequence id %d of entry '%s' does not follow the order of the entries", *e.SequenceID, e.Name)
+			}
+			candidate[i], pinned[i] = int64(*e.SequenceID), true
+			lastPinned = candidate[i]
+		} else if id, ok := prev[e.Name]; ok {
+			candidate[i] = int64(id)
+		}
+	}
+
+	// Keep the heaviest chain of candidates that leaves room for the entries in
+	// between, i.e. candidate[i]-i does not decrease along the chain. Pinned
+	// entries outweigh all the others together so they are always kept when
+	// there is a chain holding them all.
+	weight := make([]int, n)
+	best := make([]int, n)
+	from := make([]int, n)
+	for i := range entries {
+		from[i] = -1
+		if candidate[i] < 0 || candidate[i] < int64(i) || maxAclSequenceID-candidate[i] < int64(n-1-i) {
+			continue
+		}
+		weight[i] = 1
+		if pinned[i] {
+			weight[i] = n + 1
+		}
+		best[i] = weight[i]
+		for j := 0; j < i; j++ {
+			if best[j] > 0 && candidate[j] < candidate[i] && candidate[j]-int64(j) <= candidate[i]-int64(i) && best[j]+weight[i] > best[i] {
+				best[i], from[i] = best[j]+weight[i], j
+			}
+		}
+	}
+	last, total := -1, 0
+	for i := range entries {
+		if best[i] > total {
+			last, total = i, best[i]
+		}
+	}
+	numPinned := 0
+	for _, p := range pinned {
+		if p {
+			numPinned++
+		}
+	}
+	if total/(n+1) < numPinned {
+		return nil, fmt.Errorf("there is no room for the entries between the pinned sequence ids")
+	}
+	kept := make([]bool, n)
+	for i := last; i >= 0; i = from[i] {
+		kept[i] = true
+	}
+
+	// Spread the other entries over the gaps between the kept ids
+	ids := make([]uint32, n)
+	lo, start := int64(-1), 0
+	for i := 0; i <= n; i++ {
+		if i < n && !kept[i] {
+			continue
+		}
+		hi := int64(maxAclSequenceID + 1)
+		if i < n {
+			hi = candidate[i]
+		}
+		k := int64(i - start)
+		if lo < 0 && hi > k {
+			// start counting from 0 rather than using it when there is room
+			lo = 0
+		}
+		step := (hi - lo) / (k + 1)
+		if step > aclSequenceIDStep {
+			step = aclSequenceIDStep
+		}
+		for j := start; j < i; j++ {
+			ids[j] = uint32(lo + step*int64(j-start+1))
+		}
+		if i < n {
+			ids[i] = uint32(hi)
+			lo, start = hi, i+1
+		}
+	}
+	return ids, nil
+}
+
+// AclFromSpec returns the filter named name configured by spec, with the entries
+// of spec numbered by ids
+func AclFromSpec(name string, spec *srlinuxv1alpha1.AclSpec, ids []uint32) *Acl {
+	a := &Acl{
+		Type: spec.Type,
+		Filter: AclFilter{
+			Name:        name,
+			Description: spec.Description,
+			Entry:       make([]AclFilterEntry, 0, len(spec.Entries)),
+		},
+		Bindings: spec.Bindings,
+	}
+	for i, e := range spec.Entries {
+		m := &AclFilterMatch{
+			SourcePort:      e.Match.SourcePort,
+			DestinationPort: e.Match.DestinationPort,
+			DscpSet:         e.Match.DSCP,
+		}
+		if spec.Type == "ipv6" {
+			m.NextHeader = e.Match.Protocol
+		} else {
+			m.Protocol = e.Match.Protocol
+		}
+		if e.Match.SourcePrefix != "" {
+			m.SourceIP = &AclFilterPrefix{Prefix: e.Match.SourcePrefix}
+		}
+		if e.Match.DestinationPrefix != "" {
+			m.DestinationIP = &AclFilterPrefix{Prefix: e.Match.DestinationPrefix}
+		}
+		if e.Match.SourceMAC != nil {
+			m.SourceMac = &AclFilterMac{Address: e.Match.SourceMAC.Address, Mask: e.Match.SourceMAC.Mask}
+		}
+		if e.Match.DestinationMAC != nil {
+			m.DestinationMac = &AclFilterMac{Address: e.Match.DestinationMAC.Address, Mask: e.Match.DestinationMAC.Mask}
+		}
+		m.Ethertype = e.Match.Ethertype
+		action := &AclFilterAction{}
+		switch e.Action {
+		case "accept":
+			action.Accept = &AclFilterLog{Log: e.Log}
+		case "drop":
+			action.Drop = &AclFilterLog{Log: e.Log}
+		}
+		a.Filter.Entry = append(a.Filter.Entry, AclFilterEntry{
+			SequenceID:  ids[i],
+			Description: e.Description,
+			Match:       m,
+			Action:      action,
+		})
+	}
+	return a
+}
+
+// Device returns the SR Linux bindings holding the configuration of the filter and
+// its bindings to subinterfaces
+func (a *Acl) Device() (*srlmodels.Device, error) {
+	dev := &srlmodels.Device{}
+	var err error
+	switch a.Type {
+	case "ipv4":
+		err = a.ipv4Filter(dev.GetOrCreateAcl())
+	case "ipv6":
+		err = a.ipv6Filter(dev.GetOrCreateAcl())
+	case "mac":
+		err = a.macFilter(dev.GetOrCreateAcl())
+	default:
+		err = fmt.Errorf("invalid filter type '%s'", a.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range a.Bindings {
+		name, index, err := ParseSubinterfaceName(b.Subinterface)
+		if err != nil {
+			return nil, err
+		}
+		iface, ok := dev.Interface[name]
+		if !ok {
+			if iface, err = dev.NewInterface(name); err != nil {
+				return nil, err
+			}
+		}
+		subif, ok := iface.Subinterface[index]
+		if !ok {
+			if subif, err = iface.NewSubinterface(index); err != nil {
+				return nil, err
+			}
+		}
+		var ipv4Filter, ipv6Filter, macFilter **string
+		switch b.Direction {
+		case "input":
+			in := subif.GetOrCreateAcl().GetOrCreateInput()
+			ipv4Filter, ipv6Filter, macFilter = &in.Ipv4Filter, &in.Ipv6Filter, &in.MacFilter
+		case "output":
+			out := subif.GetOrCreateAcl().GetOrCreateOutput()
+			ipv4Filter, ipv6Filter, macFilter = &out.Ipv4Filter, &out.Ipv6Filter, &out.MacFilter
+		default:
+			return nil, fmt.Errorf("invalid direction '%s'", b.Direction)
+		}
+		switch a.Type {
+		case "ipv6":
+			*ipv6Filter = ygot.String(a.Filter.Name)
+		case "mac":
+			*macFilter = ygot.String(a.Filter.Name)
+		default:
+			*ipv4Filter = ygot.String(a.Filter.Name)
+		}
+	}
+	return dev, nil
+}
+
+func (a *Acl) ipv4Filter(acl *srlmodels.SrlNokiaAcl_Acl) error {
+	f, err := acl.NewIpv4Filter(a.Filter.Name)
+	if err != nil {
+		return err
+	}
+	if a.Filter.Description != "" {
+		f.Description = ygot.String(a.Filter.Description)
+	}
+	for _, e := range a.Filter.Entry {
+		entry, err := f.NewEntry(e.SequenceID)
+		if err != nil {
+			return err
+		}
+		if e.Description != "" {
+			entry.Description = ygot.String(e.Description)
+		}
+		if e.Action != nil {
+			action := entry.GetOrCreateAction()
+			if e.Action.Accept != nil {
+				action.GetOrCreateAccept().Log = ygot.Bool(e.Action.Accept.Log)
+			}
+			if e.Action.Drop != nil {
+				action.GetOrCreateDrop().Log = ygot.Bool(e.Action.Drop.Log)
+			}
+		}
+		if e.Match == nil {
+			continue
+		}
+		if e.Match.SourceMac != nil || e.Match.DestinationMac != nil || e.Match.Ethertype != nil {
+			return fmt.Errorf("entry %d of an ipv4 filter matches on the ethernet header", e.SequenceID)
+		}
+		m := entry.GetOrCreateMatch()
+		if p := e.Match.Protocol; p != nil {
+			if p.Type == intstr.Int {
+				n, err := protocolNumber(p)
+				if err != nil {
+					return err
+				}
+				m.Protocol = &srlmodels.SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union_Uint8{Uint8: n}
+			} else {
+				v, err := enumValue(srlmodels.SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_UNSET, p.StrVal)
+				if err != nil {
+					return err
+				}
+				m.Protocol = &srlmodels.SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol_Union_E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol{
+					E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol: srlmodels.E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_Protocol(v),
+				}
+			}
+		}
+		if e.Match.SourceIP != nil {
+			m.GetOrCreateSourceIp().Prefix = ygot.String(e.Match.SourceIP.Prefix)
+		}
+		if e.Match.DestinationIP != nil {
+			m.GetOrCreateDestinationIp().Prefix = ygot.String(e.Match.DestinationIP.Prefix)
+		}
+		if p := e.Match.SourcePort; p != nil {
+			op, err := portOperator(p)
+			if err != nil {
+				return err
+			}
+			port := m.GetOrCreateSourcePort()
+			port.Operator, port.Value = op, p.Value
+			if p.Range != nil {
+				port.Range = &srlmodels.SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_SourcePort_Range{Start: ygot.Uint16(p.Range.Start), End: ygot.Uint16(p.Range.End)}
+			}
+		}
+		if p := e.Match.DestinationPort; p != nil {
+			op, err := portOperator(p)
+			if err != nil {
+				return err
+			}
+			port := m.GetOrCreateDestinationPort()
+			port.Operator, port.Value = op, p.Value
+			if p.Range != nil {
+				port.Range = &srlmodels.SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Range{Start: ygot.Uint16(p.Range.Start), End: ygot.Uint16(p.Range.End)}
+			}
+		}
+		m.DscpSet = e.Match.DscpSet
+	}
+	return nil
+}
+
+func (a *Acl) ipv6Filter(acl *srlmodels.SrlNokiaAcl_Acl) error {
+	f, err := acl.NewIpv6Filter(a.Filter.Name)
+	if err != nil {
+		return err
+	}
+	if a.Filter.Description != "" {
+		f.Description = ygot.String(a.Filter.Description)
+	}
+	for _, e := range a.Filter.Entry {
+		entry, err := f.NewEntry(e.SequenceID)
+		if err != nil {
+			return err
+		}
+		if e.Description != "" {
+			entry.Description = ygot.String(e.Description)
+		}
+		if e.Action != nil {
+			action := entry.GetOrCreateAction()
+			if e.Action.Accept != nil {
+				action.GetOrCreateAccept().Log = ygot.Bool(e.Action.Accept.Log)
+			}
+			if e.Action.Drop != nil {
+				action.GetOrCreateDrop().Log = ygot.Bool(e.Action.Drop.Log)
+			}
+		}
+		if e.Match == nil {
+			continue
+		}
+		if e.Match.SourceMac != nil || e.Match.DestinationMac != nil || e.Match.Ethertype != nil {
+			return fmt.Errorf("entry %d of an ipv6 filter matches on the ethernet header", e.SequenceID)
+		}
+		m := entry.GetOrCreateMatch()
+		if p := e.Match.NextHeader; p != nil {
+			if p.Type == intstr.Int {
+				n, err := protocolNumber(p)
+				if err != nil {
+					return err
+				}
+				m.NextHeader = &srlmodels.SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union_Uint8{Uint8: n}
+			} else {
+				v, err := enumValue(srlmodels.SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_UNSET, p.StrVal)
+				if err != nil {
+					return err
+				}
+				m.NextHeader = &srlmodels.SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader_Union_E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader{
+					E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader: srlmodels.E_SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_NextHeader(v),
+				}
+			}
+		}
+		if e.Match.SourceIP != nil {
+			m.GetOrCreateSourceIp().Prefix = ygot.String(e.Match.SourceIP.Prefix)
+		}
+		if e.Match.DestinationIP != nil {
+			m.GetOrCreateDestinationIp().Prefix = ygot.String(e.Match.DestinationIP.Prefix)
+		}
+		if p := e.Match.SourcePort; p != nil {
+			op, err := portOperator(p)
+			if err != nil {
+				return err
+			}
+			port := m.GetOrCreateSourcePort()
+			port.Operator, port.Value = op, p.Value
+			if p.Range != nil {
+				port.Range = &srlmodels.SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_SourcePort_Range{Start: ygot.Uint16(p.Range.Start), End: ygot.Uint16(p.Range.End)}
+			}
+		}
+		if p := e.Match.DestinationPort; p != nil {
+			op, err := portOperator(p)
+			if err != nil {
+				return err
+			}
+			port := m.GetOrCreateDestinationPort()
+			port.Operator, port.Value = op, p.Value
+			if p.Range != nil {
+				port.Range = &srlmodels.SrlNokiaAcl_Acl_Ipv6Filter_Entry_Match_DestinationPort_Range{Start: ygot.Uint16(p.Range.Start), End: ygot.Uint16(p.Range.End)}
+			}
+		}
+		m.DscpSet = e.Match.DscpSet
+	}
+	return nil
+}
+
+func (a *Acl) macFilter(acl *srlmodels.SrlNokiaAcl_Acl) error {
+	f, err := acl.NewMacFilter(a.Filter.Name)
+	if err != nil {
+		return err
+	}
+	if a.Filter.Description != "" {
+		f.Description = ygot.String(a.Filter.Description)
+	}
+	for _, e := range a.Filter.Entry {
+		entry, err := f.NewEntry(e.SequenceID)
+		if err != nil {
+			return err
+		}
+		if e.Description != "" {
+			entry.Description = ygot.String(e.Description)
+		}
+		if e.Action != nil {
+			action := entry.GetOrCreateAction()
+			if e.Action.Accept != nil {
+				action.GetOrCreateAccept().Log = ygot.Bool(e.Action.Accept.Log)
+			}
+			if e.Action.Drop != nil {
+				action.GetOrCreateDrop().Log = ygot.Bool(e.Action.Drop.Log)
+			}
+		}
+		if e.Match == nil {
+			continue
+		}
+		if e.Match.Protocol != nil || e.Match.NextHeader != nil || e.Match.SourceIP != nil || e.Match.DestinationIP != nil ||
+			e.Match.SourcePort != nil || e.Match.DestinationPort != nil || len(e.Match.DscpSet) > 0 {
+			return fmt.Errorf("entry %d of a mac filter matches on the ip header", e.SequenceID)
+		}
+		m := entry.GetOrCreateMatch()
+		if mac := e.Match.SourceMac; mac != nil {
+			m.SourceMac = &srlmodels.SrlNokiaAcl_Acl_MacFilter_Entry_Match_SourceMac{Address: ygot.String(mac.Address)}
+			if mac.Mask != "" {
+				m.SourceMac.Mask = ygot.String(mac.Mask)
+			}
+		}
+		if mac := e.Match.DestinationMac; mac != nil {
+			m.DestinationMac = &srlmodels.SrlNokiaAcl_Acl_MacFilter_Entry_Match_DestinationMac{Address: ygot.String(mac.Address)}
+			if mac.Mask != "" {
+				m.DestinationMac.Mask = ygot.String(mac.Mask)
+			}
+		}
+		if t := e.Match.Ethertype; t != nil {
+			if t.Type == intstr.Int {
+				if t.IntVal < 1536 || t.IntVal > 65535 {
+					return fmt.Errorf("invalid ethertype %d", t.IntVal)
+				}
+				m.Ethertype = &srlmodels.SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union_Uint16{Uint16: uint16(t.IntVal)}
+			} else {
+				v, err := enumValue(srlmodels.SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_UNSET, t.StrVal)
+				if err != nil {
+					return err
+				}
+				m.Ethertype = &srlmodels.SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype_Union_E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype{
+					E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype: srlmodels.E_SrlNokiaAcl_Acl_MacFilter_Entry_Match_Ethertype(v),
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// protocolNumber returns the ipv4 protocol or ipv6 next-header number p
+func protocolNumber(p *intstr.IntOrString) (uint8, error) {
+	if p.IntVal < 0 || p.IntVal > 255 {
+		return 0, fmt.Errorf("invalid protocol %d", p.IntVal)
+	}
+	return uint8(p.IntVal), nil
+}
+
+// portOperator returns the operator of a port match, the port is either compared
+// with a value or matched against a range
+func portOperator(p *srlinuxv1alpha1.AclPortMatch) (srlmodels.E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator, error) {
+	if (p.Value == nil) == (p.Range == nil) {
+		return 0, fmt.Errorf("a port match sets either a value or a range")
+	}
+	if p.Range != nil {
+		if p.Range.Start > p.Range.End {
+			return 0, fmt.Errorf("invalid port range %d-%d", p.Range.Start, p.Range.End)
+		}
+		return srlmodels.SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator_UNSET, nil
+	}
+	if p.Operator == "" {
+		return srlmodels.SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator_UNSET, nil
+	}
+	v, err := enumValue(srlmodels.SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator_UNSET, p.Operator)
+	if err != nil {
+		return 0, err
+	}
+	return srlmodels.E_SrlNokiaAcl_Acl_Ipv4Filter_Entry_Match_DestinationPort_Operator(v), nil
+}
+
+// ParseSubinterfaceName returns the interface name and the index of the
+// subinterface named name, e.g. ethernet-1/1 and 10 for ethernet-1/1.10
+func ParseSubinterfaceName(name string) (string, uint32, error) {
+	i := strings.LastIndex(name, ".")
+	if i <= 0 {
+		return "", 0, fmt.Errorf("invalid subinterface name '%s'", name)
+	}
+	index, err := strconv.ParseUint(name[i+1:], 10, 32)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid subinterface name '%s'", name)
+	}
+	return name[:i], uint32(index), nil
+}
+
+// Status copies the statistics of the entries of the filter into the status of a
+// device, names maps the sequence ids to the names of the entries. Entries that
+// are not in names are left out.
+func (f *AclFilter) Status(devStatus *srlinuxv1alpha1.AclDeviceStatus, names map[uint32]string) {
+	devStatus.Entries = make([]srlinuxv1alpha1.AclEntryState, 0, len(f.Entry))
+	for _, e := range f.Entry {
+		name, ok := names[e.SequenceID]
+		if !ok {
+			continue
+		}
+		state := srlinuxv1alpha1.AclEntryState{Name: name, SequenceID: e.SequenceID}
+		if e.Statistics != nil {
+			state.MatchedPackets = uint64(e.Statistics.MatchedPackets)
+			state.LastMatch = e.Statistics.LastMatch
+		}
+		devStatus.Entries = append(devStatus.Entries, state)
+	}
+	sort.Slice(devStatus.Entries, func(i, j int) bool {
+		return devStatus.Entries[i].SequenceID < devStatus.Entries[j].SequenceID
+	})
+}
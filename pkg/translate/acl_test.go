@@ -0,0 +1,331 @@
+package translate
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+)
+
+func TestAclDevice(t *testing.T) {
+	tcp := intstr.FromString("tcp")
+	ssh := uint16(22)
+	spec := &srlinuxv1alpha1.AclSpec{
+		Type:        "ipv4",
+		Description: "edge",
+		Entries: []srlinuxv1alpha1.AclEntry{
+			{
+				Name:   "ssh",
+				Action: "accept",
+				Match: srlinuxv1alpha1.AclMatch{
+					Protocol:        &tcp,
+					SourcePrefix:    "10.0.0.0/8",
+					DestinationPort: &srlinuxv1alpha1.AclPortMatch{Operator: "eq", Value: &ssh},
+				},
+			},
+			{
+				Name:   "high-ports",
+				Action: "accept",
+				Match: srlinuxv1alpha1.AclMatch{
+					SourcePort: &srlinuxv1alpha1.AclPortMatch{Range: &srlinuxv1alpha1.AclPortRange{Start: 1024, End: 65535}},
+					DSCP:       []uint8{46},
+				},
+			},
+			{Name: "default", Action: "drop", Log: true},
+		},
+		Bindings: []srlinuxv1alpha1.AclBinding{{Subinterface: "ethernet-1/1.10", Direction: "input"}},
+	}
+	want := `{
+  "srl_nokia-acl:acl": {
+    "ipv4-filter": [
+      {
+        "name": "edge-in",
+        "description": "edge",
+        "entry": [
+          {
+            "sequence-id": 10,
+            "action": {"accept": {"log": false}},
+            "match": {
+              "protocol": "tcp",
+              "source-ip": {"prefix": "10.0.0.0/8"},
+              "destination-port": {"operator": "eq", "value": 22}
+            }
+          },
+          {
+            "sequence-id": 20,
+            "action": {"accept": {"log": false}},
+            "match": {
+              "source-port": {"range": {"start": 1024, "end": 65535}},
+              "dscp-set": [46]
+            }
+          },
+          {"sequence-id": 30, "action": {"drop": {"log": true}}}
+        ]
+      }
+    ]
+  },
+  "srl_nokia-interfaces:interface": [
+    {
+      "name": "ethernet-1/1",
+      "subinterface": [
+        {"index": 10, "srl_nokia-acl:acl": {"input": {"ipv4-filter": "edge-in"}}}
+      ]
+    }
+  ]
+}`
+
+	ids, err := AclSequenceIDs(spec.Entries, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev, err := AclFromSpec("edge-in", spec, ids).Device()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := gnmic.StructToJSONIETF(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, got, []byte(want))
+}
+
+func TestAclDeviceMac(t *testing.T) {
+	arp := intstr.FromString("arp")
+	lldp := intstr.FromInt(0x88cc)
+	spec := &srlinuxv1alpha1.AclSpec{
+		Type: "mac",
+		Entries: []srlinuxv1alpha1.AclEntry{
+			{
+				Name:   "arp",
+				Action: "accept",
+				Match: srlinuxv1alpha1.AclMatch{
+					SourceMAC: &srlinuxv1alpha1.AclMacMatch{Address: "00:00:5e:00:53:00", Mask: "ff:ff:ff:ff:ff:00"},
+					Ethertype: &arp,
+				},
+			},
+			{
+				Name:   "lldp",
+				Action: "drop",
+				Match: srlinuxv1alpha1.AclMatch{
+					DestinationMAC: &srlinuxv1alpha1.AclMacMatch{Address: "01:80:c2:00:00:0e"},
+					Ethertype:      &lldp,
+				},
+			},
+		},
+		Bindings: []srlinuxv1alpha1.AclBinding{{Subinterface: "ethernet-1/2.0", Direction: "output"}},
+	}
+	want := `{
+  "srl_nokia-acl:acl": {
+    "mac-filter": [
+      {
+        "name": "l2",
+        "entry": [
+          {
+            "sequence-id": 10,
+            "action": {"accept": {"log": false}},
+            "match": {
+              "source-mac": {"address": "00:00:5e:00:53:00", "mask": "ff:ff:ff:ff:ff:00"},
+              "ethertype": "arp"
+            }
+          },
+          {
+            "sequence-id": 20,
+            "action": {"drop": {"log": false}},
+            "match": {
+              "destination-mac": {"address": "01:80:c2:00:00:0e"},
+              "ethertype": 35020
+            }
+          }
+        ]
+      }
+    ]
+  },
+  "srl_nokia-interfaces:interface": [
+    {
+      "name": "ethernet-1/2",
+      "subinterface": [
+        {"index": 0, "srl_nokia-acl:acl": {"output": {"mac-filter": "l2"}}}
+      ]
+    }
+  ]
+}`
+
+	ids, err := AclSequenceIDs(spec.Entries, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dev, err := AclFromSpec("l2", spec, ids).Device()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := gnmic.StructToJSONIETF(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, got, []byte(want))
+}
+
+func TestAclDeviceInvalid(t *testing.T) {
+	port := uint16(80)
+	proto := intstr.FromInt(300)
+	ethertype := intstr.FromInt(1500)
+	tests := []struct {
+		name string
+		spec srlinuxv1alpha1.AclSpec
+	}{
+		{name: "protocol", spec: srlinuxv1alpha1.AclSpec{Type: "ipv4", Entries: []srlinuxv1alpha1.AclEntry{
+			{Name: "a", Action: "drop", Match: srlinuxv1alpha1.AclMatch{Protocol: &proto}},
+		}}},
+		{name: "prefix", spec: srlinuxv1alpha1.AclSpec{Type: "ipv6", Entries: []srlinuxv1alpha1.AclEntry{
+			{Name: "a", Action: "drop", Match: srlinuxv1alpha1.AclMatch{SourcePrefix: "10.0.0.0/8"}},
+		}}},
+		{name: "port", spec: srlinuxv1alpha1.AclSpec{Type: "ipv4", Entries: []srlinuxv1alpha1.AclEntry{
+			{Name: "a", Action: "drop", Match: srlinuxv1alpha1.AclMatch{SourcePort: &srlinuxv1alpha1.AclPortMatch{
+				Value: &port,
+				Range: &srlinuxv1alpha1.AclPortRange{Start: 80, End: 90},
+			}}},
+		}}},
+		{name: "dscp", spec: srlinuxv1alpha1.AclSpec{Type: "ipv4", Entries: []srlinuxv1alpha1.AclEntry{
+			{Name: "a", Action: "drop", Match: srlinuxv1alpha1.AclMatch{DSCP: []uint8{64}}},
+		}}},
+		{name: "ethertype", spec: srlinuxv1alpha1.AclSpec{Type: "mac", Entries: []srlinuxv1alpha1.AclEntry{
+			{Name: "a", Action: "drop", Match: srlinuxv1alpha1.AclMatch{Ethertype: &ethertype}},
+		}}},
+		{name: "mac address", spec: srlinuxv1alpha1.AclSpec{Type: "mac", Entries: []srlinuxv1alpha1.AclEntry{
+			{Name: "a", Action: "drop", Match: srlinuxv1alpha1.AclMatch{SourceMAC: &srlinuxv1alpha1.AclMacMatch{Address: "00:00:5e:00:53"}}},
+		}}},
+		{name: "ip match in mac filter", spec: srlinuxv1alpha1.AclSpec{Type: "mac", Entries: []srlinuxv1alpha1.AclEntry{
+			{Name: "a", Action: "drop", Match: srlinuxv1alpha1.AclMatch{SourcePrefix: "10.0.0.0/8"}},
+		}}},
+		{name: "mac match in ip filter", spec: srlinuxv1alpha1.AclSpec{Type: "ipv4", Entries: []srlinuxv1alpha1.AclEntry{
+			{Name: "a", Action: "drop", Match: srlinuxv1alpha1.AclMatch{DestinationMAC: &srlinuxv1alpha1.AclMacMatch{Address: "00:00:5e:00:53:00"}}},
+		}}},
+		{name: "binding", spec: srlinuxv1alpha1.AclSpec{
+			Type:     "ipv4",
+			Entries:  []srlinuxv1alpha1.AclEntry{{Name: "a", Action: "drop"}},
+			Bindings: []srlinuxv1alpha1.AclBinding{{Subinterface: "ethernet-1/1", Direction: "input"}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := AclSequenceIDs(tt.spec.Entries, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dev, err := AclFromSpec("a", &tt.spec, ids).Device()
+			if err == nil {
+				_, err = gnmic.StructToJSONIETF(dev)
+			}
+			if err == nil {
+				t.Errorf("expected an error for spec %+v", tt.spec)
+			}
+		})
+	}
+}
+
+func TestAclSequenceIDs(t *testing.T) {
+	seq := func(id uint32) *uint32 { return &id }
+	tests := []struct {
+		name     string
+		entries  []srlinuxv1alpha1.AclEntry
+		previous []srlinuxv1alpha1.AclEntrySequenceID
+		want     []uint32
+	}{
+		{
+			name:    "new",
+			entries: []srlinuxv1alpha1.AclEntry{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+			want:    []uint32{10, 20, 30},
+		},
+		{
+			name:     "insert",
+			entries:  []srlinuxv1alpha1.AclEntry{{Name: "x"}, {Name: "a"}, {Name: "y"}, {Name: "b"}, {Name: "c"}, {Name: "z"}},
+			previous: []srlinuxv1alpha1.AclEntrySequenceID{{Name: "a", SequenceID: 10}, {Name: "b", SequenceID: 20}, {Name: "c", SequenceID: 30}},
+			want:     []uint32{5, 10, 15, 20, 30, 40},
+		},
+		{
+			name:     "remove",
+			entries:  []srlinuxv1alpha1.AclEntry{{Name: "a"}, {Name: "c"}},
+			previous: []srlinuxv1alpha1.AclEntrySequenceID{{Name: "a", SequenceID: 10}, {Name: "b", SequenceID: 20}, {Name: "c", SequenceID: 30}},
+			want:     []uint32{10, 30},
+		},
+		{
+			name:     "move",
+			entries:  []srlinuxv1alpha1.AclEntry{{Name: "c"}, {Name: "a"}, {Name: "b"}},
+			previous: []srlinuxv1alpha1.AclEntrySequenceID{{Name: "a", SequenceID: 10}, {Name: "b", SequenceID: 20}, {Name: "c", SequenceID: 30}},
+			want:     []uint32{5, 10, 20},
+		},
+		{
+			name:     "no room",
+			entries:  []srlinuxv1alpha1.AclEntry{{Name: "a"}, {Name: "x"}, {Name: "y"}, {Name: "b"}},
+			previous: []srlinuxv1alpha1.AclEntrySequenceID{{Name: "a", SequenceID: 10}, {Name: "b", SequenceID: 11}},
+			want:     []uint32{10, 20, 30, 40},
+		},
+		{
+			name:     "pinned",
+			entries:  []srlinuxv1alpha1.AclEntry{{Name: "a"}, {Name: "b", SequenceID: seq(10)}, {Name: "c"}},
+			previous: []srlinuxv1alpha1.AclEntrySequenceID{{Name: "a", SequenceID: 10}, {Name: "c", SequenceID: 30}},
+			want:     []uint32{5, 10, 30},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AclSequenceIDs(tt.entries, tt.previous)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AclSequenceIDs() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAclSequenceIDsInvalid(t *testing.T) {
+	seq := func(id uint32) *uint32 { return &id }
+	tests := []struct {
+		name    string
+		entries []srlinuxv1alpha1.AclEntry
+	}{
+		{name: "duplicate", entries: []srlinuxv1alpha1.AclEntry{{Name: "a"}, {Name: "a"}}},
+		{name: "order", entries: []srlinuxv1alpha1.AclEntry{{Name: "a", SequenceID: seq(20)}, {Name: "b", SequenceID: seq(10)}}},
+		{name: "room", entries: []srlinuxv1alpha1.AclEntry{{Name: "a", SequenceID: seq(10)}, {Name: "b"}, {Name: "c", SequenceID: seq(11)}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := AclSequenceIDs(tt.entries, nil); err == nil {
+				t.Errorf("expected an error for entries %+v", tt.entries)
+			}
+		})
+	}
+}
+
+func TestAclStatus(t *testing.T) {
+	// JSON_IETF encodes counter64 values as strings
+	state := `{
+  "name": "edge-in",
+  "entry": [
+    {"sequence-id": 30, "action": {"drop": {"log": true}}, "statistics": {"matched-packets": "18446744073709551615"}},
+    {"sequence-id": 10, "statistics": {"matched-packets": "42", "last-match": "2020-10-15T08:12:31.000Z"}},
+    {"sequence-id": 15, "statistics": {"matched-packets": "1"}}
+  ]
+}`
+	var f AclFilter
+	if err := json.Unmarshal([]byte(state), &f); err != nil {
+		t.Fatal(err)
+	}
+	var got srlinuxv1alpha1.AclDeviceStatus
+	f.Status(&got, map[uint32]string{10: "ssh", 30: "default"})
+	want := srlinuxv1alpha1.AclDeviceStatus{
+		Entries: []srlinuxv1alpha1.AclEntryState{
+			{Name: "ssh", SequenceID: 10, MatchedPackets: 42, LastMatch: "2020-10-15T08:12:31.000Z"},
+			{Name: "default", SequenceID: 30, MatchedPackets: 18446744073709551615},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Status() = %+v, want %+v", got, want)
+	}
+}
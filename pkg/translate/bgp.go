@@ -0,0 +1,296 @@
+package translate
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openconfig/ygot/ygot"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+)
+
+// Bgp is the /network-instance/protocols/bgp container of SR Linux
+type Bgp struct {
+	AdminState       string `json:"admin-state,omitempty"`
+	OperState        string `json:"oper-state,omitempty"`
+	AutonomousSystem uint32 `json:"autonomous-system,omitempty"`
+	RouterID         string `json:"router-id,omitempty"`
+	BgpPolicies
+	BgpAddressFamilies
+	Group    []BgpGroup    `json:"group,omitempty"`
+	Neighbor []BgpNeighbor `json:"neighbor,omitempty"`
+}
+
+// BgpPolicies are the import and export policies of bgp, a group or a neighbor
+type BgpPolicies struct {
+	ImportPolicy string `json:"import-policy,omitempty"`
+	ExportPolicy string `json:"export-policy,omitempty"`
+}
+
+// BgpAddressFamilies are the address family containers of bgp, a group or a
+// neighbor
+type BgpAddressFamilies struct {
+	IPv4Unicast *BgpAddressFamily `json:"ipv4-unicast,omitempty"`
+	IPv6Unicast *BgpAddressFamily `json:"ipv6-unicast,omitempty"`
+}
+
+// BgpAddressFamily is an ipv4-unicast or ipv6-unicast container
+type BgpAddressFamily struct {
+	AdminState     string `json:"admin-state,omitempty"`
+	ReceivedRoutes uint32 `json:"received-routes,omitempty"`
+	ActiveRoutes   uint32 `json:"active-routes,omitempty"`
+	SentRoutes     uint32 `json:"sent-routes,omitempty"`
+}
+
+// BgpGroup is a /network-instance/protocols/bgp/group list entry
+type BgpGroup struct {
+	GroupName   string `json:"group-name"`
+	AdminState  string `json:"admin-state,omitempty"`
+	Description string `json:"description,omitempty"`
+	PeerAS      uint32 `json:"peer-as,omitempty"`
+	BgpPolicies
+	BgpAddressFamilies
+}
+
+// BgpNeighbor is a /network-instance/protocols/bgp/neighbor list entry
+type BgpNeighbor struct {
+	PeerAddress     string `json:"peer-address"`
+	AdminState      string `json:"admin-state,omitempty"`
+	Description     string `json:"description,omitempty"`
+	PeerGroup       string `json:"peer-group,omitempty"`
+	PeerAS          uint32 `json:"peer-as,omitempty"`
+	SessionState    string `json:"session-state,omitempty"`
+	LastEstablished string `json:"last-established,omitempty"`
+	BgpPolicies
+	BgpAddressFamilies
+}
+
+// BgpFromSpec returns the bgp configuration of spec
+func BgpFromSpec(spec *srlinuxv1alpha1.BgpSpec) *Bgp {
+	b := &Bgp{
+		AdminState:         spec.AdminState,
+		AutonomousSystem:   spec.AutonomousSystem,
+		RouterID:           spec.RouterID,
+		BgpPolicies:        BgpPolicies{ImportPolicy: spec.ImportPolicy, ExportPolicy: spec.ExportPolicy},
+		BgpAddressFamilies: bgpAddressFamiliesFromSpec(spec.AddressFamilies),
+	}
+	for _, g := range spec.Groups {
+		b.Group = append(b.Group, BgpGroup{
+			GroupName:          g.Name,
+			AdminState:         g.AdminState,
+			Description:        g.Description,
+			PeerAS:             g.PeerAS,
+			BgpPolicies:        BgpPolicies{ImportPolicy: g.ImportPolicy, ExportPolicy: g.ExportPolicy},
+			BgpAddressFamilies: bgpAddressFamiliesFromSpec(g.AddressFamilies),
+		})
+	}
+	for _, n := range spec.Neighbors {
+		b.Neighbor = append(b.Neighbor, BgpNeighbor{
+			PeerAddress:        n.PeerAddress,
+			AdminState:         n.AdminState,
+			Description:        n.Description,
+			PeerGroup:          n.PeerGroup,
+			PeerAS:             n.PeerAS,
+			BgpPolicies:        BgpPolicies{ImportPolicy: n.ImportPolicy, ExportPolicy: n.ExportPolicy},
+			BgpAddressFamilies: bgpAddressFamiliesFromSpec(n.AddressFamilies),
+		})
+	}
+	return b
+}
+
+// BgpFromTree returns the bgp container held by the generic JSON representation
+// tree, rooted at the bgp container
+func BgpFromTree(tree interface{}) (*Bgp, error) {
+	b := &Bgp{}
+	if err := decodeTree(tree, nil, b); err != nil {
+		return nil, fmt.Errorf("cannot decode bgp: %v", err)
+	}
+	return b, nil
+}
+
+// bgpAddressFamiliesFromSpec enables the address families in afs and disables
+// the others. Nothing is set when afs is empty, the address families are
+// inherited then.
+func bgpAddressFamiliesFromSpec(afs []srlinuxv1alpha1.BgpAddressFamily) BgpAddressFamilies {
+	if len(afs) == 0 {
+		return BgpAddressFamilies{}
+	}
+	a := BgpAddressFamilies{
+		IPv4Unicast: &BgpAddressFamily{AdminState: "disable"},
+		IPv6Unicast: &BgpAddressFamily{AdminState: "disable"},
+	}
+	for _, af := range afs {
+		switch af {
+		case "ipv4-unicast":
+			a.IPv4Unicast.AdminState = "enable"
+		case "ipv6-unicast":
+			a.IPv6Unicast.AdminState = "enable"
+		}
+	}
+	return a
+}
+
+// Device returns the SR Linux bindings holding the bgp configuration of the
+// network instance named name
+func (b *Bgp) Device(name string) (*srlmodels.Device, error) {
+	dev := &srlmodels.Device{}
+	ni, err := dev.NewNetworkInstance(name)
+	if err != nil {
+		return nil, err
+	}
+	bgp := ni.GetOrCreateProtocols().GetOrCreateBgp()
+	if bgp.AdminState, err = bgpAdminState(b.AdminState); err != nil {
+		return nil, err
+	}
+	bgp.AutonomousSystem = ygot.Uint32(b.AutonomousSystem)
+	bgp.RouterId = ygot.String(b.RouterID)
+	bgp.ImportPolicy, bgp.ExportPolicy = b.policies()
+	if b.IPv4Unicast != nil {
+		afi := bgp.GetOrCreateIpv4Unicast()
+		if afi.AdminState, err = bgpAdminState(b.IPv4Unicast.AdminState); err != nil {
+			return nil, err
+		}
+	}
+	if b.IPv6Unicast != nil {
+		afi := bgp.GetOrCreateIpv6Unicast()
+		if afi.AdminState, err = bgpAdminState(b.IPv6Unicast.AdminState); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, g := range b.Group {
+		group, err := bgp.NewGroup(g.GroupName)
+		if err != nil {
+			return nil, err
+		}
+		if group.AdminState, err = bgpAdminState(g.AdminState); err != nil {
+			return nil, err
+		}
+		if g.Description != "" {
+			group.Description = ygot.String(g.Description)
+		}
+		if g.PeerAS != 0 {
+			group.PeerAs = ygot.Uint32(g.PeerAS)
+		}
+		group.ImportPolicy, group.ExportPolicy = g.policies()
+		if g.IPv4Unicast != nil {
+			afi := group.GetOrCreateIpv4Unicast()
+			if afi.AdminState, err = bgpAdminState(g.IPv4Unicast.AdminState); err != nil {
+				return nil, err
+			}
+		}
+		if g.IPv6Unicast != nil {
+			afi := group.GetOrCreateIpv6Unicast()
+			if afi.AdminState, err = bgpAdminState(g.IPv6Unicast.AdminState); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for _, n := range b.Neighbor {
+		neighbor, err := bgp.NewNeighbor(n.PeerAddress)
+		if err != nil {
+			return nil, err
+		}
+		if neighbor.AdminState, err = bgpAdminState(n.AdminState); err != nil {
+			return nil, err
+		}
+		if n.Description != "" {
+			neighbor.Description = ygot.String(n.Description)
+		}
+		neighbor.PeerGroup = ygot.String(n.PeerGroup)
+		if n.PeerAS != 0 {
+			neighbor.PeerAs = ygot.Uint32(n.PeerAS)
+		}
+		neighbor.ImportPolicy, neighbor.ExportPolicy = n.policies()
+		if n.IPv4Unicast != nil {
+			afi := neighbor.GetOrCreateIpv4Unicast()
+			if afi.AdminState, err = bgpAdminState(n.IPv4Unicast.AdminState); err != nil {
+				return nil, err
+			}
+		}
+		if n.IPv6Unicast != nil {
+			afi := neighbor.GetOrCreateIpv6Unicast()
+			if afi.AdminState, err = bgpAdminState(n.IPv6Unicast.AdminState); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return dev, nil
+}
+
+// bgpAdminState returns the admin-state named name, UNSET when name is empty
+func bgpAdminState(name string) (srlmodels.E_SrlNokiaBgp_AdminState, error) {
+	if name == "" {
+		return srlmodels.SrlNokiaBgp_AdminState_UNSET, nil
+	}
+	v, err := enumValue(srlmodels.SrlNokiaBgp_AdminState_UNSET, name)
+	return srlmodels.E_SrlNokiaBgp_AdminState(v), err
+}
+
+// policies returns the policy leaves that are set
+func (p BgpPolicies) policies() (importPolicy, exportPolicy *string) {
+	if p.ImportPolicy != "" {
+		importPolicy = ygot.String(p.ImportPolicy)
+	}
+	if p.ExportPolicy != "" {
+		exportPolicy = ygot.String(p.ExportPolicy)
+	}
+	return importPolicy, exportPolicy
+}
+
+// Status copies the state of bgp and of its neighbors into the status of a
+// device. The uptime of the established sessions is computed at now.
+func (b *Bgp) Status(devStatus *srlinuxv1alpha1.BgpDeviceStatus, now time.Time) {
+	devStatus.AdminState = b.AdminState
+	devStatus.OperState = b.OperState
+	devStatus.Neighbors = make([]srlinuxv1alpha1.BgpNeighborState, 0, len(b.Neighbor))
+	for _, n := range b.Neighbor {
+		state := srlinuxv1alpha1.BgpNeighborState{
+			PeerAddress:     n.PeerAddress,
+			PeerGroup:       n.PeerGroup,
+			PeerAS:          n.PeerAS,
+			SessionState:    n.SessionState,
+			LastEstablished: n.LastEstablished,
+		}
+		if n.SessionState == "established" {
+			state.Uptime = sessionUptime(n.LastEstablished, now)
+		}
+		for _, af := range []struct {
+			name  srlinuxv1alpha1.BgpAddressFamily
+			state *BgpAddressFamily
+		}{
+			{name: "ipv4-unicast", state: n.IPv4Unicast},
+			{name: "ipv6-unicast", state: n.IPv6Unicast},
+		} {
+			if af.state == nil {
+				continue
+			}
+			state.AddressFamilies = append(state.AddressFamilies, srlinuxv1alpha1.BgpAddressFamilyState{
+				Name:           af.name,
+				AdminState:     af.state.AdminState,
+				ReceivedRoutes: af.state.ReceivedRoutes,
+				ActiveRoutes:   af.state.ActiveRoutes,
+				SentRoutes:     af.state.SentRoutes,
+			})
+		}
+		devStatus.Neighbors = append(devStatus.Neighbors, state)
+	}
+}
+
+// sessionUptime returns the time elapsed at now since lastEstablished, rounded
+// to seconds. SR Linux appends the age to the timestamp, e.g.
+// "2020-10-15T08:12:31.000Z (2 days ago)", only the timestamp is used.
+func sessionUptime(lastEstablished string, now time.Time) string {
+	fields := strings.Fields(lastEstablished)
+	if len(fields) == 0 {
+		return ""
+	}
+	t, err := time.Parse(time.RFC3339Nano, fields[0])
+	if err != nil || t.After(now) {
+		return ""
+	}
+	return now.Sub(t).Round(time.Second).String()
+}
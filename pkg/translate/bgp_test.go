@@ -0,0 +1,146 @@
+package translate
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+)
+
+func TestBgpDevice(t *testing.T) {
+	spec := &srlinuxv1alpha1.BgpSpec{
+		AdminState:       "enable",
+		AutonomousSystem: 65001,
+		RouterID:         "10.0.0.1",
+		AddressFamilies:  []srlinuxv1alpha1.BgpAddressFamily{"ipv4-unicast"},
+		Groups: []srlinuxv1alpha1.BgpGroup{{
+			Name:        "spines",
+			PeerAS:      65000,
+			BgpPolicies: srlinuxv1alpha1.BgpPolicies{ImportPolicy: "import-all", ExportPolicy: "export-lo"},
+		}},
+		Neighbors: []srlinuxv1alpha1.BgpNeighbor{{
+			PeerAddress:     "192.168.0.1",
+			PeerGroup:       "spines",
+			AddressFamilies: []srlinuxv1alpha1.BgpAddressFamily{"ipv4-unicast", "ipv6-unicast"},
+		}},
+	}
+	want := `{
+  "srl_nokia-network-instance:network-instance": [
+    {
+      "name": "default",
+      "protocols": {
+        "srl_nokia-bgp:bgp": {
+          "admin-state": "enable",
+          "autonomous-system": 65001,
+          "router-id": "10.0.0.1",
+          "ipv4-unicast": {"admin-state": "enable"},
+          "ipv6-unicast": {"admin-state": "disable"},
+          "group": [
+            {"group-name": "spines", "peer-as": 65000, "import-policy": "import-all", "export-policy": "export-lo"}
+          ],
+          "neighbor": [
+            {
+              "peer-address": "192.168.0.1",
+              "peer-group": "spines",
+              "ipv4-unicast": {"admin-state": "enable"},
+              "ipv6-unicast": {"admin-state": "enable"}
+            }
+          ]
+        }
+      }
+    }
+  ]
+}`
+
+	dev, err := BgpFromSpec(spec).Device("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := gnmic.StructToJSONIETF(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, got, []byte(want))
+}
+
+func TestBgpDeviceInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		spec srlinuxv1alpha1.BgpSpec
+	}{
+		{name: "router-id", spec: srlinuxv1alpha1.BgpSpec{AutonomousSystem: 65001, RouterID: "10.0.0"}},
+		{name: "admin-state", spec: srlinuxv1alpha1.BgpSpec{AutonomousSystem: 65001, RouterID: "10.0.0.1", AdminState: "up"}},
+		{name: "peer-address", spec: srlinuxv1alpha1.BgpSpec{
+			AutonomousSystem: 65001,
+			RouterID:         "10.0.0.1",
+			Groups:           []srlinuxv1alpha1.BgpGroup{{Name: "spines"}},
+			Neighbors:        []srlinuxv1alpha1.BgpNeighbor{{PeerAddress: "spine1", PeerGroup: "spines"}},
+		}},
+		{name: "peer-group", spec: srlinuxv1alpha1.BgpSpec{
+			AutonomousSystem: 65001,
+			RouterID:         "10.0.0.1",
+			Neighbors:        []srlinuxv1alpha1.BgpNeighbor{{PeerAddress: "192.168.0.1", PeerGroup: "spines"}},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dev, err := BgpFromSpec(&tt.spec).Device("default")
+			if err == nil {
+				_, err = gnmic.StructToJSONIETF(dev)
+			}
+			if err == nil {
+				t.Errorf("expected an error for spec %+v", tt.spec)
+			}
+		})
+	}
+}
+
+func TestBgpStatus(t *testing.T) {
+	state := `{
+  "admin-state": "enable",
+  "oper-state": "up",
+  "autonomous-system": 65001,
+  "router-id": "10.0.0.1",
+  "neighbor": [
+    {
+      "peer-address": "192.168.0.1",
+      "peer-group": "spines",
+      "peer-as": 65000,
+      "session-state": "established",
+      "last-established": "2020-10-15T08:12:31.000Z (2 hours ago)",
+      "ipv4-unicast": {"admin-state": "enable", "received-routes": 12, "active-routes": 10, "sent-routes": 3}
+    },
+    {"peer-address": "192.168.0.5", "peer-group": "spines", "session-state": "active"}
+  ]
+}`
+	var b Bgp
+	if err := json.Unmarshal([]byte(state), &b); err != nil {
+		t.Fatal(err)
+	}
+	var got srlinuxv1alpha1.BgpDeviceStatus
+	b.Status(&got, time.Date(2020, 10, 15, 10, 30, 0, 0, time.UTC))
+	want := srlinuxv1alpha1.BgpDeviceStatus{
+		AdminState: "enable",
+		OperState:  "up",
+		Neighbors: []srlinuxv1alpha1.BgpNeighborState{
+			{
+				PeerAddress:     "192.168.0.1",
+				PeerGroup:       "spines",
+				PeerAS:          65000,
+				SessionState:    "established",
+				LastEstablished: "2020-10-15T08:12:31.000Z (2 hours ago)",
+				Uptime:          "2h17m29s",
+				AddressFamilies: []srlinuxv1alpha1.BgpAddressFamilyState{
+					{Name: "ipv4-unicast", AdminState: "enable", ReceivedRoutes: 12, ActiveRoutes: 10, SentRoutes: 3},
+				},
+			},
+			{PeerAddress: "192.168.0.5", PeerGroup: "spines", SessionState: "active"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Status() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,106 @@
+package translate
+
+import (
+	"github.com/openconfig/ygot/ygot"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+)
+
+// Interface is an /interface list entry of SR Linux
+type Interface struct {
+	Name           string               `json:"name"`
+	Description    string               `json:"description,omitempty"`
+	AdminState     string               `json:"admin-state,omitempty"`
+	Mtu            uint16               `json:"mtu,omitempty"`
+	VlanTagging    bool                 `json:"vlan-tagging,omitempty"`
+	OperState      string               `json:"oper-state,omitempty"`
+	OperDownReason string               `json:"oper-down-reason,omitempty"`
+	LastChange     string               `json:"last-change,omitempty"`
+	Ethernet       *InterfaceEthernet   `json:"ethernet,omitempty"`
+	Statistics     *InterfaceStatistics `json:"statistics,omitempty"`
+}
+
+// InterfaceEthernet is the /interface/ethernet container
+type InterfaceEthernet struct {
+	PortSpeed string `json:"port-speed,omitempty"`
+}
+
+// InterfaceStatistics is the statistics container of an interface or a subinterface
+type InterfaceStatistics struct {
+	InOctets            Counter `json:"in-octets,omitempty"`
+	InUnicastPackets    Counter `json:"in-unicast-packets,omitempty"`
+	InErrorPackets      Counter `json:"in-error-packets,omitempty"`
+	InDiscardedPackets  Counter `json:"in-discarded-packets,omitempty"`
+	OutOctets           Counter `json:"out-octets,omitempty"`
+	OutUnicastPackets   Counter `json:"out-unicast-packets,omitempty"`
+	OutErrorPackets     Counter `json:"out-error-packets,omitempty"`
+	OutDiscardedPackets Counter `json:"out-discarded-packets,omitempty"`
+}
+
+// InterfaceFromSpec returns the interface configured by spec
+func InterfaceFromSpec(spec *srlinuxv1alpha1.InterfaceSpec) *Interface {
+	return &Interface{
+		Name:        spec.Name,
+		Description: spec.Description,
+		AdminState:  spec.AdminState,
+		Mtu:         spec.MTU,
+		VlanTagging: spec.VlanTagging,
+	}
+}
+
+// Device returns the SR Linux bindings holding the configuration of the interface.
+// Subinterfaces are left out, they are configured on their own.
+func (i *Interface) Device() (*srlmodels.Device, error) {
+	dev := &srlmodels.Device{}
+	iface, err := dev.NewInterface(i.Name)
+	if err != nil {
+		return nil, err
+	}
+	if i.Description != "" {
+		iface.Description = ygot.String(i.Description)
+	}
+	if i.AdminState != "" {
+		v, err := enumValue(srlmodels.SrlNokiaInterfaces_AdminState_UNSET, i.AdminState)
+		if err != nil {
+			return nil, err
+		}
+		iface.AdminState = srlmodels.E_SrlNokiaInterfaces_AdminState(v)
+	}
+	if i.Mtu != 0 {
+		iface.Mtu = ygot.Uint16(i.Mtu)
+	}
+	iface.VlanTagging = ygot.Bool(i.VlanTagging)
+	return dev, nil
+}
+
+// Status copies the state of the interface into the status of a device
+func (i *Interface) Status(devStatus *srlinuxv1alpha1.InterfaceDeviceStatus) {
+	devStatus.AdminState = i.AdminState
+	devStatus.OperState = i.OperState
+	devStatus.OperDownReason = i.OperDownReason
+	devStatus.MTU = i.Mtu
+	devStatus.LastChange = i.LastChange
+	devStatus.Speed = ""
+	if i.Ethernet != nil {
+		devStatus.Speed = i.Ethernet.PortSpeed
+	}
+	devStatus.Counters = i.Statistics.counters()
+}
+
+// counters returns the statistics in the layout of the status of the resources
+func (s *InterfaceStatistics) counters() *srlinuxv1alpha1.InterfaceCounters {
+	if s == nil {
+		return nil
+	}
+	return &srlinuxv1alpha1.InterfaceCounters{
+		InOctets:            uint64(s.InOctets),
+		InUnicastPackets:    uint64(s.InUnicastPackets),
+		InErrorPackets:      uint64(s.InErrorPackets),
+		InDiscardedPackets:  uint64(s.InDiscardedPackets),
+		OutOctets:           uint64(s.OutOctets),
+		OutUnicastPackets:   uint64(s.OutUnicastPackets),
+		OutErrorPackets:     uint64(s.OutErrorPackets),
+		OutDiscardedPackets: uint64(s.OutDiscardedPackets),
+	}
+}
@@ -0,0 +1,158 @@
+package translate
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+)
+
+func TestInterfaceDevice(t *testing.T) {
+	spec := &srlinuxv1alpha1.InterfaceSpec{
+		Name:        "ethernet-1/1",
+		Description: "uplink",
+		AdminState:  "enable",
+		MTU:         9214,
+		VlanTagging: true,
+	}
+	want := `{
+  "srl_nokia-interfaces:interface": [
+    {"name": "ethernet-1/1", "description": "uplink", "admin-state": "enable", "mtu": 9214, "vlan-tagging": true}
+  ]
+}`
+
+	dev, err := InterfaceFromSpec(spec).Device()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := gnmic.StructToJSONIETF(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, got, []byte(want))
+}
+
+func TestInterfaceDeviceInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		spec srlinuxv1alpha1.InterfaceSpec
+	}{
+		{name: "name", spec: srlinuxv1alpha1.InterfaceSpec{Name: "eth0"}},
+		{name: "admin-state", spec: srlinuxv1alpha1.InterfaceSpec{Name: "ethernet-1/1", AdminState: "up"}},
+		{name: "mtu", spec: srlinuxv1alpha1.InterfaceSpec{Name: "ethernet-1/1", MTU: 1000}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dev, err := InterfaceFromSpec(&tt.spec).Device()
+			if err == nil {
+				_, err = gnmic.StructToJSONIETF(dev)
+			}
+			if err == nil {
+				t.Errorf("expected an error for spec %+v", tt.spec)
+			}
+		})
+	}
+}
+
+func TestInterfaceStatus(t *testing.T) {
+	// JSON_IETF encodes counter64 values as strings
+	state := `{
+  "name": "ethernet-1/1",
+  "admin-state": "enable",
+  "oper-state": "down",
+  "oper-down-reason": "port-admin-disabled",
+  "mtu": 9214,
+  "last-change": "2020-10-15T08:12:31.000Z",
+  "ethernet": {"port-speed": "100G"},
+  "statistics": {"in-octets": "18446744073709551615", "out-octets": "1200", "in-error-packets": 3}
+}`
+	var i Interface
+	if err := json.Unmarshal([]byte(state), &i); err != nil {
+		t.Fatal(err)
+	}
+	var got srlinuxv1alpha1.InterfaceDeviceStatus
+	i.Status(&got)
+	want := srlinuxv1alpha1.InterfaceDeviceStatus{
+		AdminState:     "enable",
+		OperState:      "down",
+		OperDownReason: "port-admin-disabled",
+		Speed:          "100G",
+		MTU:            9214,
+		LastChange:     "2020-10-15T08:12:31.000Z",
+		Counters: &srlinuxv1alpha1.InterfaceCounters{
+			InOctets:       18446744073709551615,
+			OutOctets:      1200,
+			InErrorPackets: 3,
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Status() = %+v, want %+v", got, want)
+	}
+}
+
+func TestSubinterfaceDevice(t *testing.T) {
+	vlan := uint16(10)
+	spec := &srlinuxv1alpha1.SubinterfaceSpec{
+		Interface:  "ethernet-1/1",
+		Index:      10,
+		AdminState: "enable",
+		VlanID:     &vlan,
+		IPv4:       []string{"192.168.10.1/30"},
+		IPv6:       []string{"2001:db8:10::1/64"},
+	}
+	want := `{
+  "srl_nokia-interfaces:interface": [
+    {
+      "name": "ethernet-1/1",
+      "subinterface": [
+        {
+          "index": 10,
+          "admin-state": "enable",
+          "ipv4": {"address": [{"ip-prefix": "192.168.10.1/30"}]},
+          "ipv6": {"address": [{"ip-prefix": "2001:db8:10::1/64"}]},
+          "srl_nokia-interfaces-vlans:vlan": {"encap": {"single-tagged": {"vlan-id": 10}}}
+        }
+      ]
+    }
+  ]
+}`
+
+	dev, err := SubinterfaceFromSpec(spec).Device(spec.Interface)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := gnmic.StructToJSONIETF(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, got, []byte(want))
+}
+
+func TestSubinterfaceStatus(t *testing.T) {
+	state := `{
+  "index": 10,
+  "admin-state": "enable",
+  "oper-state": "up",
+  "vlan": {"encap": {"single-tagged": {"vlan-id": 10}}},
+  "ipv4": {"address": [{"ip-prefix": "192.168.10.1/30"}]},
+  "statistics": {"in-octets": "42"}
+}`
+	var s Subinterface
+	if err := json.Unmarshal([]byte(state), &s); err != nil {
+		t.Fatal(err)
+	}
+	var got srlinuxv1alpha1.SubinterfaceDeviceStatus
+	s.Status(&got)
+	want := srlinuxv1alpha1.SubinterfaceDeviceStatus{
+		AdminState: "enable",
+		OperState:  "up",
+		VlanID:     10,
+		IPv4:       []string{"192.168.10.1/30"},
+		Counters:   &srlinuxv1alpha1.InterfaceCounters{InOctets: 42},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Status() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,94 @@
+package translate
+
+import (
+	"github.com/openconfig/ygot/ygot"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+)
+
+// NetworkInstance is a /network-instance list entry of SR Linux
+type NetworkInstance struct {
+	Name        string                     `json:"name"`
+	Type        string                     `json:"type,omitempty"`
+	AdminState  string                     `json:"admin-state,omitempty"`
+	OperState   string                     `json:"oper-state,omitempty"`
+	Description string                     `json:"description,omitempty"`
+	RouterID    string                     `json:"router-id,omitempty"`
+	Interface   []NetworkInstanceInterface `json:"interface,omitempty"`
+}
+
+// NetworkInstanceInterface is a /network-instance/interface list entry
+type NetworkInstanceInterface struct {
+	Name      string `json:"name"`
+	OperState string `json:"oper-state,omitempty"`
+}
+
+// NetworkInstanceFromSpec returns the network instance named name configured by spec
+func NetworkInstanceFromSpec(name string, spec *srlinuxv1alpha1.NetworkInstanceSpec) *NetworkInstance {
+	ni := &NetworkInstance{
+		Name:        name,
+		Type:        spec.Type,
+		AdminState:  spec.AdminState,
+		Description: spec.Description,
+		RouterID:    spec.RouterID,
+	}
+	for _, iface := range spec.Interfaces {
+		ni.Interface = append(ni.Interface, NetworkInstanceInterface{Name: iface})
+	}
+	return ni
+}
+
+// Device returns the SR Linux bindings holding the configuration of the network
+// instance. The protocols of the network instance are left out, they are
+// configured on their own.
+func (n *NetworkInstance) Device() (*srlmodels.Device, error) {
+	dev := &srlmodels.Device{}
+	ni, err := dev.NewNetworkInstance(n.Name)
+	if err != nil {
+		return nil, err
+	}
+	if n.Type != "" {
+		// JSON_IETF identityref values carry the module of the identity
+		v, err := enumValue(srlmodels.SrlNokiaNetworkInstance_NiType_UNSET, gnmic.StripModulePrefix(n.Type))
+		if err != nil {
+			return nil, err
+		}
+		ni.Type = srlmodels.E_SrlNokiaNetworkInstance_NiType(v)
+	}
+	if n.AdminState != "" {
+		v, err := enumValue(srlmodels.SrlNokiaNetworkInstance_AdminState_UNSET, n.AdminState)
+		if err != nil {
+			return nil, err
+		}
+		ni.AdminState = srlmodels.E_SrlNokiaNetworkInstance_AdminState(v)
+	}
+	if n.Description != "" {
+		ni.Description = ygot.String(n.Description)
+	}
+	if n.RouterID != "" {
+		ni.RouterId = ygot.String(n.RouterID)
+	}
+	for _, iface := range n.Interface {
+		if _, err := ni.NewInterface(iface.Name); err != nil {
+			return nil, err
+		}
+	}
+	return dev, nil
+}
+
+// Status copies the state of the network instance into the status of a device
+func (n *NetworkInstance) Status(devStatus *srlinuxv1alpha1.NetworkInstanceDeviceStatus) {
+	devStatus.Type = gnmic.StripModulePrefix(n.Type)
+	devStatus.AdminState = n.AdminState
+	devStatus.OperState = n.OperState
+	devStatus.RouterID = n.RouterID
+	devStatus.Interfaces = make([]srlinuxv1alpha1.NetworkInstanceInterfaceState, 0, len(n.Interface))
+	for _, iface := range n.Interface {
+		devStatus.Interfaces = append(devStatus.Interfaces, srlinuxv1alpha1.NetworkInstanceInterfaceState{
+			Name:      iface.Name,
+			OperState: iface.OperState,
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package translate
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+)
+
+func TestNetworkInstanceDevice(t *testing.T) {
+	spec := &srlinuxv1alpha1.NetworkInstanceSpec{
+		Type:       "ip-vrf",
+		AdminState: "enable",
+		RouterID:   "10.0.0.1",
+		Interfaces: []string{"ethernet-1/1.10", "lo0.0"},
+	}
+	want := `{
+  "srl_nokia-network-instance:network-instance": [
+    {
+      "name": "ip-vrf-1",
+      "type": "srl_nokia-network-instance:ip-vrf",
+      "admin-state": "enable",
+      "router-id": "10.0.0.1",
+      "interface": [{"name": "ethernet-1/1.10"}, {"name": "lo0.0"}]
+    }
+  ]
+}`
+
+	dev, err := NetworkInstanceFromSpec("ip-vrf-1", spec).Device()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := gnmic.StructToJSONIETF(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, got, []byte(want))
+}
+
+func TestNetworkInstanceDeviceInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		spec srlinuxv1alpha1.NetworkInstanceSpec
+	}{
+		{name: "type", spec: srlinuxv1alpha1.NetworkInstanceSpec{Type: "vpls"}},
+		{name: "router-id", spec: srlinuxv1alpha1.NetworkInstanceSpec{Type: "ip-vrf", RouterID: "10.0.0"}},
+		{name: "interface", spec: srlinuxv1alpha1.NetworkInstanceSpec{Type: "ip-vrf", Interfaces: []string{"ethernet-1/1"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dev, err := NetworkInstanceFromSpec("ip-vrf-1", &tt.spec).Device()
+			if err == nil {
+				_, err = gnmic.StructToJSONIETF(dev)
+			}
+			if err == nil {
+				t.Errorf("expected an error for spec %+v", tt.spec)
+			}
+		})
+	}
+}
+
+func TestNetworkInstanceStatus(t *testing.T) {
+	state := `{
+  "name": "ip-vrf-1",
+  "type": "srl_nokia-network-instance:ip-vrf",
+  "admin-state": "enable",
+  "oper-state": "up",
+  "router-id": "10.0.0.1",
+  "interface": [{"name": "ethernet-1/1.10", "oper-state": "up"}]
+}`
+	var n NetworkInstance
+	if err := json.Unmarshal([]byte(state), &n); err != nil {
+		t.Fatal(err)
+	}
+	var got srlinuxv1alpha1.NetworkInstanceDeviceStatus
+	n.Status(&got)
+	want := srlinuxv1alpha1.NetworkInstanceDeviceStatus{
+		Type:       "ip-vrf",
+		AdminState: "enable",
+		OperState:  "up",
+		RouterID:   "10.0.0.1",
+		Interfaces: []srlinuxv1alpha1.NetworkInstanceInterfaceState{{Name: "ethernet-1/1.10", OperState: "up"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Status() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,79 @@
+package translate
+
+import (
+	"github.com/openconfig/ygot/ygot"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+)
+
+// NextHopGroup is a /network-instance/next-hop-groups/group list entry of SR Linux
+type NextHopGroup struct {
+	Name       string    `json:"name"`
+	AdminState string    `json:"admin-state,omitempty"`
+	Nexthop    []NextHop `json:"nexthop,omitempty"`
+}
+
+// NextHop is a /network-instance/next-hop-groups/group/nexthop list entry
+type NextHop struct {
+	Index      uint16 `json:"index"`
+	IPAddress  string `json:"ip-address,omitempty"`
+	AdminState string `json:"admin-state,omitempty"`
+	Resolve    *bool  `json:"resolve,omitempty"`
+}
+
+// NextHopGroupFromSpec returns the next-hop group named name configured by spec
+func NextHopGroupFromSpec(name string, spec *srlinuxv1alpha1.NextHopGroupSpec) *NextHopGroup {
+	g := &NextHopGroup{
+		Name:       name,
+		AdminState: spec.AdminState,
+	}
+	for _, nh := range spec.NextHops {
+		g.Nexthop = append(g.Nexthop, NextHop{
+			Index:      nh.Index,
+			IPAddress:  nh.IPAddress,
+			AdminState: nh.AdminState,
+			Resolve:    nh.Resolve,
+		})
+	}
+	return g
+}
+
+// Device returns the SR Linux bindings holding the configuration of the next-hop
+// group in the network instance named name
+func (g *NextHopGroup) Device(name string) (*srlmodels.Device, error) {
+	dev := &srlmodels.Device{}
+	ni, err := dev.NewNetworkInstance(name)
+	if err != nil {
+		return nil, err
+	}
+	group, err := ni.GetOrCreateNextHopGroups().NewGroup(g.Name)
+	if err != nil {
+		return nil, err
+	}
+	if g.AdminState != "" {
+		v, err := enumValue(srlmodels.SrlNokiaNextHopGroups_AdminState_UNSET, g.AdminState)
+		if err != nil {
+			return nil, err
+		}
+		group.AdminState = srlmodels.E_SrlNokiaNextHopGroups_AdminState(v)
+	}
+	for _, nh := range g.Nexthop {
+		nexthop, err := group.NewNexthop(nh.Index)
+		if err != nil {
+			return nil, err
+		}
+		if nh.IPAddress != "" {
+			nexthop.IpAddress = ygot.String(nh.IPAddress)
+		}
+		if nh.AdminState != "" {
+			v, err := enumValue(srlmodels.SrlNokiaNextHopGroups_AdminState_UNSET, nh.AdminState)
+			if err != nil {
+				return nil, err
+			}
+			nexthop.AdminState = srlmodels.E_SrlNokiaNextHopGroups_AdminState(v)
+		}
+		nexthop.Resolve = nh.Resolve
+	}
+	return dev, nil
+}
@@ -0,0 +1,165 @@
+package translate
+
+import (
+	"fmt"
+
+	"github.com/openconfig/ygot/ygot"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+)
+
+// ntpPath are the elements of the path of the ntp container
+var ntpPath = []string{"system", "ntp"}
+
+// Ntp is the /system/ntp container of SR Linux
+type Ntp struct {
+	AdminState      string      `json:"admin-state,omitempty"`
+	OperState       string      `json:"oper-state,omitempty"`
+	Synchronized    string      `json:"synchronized,omitempty"`
+	NetworkInstance string      `json:"network-instance,omitempty"`
+	Server          []NtpServer `json:"server,omitempty"`
+}
+
+// NtpServer is a /system/ntp/server list entry
+type NtpServer struct {
+	Address      string  `json:"address"`
+	IBurst       bool    `json:"iburst"`
+	Prefer       bool    `json:"prefer"`
+	Stratum      uint8   `json:"stratum,omitempty"`
+	Jitter       Decimal `json:"jitter,omitempty"`
+	Offset       Decimal `json:"offset,omitempty"`
+	PollInterval uint16  `json:"poll-interval,omitempty"`
+}
+
+// NtpFromSpec returns the ntp container configured by spec
+func NtpFromSpec(spec *srlinuxv1alpha1.NtpSpec) *Ntp {
+	return &Ntp{
+		AdminState:      spec.AdminState,
+		NetworkInstance: spec.NetworkInstance,
+		Server:          ntpServersFromSpec(spec.Server),
+	}
+}
+
+// NtpFromBaseline returns the ntp container configured by baseline
+func NtpFromBaseline(baseline *srlinuxv1alpha1.NtpBaseline) *Ntp {
+	return &Ntp{
+		AdminState: baseline.AdminState,
+		Server:     ntpServersFromSpec(baseline.Server),
+	}
+}
+
+func ntpServersFromSpec(servers []srlinuxv1alpha1.NtpServer) []NtpServer {
+	if len(servers) == 0 {
+		return nil
+	}
+	out := make([]NtpServer, 0, len(servers))
+	for _, s := range servers {
+		out = append(out, NtpServer{
+			Address: s.Address,
+			IBurst:  s.IBurst,
+			Prefer:  s.Prefer,
+		})
+	}
+	return out
+}
+
+// UnmarshalNtp decodes the JSON or JSON_IETF encoded ntp container read from a
+// device. data may be rooted at /, at /system or at /system/ntp.
+func UnmarshalNtp(data []byte) (*Ntp, error) {
+	ntp := &Ntp{}
+	if err := decodeContainer(data, ntpPath, ntp); err != nil {
+		return nil, fmt.Errorf("cannot decode ntp: %v", err)
+	}
+	return ntp, nil
+}
+
+// NtpFromTree returns the ntp container held by the generic JSON representation
+// tree, rooted like the data of UnmarshalNtp
+func NtpFromTree(tree interface{}) (*Ntp, error) {
+	ntp := &Ntp{}
+	if err := decodeTree(tree, ntpPath, ntp); err != nil {
+		return nil, fmt.Errorf("cannot decode ntp: %v", err)
+	}
+	return ntp, nil
+}
+
+// Device returns the SR Linux bindings holding the configuration of the ntp container
+func (n *Ntp) Device() (*srlmodels.Device, error) {
+	dev := &srlmodels.Device{}
+	ntp := dev.GetOrCreateSystem().GetOrCreateNtp()
+	if n.AdminState != "" {
+		v, err := enumValue(srlmodels.SrlNokiaNtp_AdminState_UNSET, n.AdminState)
+		if err != nil {
+			return nil, err
+		}
+		ntp.AdminState = srlmodels.E_SrlNokiaNtp_AdminState(v)
+	}
+	if n.NetworkInstance != "" {
+		ntp.NetworkInstance = ygot.String(n.NetworkInstance)
+	}
+	for _, s := range n.Server {
+		server, err := ntp.NewServer(s.Address)
+		if err != nil {
+			return nil, err
+		}
+		server.Iburst = ygot.Bool(s.IBurst)
+		server.Prefer = ygot.Bool(s.Prefer)
+	}
+	return dev, nil
+}
+
+// Config returns the configuration of the ntp container without module prefixes,
+// in the layout of the container on the device
+func (n *Ntp) Config() (interface{}, error) {
+	dev, err := n.Device()
+	if err != nil {
+		return nil, err
+	}
+	return ygot.ConstructIETFJSON(dev.GetSystem().GetNtp(), nil)
+}
+
+// MarshalConfig returns the JSON_IETF encoded configuration of the ntp container,
+// rooted at / so it can be sent in an update of the root path
+func (n *Ntp) MarshalConfig() ([]byte, error) {
+	dev, err := n.Device()
+	if err != nil {
+		return nil, err
+	}
+	return gnmic.StructToJSONIETF(dev)
+}
+
+// Spec copies the configuration of the ntp container into spec
+func (n *Ntp) Spec(spec *srlinuxv1alpha1.NtpSpec) {
+	spec.AdminState = n.AdminState
+	spec.NetworkInstance = n.NetworkInstance
+	spec.Server = nil
+	for _, s := range n.Server {
+		spec.Server = append(spec.Server, srlinuxv1alpha1.NtpServer{
+			Address: s.Address,
+			IBurst:  s.IBurst,
+			Prefer:  s.Prefer,
+		})
+	}
+}
+
+// Status copies the state of the ntp container into the status of a device
+func (n *Ntp) Status(devStatus *srlinuxv1alpha1.NtpDeviceStatus) {
+	devStatus.AdminState = n.AdminState
+	devStatus.OperState = n.OperState
+	devStatus.Synchronized = n.Synchronized
+	devStatus.NetworkInstance = n.NetworkInstance
+	devStatus.Server = make([]srlinuxv1alpha1.NtpServerState, 0, len(n.Server))
+	for _, s := range n.Server {
+		devStatus.Server = append(devStatus.Server, srlinuxv1alpha1.NtpServerState{
+			Address:      s.Address,
+			IBurst:       s.IBurst,
+			Prefer:       s.Prefer,
+			Stratum:      s.Stratum,
+			Jitter:       string(s.Jitter),
+			Offset:       string(s.Offset),
+			PollInterval: s.PollInterval,
+		})
+	}
+}
@@ -0,0 +1,221 @@
+package translate
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+)
+
+// srlNtpState is /system/ntp as returned by SR Linux for a JSON_IETF GetRequest
+// of type ALL at /system/ntp
+const srlNtpState = `{
+  "srl_nokia-ntp:admin-state": "enable",
+  "srl_nokia-ntp:oper-state": "up",
+  "srl_nokia-ntp:network-instance": "mgmt",
+  "srl_nokia-ntp:synchronized": "synchronized",
+  "srl_nokia-ntp:server": [
+    {
+      "address": "193.104.37.238",
+      "iburst": false,
+      "prefer": true,
+      "stratum": 2,
+      "jitter": "0.415",
+      "offset": "-0.122",
+      "poll-interval": 64
+    },
+    {
+      "address": "162.159.200.1",
+      "iburst": true,
+      "prefer": false,
+      "stratum": 3,
+      "jitter": "1.021",
+      "offset": "0.307",
+      "poll-interval": 128
+    }
+  ]
+}`
+
+func TestNtpMarshalConfig(t *testing.T) {
+	spec := &srlinuxv1alpha1.NtpSpec{
+		AdminState:      "enable",
+		NetworkInstance: "mgmt",
+		Server: []srlinuxv1alpha1.NtpServer{
+			{Address: "193.104.37.238", Prefer: true},
+			{Address: "162.159.200.1", IBurst: true},
+		},
+		DriftPolicy: "remediate",
+	}
+	want := `{
+  "srl_nokia-system:system": {
+    "srl_nokia-ntp:ntp": {
+      "admin-state": "enable",
+      "network-instance": "mgmt",
+      "server": [
+        {"address": "162.159.200.1", "iburst": true, "prefer": false},
+        {"address": "193.104.37.238", "iburst": false, "prefer": true}
+      ]
+    }
+  }
+}`
+
+	got, err := NtpFromSpec(spec).MarshalConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, got, []byte(want))
+}
+
+func TestNtpMarshalConfigInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		spec srlinuxv1alpha1.NtpSpec
+	}{
+		{
+			name: "admin-state",
+			spec: srlinuxv1alpha1.NtpSpec{AdminState: "on", NetworkInstance: "mgmt"},
+		},
+		{
+			name: "address",
+			spec: srlinuxv1alpha1.NtpSpec{
+				NetworkInstance: "mgmt",
+				Server:          []srlinuxv1alpha1.NtpServer{{Address: "not an address"}},
+			},
+		},
+		{
+			name: "duplicate server",
+			spec: srlinuxv1alpha1.NtpSpec{
+				NetworkInstance: "mgmt",
+				Server:          []srlinuxv1alpha1.NtpServer{{Address: "10.0.0.1"}, {Address: "10.0.0.1"}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if b, err := NtpFromSpec(&tt.spec).MarshalConfig(); err == nil {
+				t.Errorf("expected an error, got %s", b)
+			}
+		})
+	}
+}
+
+func TestNtpRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		spec srlinuxv1alpha1.NtpSpec
+	}{
+		{
+			name: "no servers",
+			spec: srlinuxv1alpha1.NtpSpec{AdminState: "disable", NetworkInstance: "default"},
+		},
+		{
+			name: "servers",
+			spec: srlinuxv1alpha1.NtpSpec{
+				AdminState:      "enable",
+				NetworkInstance: "mgmt",
+				Server: []srlinuxv1alpha1.NtpServer{
+					{Address: "time.example.com", IBurst: true},
+					{Address: "193.104.37.238", IBurst: true, Prefer: true},
+					{Address: "2001:db8::1"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := NtpFromSpec(&tt.spec).MarshalConfig()
+			if err != nil {
+				t.Fatal(err)
+			}
+			ntp, err := UnmarshalNtp(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got srlinuxv1alpha1.NtpSpec
+			ntp.Spec(&got)
+			// the device orders the servers on their address
+			sort.Slice(got.Server, func(i, j int) bool { return got.Server[i].Address < got.Server[j].Address })
+			sort.Slice(tt.spec.Server, func(i, j int) bool { return tt.spec.Server[i].Address < tt.spec.Server[j].Address })
+			if !reflect.DeepEqual(got, tt.spec) {
+				t.Errorf("round trip of %s\ngot  %+v\nwant %+v", data, got, tt.spec)
+			}
+		})
+	}
+}
+
+func TestUnmarshalNtp(t *testing.T) {
+	wantStatus := srlinuxv1alpha1.NtpDeviceStatus{
+		AdminState:      "enable",
+		OperState:       "up",
+		Synchronized:    "synchronized",
+		NetworkInstance: "mgmt",
+		Server: []srlinuxv1alpha1.NtpServerState{
+			{Address: "193.104.37.238", Prefer: true, Stratum: 2, Jitter: "0.415", Offset: "-0.122", PollInterval: 64},
+			{Address: "162.159.200.1", IBurst: true, Stratum: 3, Jitter: "1.021", Offset: "0.307", PollInterval: 128},
+		},
+	}
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{name: "rooted at /system/ntp", data: srlNtpState},
+		{name: "rooted at /system", data: `{"srl_nokia-ntp:ntp": ` + srlNtpState + `}`},
+		{name: "rooted at /", data: `{"srl_nokia-system:system": {"srl_nokia-ntp:ntp": ` + srlNtpState + `}}`},
+		{
+			name: "json encoding",
+			data: `{"system": {"ntp": {"admin-state": "enable", "oper-state": "up", "network-instance": "mgmt",
+				"synchronized": "synchronized", "server": [
+				{"address": "193.104.37.238", "iburst": false, "prefer": true, "stratum": 2, "jitter": 0.415, "offset": -0.122, "poll-interval": 64},
+				{"address": "162.159.200.1", "iburst": true, "prefer": false, "stratum": 3, "jitter": 1.021, "offset": 0.307, "poll-interval": 128}]}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ntp, err := UnmarshalNtp([]byte(tt.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got srlinuxv1alpha1.NtpDeviceStatus
+			ntp.Status(&got)
+			if !reflect.DeepEqual(got, wantStatus) {
+				t.Errorf("got  %+v\nwant %+v", got, wantStatus)
+			}
+		})
+	}
+}
+
+func TestNtpStateRoundTrip(t *testing.T) {
+	// the configuration read back from the device is sent unchanged
+	ntp, err := UnmarshalNtp([]byte(srlNtpState))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec srlinuxv1alpha1.NtpSpec
+	ntp.Spec(&spec)
+	got, err := NtpFromSpec(&spec).MarshalConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ntp.MarshalConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, got, want)
+}
+
+func assertJSONEqual(t *testing.T, got, want []byte) {
+	t.Helper()
+	var g, w interface{}
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("invalid json %s: %v", got, err)
+	}
+	if err := json.Unmarshal(want, &w); err != nil {
+		t.Fatalf("invalid json %s: %v", want, err)
+	}
+	if !reflect.DeepEqual(g, w) {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
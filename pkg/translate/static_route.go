@@ -0,0 +1,101 @@
+package translate
+
+import (
+	"github.com/openconfig/ygot/ygot"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+)
+
+// StaticRoute is a /network-instance/static-routes/route list entry of SR Linux
+type StaticRoute struct {
+	Prefix       string  `json:"prefix"`
+	AdminState   string  `json:"admin-state,omitempty"`
+	Metric       *uint32 `json:"metric,omitempty"`
+	Preference   *uint8  `json:"preference,omitempty"`
+	NextHopGroup string  `json:"next-hop-group,omitempty"`
+}
+
+// StaticRoutes is the /network-instance/static-routes container
+type StaticRoutes struct {
+	Route []StaticRoute `json:"route,omitempty"`
+}
+
+// StaticRoutesFromSpec returns a route to each of the prefixes of spec
+func StaticRoutesFromSpec(spec *srlinuxv1alpha1.StaticRouteSpec) *StaticRoutes {
+	s := &StaticRoutes{Route: make([]StaticRoute, 0, len(spec.Prefixes))}
+	for _, prefix := range spec.Prefixes {
+		s.Route = append(s.Route, StaticRoute{
+			Prefix:       prefix,
+			AdminState:   spec.AdminState,
+			Metric:       spec.Metric,
+			Preference:   spec.Preference,
+			NextHopGroup: spec.NextHopGroup,
+		})
+	}
+	return s
+}
+
+// Device returns the SR Linux bindings holding the static routes in the network
+// instance named name
+func (s *StaticRoutes) Device(name string) (*srlmodels.Device, error) {
+	dev := &srlmodels.Device{}
+	ni, err := dev.NewNetworkInstance(name)
+	if err != nil {
+		return nil, err
+	}
+	routes := ni.GetOrCreateStaticRoutes()
+	for _, r := range s.Route {
+		route, err := routes.NewRoute(r.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		if r.AdminState != "" {
+			v, err := enumValue(srlmodels.SrlNokiaStaticRoutes_AdminState_UNSET, r.AdminState)
+			if err != nil {
+				return nil, err
+			}
+			route.AdminState = srlmodels.E_SrlNokiaStaticRoutes_AdminState(v)
+		}
+		route.Metric = r.Metric
+		route.Preference = r.Preference
+		if r.NextHopGroup != "" {
+			route.NextHopGroup = ygot.String(r.NextHopGroup)
+		}
+	}
+	return dev, nil
+}
+
+// RouteTableRoute is a /network-instance/route-table/ipv4-unicast/route or
+// ipv6-unicast/route list entry
+type RouteTableRoute struct {
+	IPv4Prefix string `json:"ipv4-prefix,omitempty"`
+	IPv6Prefix string `json:"ipv6-prefix,omitempty"`
+	ID         uint16 `json:"id"`
+	RouteType  string `json:"route-type"`
+	RouteOwner string `json:"route-owner"`
+	Active     bool   `json:"active"`
+	Metric     uint32 `json:"metric,omitempty"`
+	Preference uint8  `json:"preference,omitempty"`
+}
+
+// StaticRouteState returns the state of the static route to prefix given the
+// entries of the route table for prefix. The route is installed when a static
+// entry is active.
+func StaticRouteState(prefix string, routes []RouteTableRoute) srlinuxv1alpha1.StaticRouteState {
+	state := srlinuxv1alpha1.StaticRouteState{Prefix: prefix}
+	for _, r := range routes {
+		// JSON_IETF identityref values carry the module of the identity
+		if gnmic.StripModulePrefix(r.RouteType) != "static" {
+			continue
+		}
+		state.Metric = r.Metric
+		state.Preference = r.Preference
+		if r.Active {
+			state.Installed = true
+			break
+		}
+	}
+	return state
+}
@@ -0,0 +1,136 @@
+package translate
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+)
+
+func TestNextHopGroupDevice(t *testing.T) {
+	spec := &srlinuxv1alpha1.NextHopGroupSpec{
+		AdminState: "enable",
+		NextHops: []srlinuxv1alpha1.NextHop{
+			{Index: 1, IPAddress: "192.168.11.1", Resolve: ygot.Bool(false)},
+			{Index: 2, IPAddress: "2001:db8::1", AdminState: "disable"},
+		},
+	}
+	want := `{
+  "srl_nokia-network-instance:network-instance": [
+    {
+      "name": "default",
+      "srl_nokia-next-hop-groups:next-hop-groups": {
+        "group": [
+          {
+            "name": "spines",
+            "admin-state": "enable",
+            "nexthop": [
+              {"index": 1, "ip-address": "192.168.11.1", "resolve": false},
+              {"index": 2, "ip-address": "2001:db8::1", "admin-state": "disable"}
+            ]
+          }
+        ]
+      }
+    }
+  ]
+}`
+
+	dev, err := NextHopGroupFromSpec("spines", spec).Device("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := gnmic.StructToJSONIETF(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, got, []byte(want))
+}
+
+func TestStaticRoutesDevice(t *testing.T) {
+	spec := &srlinuxv1alpha1.StaticRouteSpec{
+		Prefixes:     []string{"10.1.0.0/16", "2001:db8:1::/48"},
+		NextHopGroup: "spines",
+		AdminState:   "enable",
+		Preference:   func(v uint8) *uint8 { return &v }(10),
+	}
+	want := `{
+  "srl_nokia-network-instance:network-instance": [
+    {
+      "name": "default",
+      "srl_nokia-static-routes:static-routes": {
+        "route": [
+          {"prefix": "10.1.0.0/16", "admin-state": "enable", "preference": 10, "next-hop-group": "spines"},
+          {"prefix": "2001:db8:1::/48", "admin-state": "enable", "preference": 10, "next-hop-group": "spines"}
+        ]
+      }
+    }
+  ]
+}`
+
+	dev, err := StaticRoutesFromSpec(spec).Device("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := gnmic.StructToJSONIETF(dev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertJSONEqual(t, got, []byte(want))
+}
+
+func TestStaticRoutesDeviceInvalid(t *testing.T) {
+	spec := &srlinuxv1alpha1.StaticRouteSpec{Prefixes: []string{"10.1.0.0"}, NextHopGroup: "spines"}
+	dev, err := StaticRoutesFromSpec(spec).Device("default")
+	if err == nil {
+		_, err = gnmic.StructToJSONIETF(dev)
+	}
+	if err == nil {
+		t.Errorf("expected an error for spec %+v", spec)
+	}
+}
+
+func TestStaticRouteState(t *testing.T) {
+	// the entries of a prefix in the route table, the static route loses against
+	// the bgp route
+	table := `[
+  {"ipv4-prefix": "10.1.0.0/16", "id": 0, "route-type": "srl_nokia-common:bgp", "route-owner": "bgp_mgr", "active": true, "metric": 0, "preference": 170},
+  {"ipv4-prefix": "10.1.0.0/16", "id": 0, "route-type": "srl_nokia-common:static", "route-owner": "static_route_mgr", "active": false, "metric": 1, "preference": 200}
+]`
+	var routes []RouteTableRoute
+	if err := json.Unmarshal([]byte(table), &routes); err != nil {
+		t.Fatal(err)
+	}
+	installed := routes[1]
+	installed.Active = true
+	tests := []struct {
+		name   string
+		routes []RouteTableRoute
+		want   srlinuxv1alpha1.StaticRouteState
+	}{
+		{
+			name:   "inactive",
+			routes: routes,
+			want:   srlinuxv1alpha1.StaticRouteState{Prefix: "10.1.0.0/16", Metric: 1, Preference: 200},
+		},
+		{
+			name:   "installed",
+			routes: []RouteTableRoute{installed},
+			want:   srlinuxv1alpha1.StaticRouteState{Prefix: "10.1.0.0/16", Installed: true, Metric: 1, Preference: 200},
+		},
+		{
+			name: "missing",
+			want: srlinuxv1alpha1.StaticRouteState{Prefix: "10.1.0.0/16"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StaticRouteState("10.1.0.0/16", tt.routes); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("StaticRouteState() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package translate
+
+import (
+	"github.com/openconfig/ygot/ygot"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/srlmodels"
+)
+
+// Subinterface is an /interface/subinterface list entry of SR Linux
+type Subinterface struct {
+	Index       uint32               `json:"index"`
+	Description string               `json:"description,omitempty"`
+	AdminState  string               `json:"admin-state,omitempty"`
+	OperState   string               `json:"oper-state,omitempty"`
+	LastChange  string               `json:"last-change,omitempty"`
+	Vlan        *SubinterfaceVlan    `json:"vlan,omitempty"`
+	IPv4        *SubinterfaceIP      `json:"ipv4,omitempty"`
+	IPv6        *SubinterfaceIP      `json:"ipv6,omitempty"`
+	Statistics  *InterfaceStatistics `json:"statistics,omitempty"`
+}
+
+// SubinterfaceVlan is the /interface/subinterface/vlan container
+type SubinterfaceVlan struct {
+	Encap struct {
+		SingleTagged struct {
+			VlanID uint16 `json:"vlan-id,omitempty"`
+		} `json:"single-tagged"`
+	} `json:"encap"`
+}
+
+// SubinterfaceIP is the ipv4 or ipv6 container of a subinterface
+type SubinterfaceIP struct {
+	Address []SubinterfaceAddress `json:"address,omitempty"`
+}
+
+// SubinterfaceAddress is an address list entry of a subinterface
+type SubinterfaceAddress struct {
+	IPPrefix string `json:"ip-prefix"`
+}
+
+// SubinterfaceFromSpec returns the subinterface configured by spec
+func SubinterfaceFromSpec(spec *srlinuxv1alpha1.SubinterfaceSpec) *Subinterface {
+	s := &Subinterface{
+		Index:       spec.Index,
+		Description: spec.Description,
+		AdminState:  spec.AdminState,
+		IPv4:        subinterfaceIPFromSpec(spec.IPv4),
+		IPv6:        subinterfaceIPFromSpec(spec.IPv6),
+	}
+	if spec.VlanID != nil {
+		s.Vlan = &SubinterfaceVlan{}
+		s.Vlan.Encap.SingleTagged.VlanID = *spec.VlanID
+	}
+	return s
+}
+
+func subinterfaceIPFromSpec(prefixes []string) *SubinterfaceIP {
+	if len(prefixes) == 0 {
+		return nil
+	}
+	ip := &SubinterfaceIP{Address: make([]SubinterfaceAddress, 0, len(prefixes))}
+	for _, p := range prefixes {
+		ip.Address = append(ip.Address, SubinterfaceAddress{IPPrefix: p})
+	}
+	return ip
+}
+
+// Device returns the SR Linux bindings holding the configuration of the
+// subinterface of the interface named name
+func (s *Subinterface) Device(name string) (*srlmodels.Device, error) {
+	dev := &srlmodels.Device{}
+	iface, err := dev.NewInterface(name)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := iface.NewSubinterface(s.Index)
+	if err != nil {
+		return nil, err
+	}
+	if s.Description != "" {
+		sub.Description = ygot.String(s.Description)
+	}
+	if s.AdminState != "" {
+		v, err := enumValue(srlmodels.SrlNokiaInterfaces_AdminState_UNSET, s.AdminState)
+		if err != nil {
+			return nil, err
+		}
+		sub.AdminState = srlmodels.E_SrlNokiaInterfaces_AdminState(v)
+	}
+	if s.Vlan != nil {
+		sub.GetOrCreateVlan().GetOrCreateEncap().GetOrCreateSingleTagged().VlanId = ygot.Uint16(s.Vlan.Encap.SingleTagged.VlanID)
+	}
+	for _, a := range s.IPv4.prefixes() {
+		if _, err := sub.GetOrCreateIpv4().NewAddress(a); err != nil {
+			return nil, err
+		}
+	}
+	for _, a := range s.IPv6.prefixes() {
+		if _, err := sub.GetOrCreateIpv6().NewAddress(a); err != nil {
+			return nil, err
+		}
+	}
+	return dev, nil
+}
+
+// Status copies the state of the subinterface into the status of a device
+func (s *Subinterface) Status(devStatus *srlinuxv1alpha1.SubinterfaceDeviceStatus) {
+	devStatus.AdminState = s.AdminState
+	devStatus.OperState = s.OperState
+	devStatus.LastChange = s.LastChange
+	devStatus.VlanID = 0
+	if s.Vlan != nil {
+		devStatus.VlanID = s.Vlan.Encap.SingleTagged.VlanID
+	}
+	devStatus.IPv4 = s.IPv4.prefixes()
+	devStatus.IPv6 = s.IPv6.prefixes()
+	devStatus.Counters = s.Statistics.counters()
+}
+
+// prefixes returns the ip-prefix of the addresses
+func (ip *SubinterfaceIP) prefixes() []string {
+	if ip == nil {
+		return nil
+	}
+	prefixes := make([]string, 0, len(ip.Address))
+	for _, a := range ip.Address {
+		prefixes = append(prefixes, a.IPPrefix)
+	}
+	return prefixes
+}
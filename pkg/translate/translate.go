@@ -0,0 +1,99 @@
+// Package translate maps the specs of the custom resources to the SR Linux YANG
+// JSON sent to the devices, and the JSON read back from the devices to the status
+// of the custom resources.
+package translate
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/openconfig/ygot/ygot"
+
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+)
+
+// Decimal is a YANG decimal64 value. JSON_IETF encodes it as a string, JSON as a
+// number, both are accepted when decoding.
+type Decimal string
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	var n json.Number
+	if err := json.Unmarshal(b, &n); err == nil {
+		*d = Decimal(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	*d = Decimal(s)
+	return nil
+}
+
+// Counter is a YANG counter64 value. JSON_IETF encodes it as a string, JSON as a
+// number, both are accepted when decoding.
+type Counter uint64
+
+// UnmarshalJSON implements json.Unmarshaler
+func (c *Counter) UnmarshalJSON(b []byte) error {
+	var d Decimal
+	if err := d.UnmarshalJSON(b); err != nil {
+		return err
+	}
+	v, err := strconv.ParseUint(string(d), 10, 64)
+	if err != nil {
+		// numbers decoded into a float64 on the way, e.g. by gnmic.GetTree
+		f, ferr := strconv.ParseFloat(string(d), 64)
+		if ferr != nil || f < 0 {
+			return fmt.Errorf("invalid counter %s", b)
+		}
+		v = uint64(f)
+	}
+	*c = Counter(v)
+	return nil
+}
+
+// decodeContainer decodes the JSON or JSON_IETF encoded data into out. data may be
+// rooted anywhere along path, e.g. for path system/ntp at /, at /system or at
+// /system/ntp.
+func decodeContainer(data []byte, path []string, out interface{}) error {
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	return decodeTree(raw, path, out)
+}
+
+// decodeTree is decodeContainer for data that was decoded already
+func decodeTree(tree interface{}, path []string, out interface{}) error {
+	tree = gnmic.StripModulePrefixes(tree)
+	for _, name := range path {
+		if m, ok := tree.(map[string]interface{}); ok {
+			if v, ok := m[name]; ok {
+				tree = v
+			}
+		}
+	}
+	if tree == nil {
+		return nil
+	}
+	b, err := json.Marshal(tree)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
+
+// enumValue returns the value named name of the enumeration e belongs to
+func enumValue(e ygot.GoEnum, name string) (int64, error) {
+	typeName := reflect.TypeOf(e).Name()
+	for v, d := range e.ΛMap()[typeName] {
+		if d.Name == name {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid value '%s' for %s", name, typeName)
+}
@@ -0,0 +1,69 @@
+package translate
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/openconfig/gnmi/proto/gnmi"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	srlinuxv1alpha1 "github.com/srl-wim/srlinux-k8s-operator/api/v1alpha1"
+	"github.com/srl-wim/srlinux-k8s-operator/pkg/gnmic"
+)
+
+// ValidateNtp validates the ntp container configured by spec against schema and
+// returns the errors at the fields of spec they were rendered from
+func ValidateNtp(schema *gnmic.Schema, spec *srlinuxv1alpha1.NtpSpec, fldPath *field.Path) field.ErrorList {
+	err := validateContainer(schema, ntpPath, NtpFromSpec(spec))
+	return fieldErrors(err, fldPath, len(ntpPath), func(list string, keys map[string]string) int {
+		for i, s := range spec.Server {
+			if list == "server" && s.Address == keys["address"] {
+				return i
+			}
+		}
+		return -1
+	})
+}
+
+// validateContainer validates the json representation of container at path
+// against schema
+func validateContainer(schema *gnmic.Schema, path []string, container interface{}) error {
+	b, err := json.Marshal(container)
+	if err != nil {
+		return err
+	}
+	p := &gnmi.Path{}
+	for _, name := range path {
+		p.Elem = append(p.Elem, &gnmi.PathElem{Name: name})
+	}
+	return schema.Validate(p, &gnmi.TypedValue{Value: &gnmi.TypedValue_JsonIetfVal{JsonIetfVal: b}})
+}
+
+// fieldErrors converts the schema validation errors of a container into errors at
+// fldPath. The elements below the first depth elements of an error path map onto
+// the fields with the same name, list entries onto the index returned by index.
+func fieldErrors(err error, fldPath *field.Path, depth int, index func(list string, keys map[string]string) int) field.ErrorList {
+	if err == nil {
+		return nil
+	}
+	var verrs gnmic.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return field.ErrorList{field.InternalError(fldPath, err)}
+	}
+	allErrs := make(field.ErrorList, 0, len(verrs))
+	for _, verr := range verrs {
+		p := fldPath
+		elems := verr.Path.GetElem()
+		for i := depth; i < len(elems); i++ {
+			p = p.Child(elems[i].GetName())
+			if len(elems[i].GetKey()) == 0 {
+				continue
+			}
+			if idx := index(elems[i].GetName(), elems[i].GetKey()); idx >= 0 {
+				p = p.Index(idx)
+			}
+		}
+		allErrs = append(allErrs, field.Invalid(p, verr.Value, verr.Message))
+	}
+	return allErrs
+}